@@ -470,6 +470,12 @@ func (app *BaseApp) getContextForAnte(mode runTxMode, txBytes []byte) (ctx sdk.C
 		ctx = ctx.WithSigningValidators(app.signedValidators)
 	}
 
+	// a simulation must not change the check state, e.g. the sequence of the
+	// signers, as the simulated tx is usually checked right after
+	if mode == runTxModeSimulate {
+		ctx = ctx.WithMultiStore(app.checkState.CacheMultiStore())
+	}
+
 	return
 }
 
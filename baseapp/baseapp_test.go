@@ -653,6 +653,36 @@ func TestSimulateTx(t *testing.T) {
 	}
 }
 
+// Simulating a transaction must not change the check state, so the same
+// transaction can be checked right after.
+func TestSimulateTxKeepsCheckState(t *testing.T) {
+	counterKey := []byte("counter-key")
+
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, counterKey)) }
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(typeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result { return sdk.Result{} })
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+
+	app.InitChain(abci.RequestInitChain{})
+
+	tx := *newTxCounter(0, 0)
+
+	// the ante handler only accepts the counter stored in the check state
+	result := app.Simulate(tx)
+	require.True(t, result.IsOK(), result.Log)
+	result = app.Simulate(tx)
+	require.True(t, result.IsOK(), result.Log)
+
+	checkStateStore := app.checkState.ctx.KVStore(capKey1)
+	require.Equal(t, int64(0), getIntFromStore(checkStateStore, counterKey))
+
+	result = app.Check(tx)
+	require.True(t, result.IsOK(), result.Log)
+	require.Equal(t, int64(1), getIntFromStore(checkStateStore, counterKey))
+}
+
 //-------------------------------------------------------------------------------------------
 // Tx failure cases
 // TODO: add more
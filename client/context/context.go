@@ -30,6 +30,8 @@ type CLIContext struct {
 	Async           bool
 	JSON            bool
 	PrintResponse   bool
+	GenerateOnly    bool
+	Verifier        *Verifier
 }
 
 // NewCLIContext returns a new initialized CLIContext with parameters from the
@@ -53,6 +55,7 @@ func NewCLIContext() CLIContext {
 		Async:           viper.GetBool(client.FlagAsync),
 		JSON:            viper.GetBool(client.FlagJson),
 		PrintResponse:   viper.GetBool(client.FlagPrintResponse),
+		GenerateOnly:    viper.GetBool(client.FlagGenerateOnly),
 	}
 }
 
@@ -108,6 +111,13 @@ func (ctx CLIContext) WithClient(client rpcclient.Client) CLIContext {
 	return ctx
 }
 
+// WithVerifier returns a copy of the context with an updated verifier of the
+// responses of the node, used unless the node is trusted.
+func (ctx CLIContext) WithVerifier(verifier *Verifier) CLIContext {
+	ctx.Verifier = verifier
+	return ctx
+}
+
 // WithUseLedger returns a copy of the context with an updated UseLedger flag.
 func (ctx CLIContext) WithUseLedger(useLedger bool) CLIContext {
 	ctx.UseLedger = useLedger
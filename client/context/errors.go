@@ -11,3 +11,26 @@ func ErrInvalidAccount(addr sdk.AccAddress) error {
 	return errors.Errorf(`No account with address %s was found in the state.
 Are you sure there has been a transaction involving it?`, addr)
 }
+
+// VerificationError is returned when the response of an untrusted node fails
+// to be verified against the headers certified by the light client.
+type VerificationError struct {
+	Reason string
+}
+
+func (e VerificationError) Error() string {
+	return "failed to verify the response of the node: " + e.Reason
+}
+
+// ErrVerification returns a standardized error reflecting that a response of
+// the node failed verification.
+func ErrVerification(reason string) error {
+	return VerificationError{Reason: reason}
+}
+
+// IsVerificationError returns whether the error, or its cause, reflects that
+// a response of the node failed verification.
+func IsVerificationError(err error) bool {
+	_, ok := errors.Cause(err).(VerificationError)
+	return ok
+}
@@ -5,7 +5,9 @@ import (
 	"io"
 
 	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 
 	"github.com/pkg/errors"
@@ -49,6 +51,49 @@ func (ctx CLIContext) QuerySubspace(subspace []byte, storeName string) (res []sd
 	return
 }
 
+// QuerySubspacePage performs a subspace query and returns the given page of
+// results, pages starting at 1. A limit of 0 returns all the results. The node
+// returns the pages one at a time, each starting after the last key of the
+// previous one.
+func (ctx CLIContext) QuerySubspacePage(subspace []byte, storeName string, page, limit int) (res []sdk.KVPair, err error) {
+	if page < 1 || limit < 0 {
+		return res, errors.Errorf("invalid page %d or limit %d", page, limit)
+	}
+	if limit == 0 {
+		return ctx.QuerySubspace(subspace, storeName)
+	}
+
+	start := subspace
+	for i := 1; ; i++ {
+		res, err = ctx.querySubspacePage(subspace, start, storeName, limit)
+		if err != nil || i == page {
+			return res, err
+		}
+		if len(res) < limit {
+			return nil, nil
+		}
+		// the key right after the last key of the page
+		start = append(append([]byte{}, res[len(res)-1].Key...), 0)
+	}
+}
+
+// querySubspacePage queries the node for at most limit pairs of the subspace,
+// from the start key on.
+func (ctx CLIContext) querySubspacePage(subspace, start []byte, storeName string, limit int) (res []sdk.KVPair, err error) {
+	data := cdc.MustMarshalBinary(store.SubspacePageQuery{
+		Subspace: subspace,
+		Start:    start,
+		Limit:    limit,
+	})
+	resRaw, err := ctx.queryStore(data, storeName, "subspace_page")
+	if err != nil {
+		return res, err
+	}
+
+	ctx.Codec.MustUnmarshalBinary(resRaw, &res)
+	return
+}
+
 // GetAccount queries for an account given an address and a block height. An
 // error is returned if the query or decoding fails.
 func (ctx CLIContext) GetAccount(address []byte) (auth.Account, error) {
@@ -112,6 +157,29 @@ func (ctx CLIContext) GetAccountSequence(address []byte) (int64, error) {
 	return account.GetSequence(), nil
 }
 
+// Simulate runs transaction bytes against the latest state of a Tendermint
+// node without committing them, and returns the result. An error is returned
+// if the transaction fails.
+func (ctx CLIContext) Simulate(tx []byte) (sdk.Result, error) {
+	res, err := ctx.query("/app/simulate", tx)
+	if err != nil {
+		return sdk.Result{}, err
+	}
+
+	var result sdk.Result
+	if err := wire.Cdc.UnmarshalBinary(res, &result); err != nil {
+		return sdk.Result{}, err
+	}
+
+	if !result.IsOK() {
+		return result, errors.Errorf("simulation failed: (%d) %s",
+			result.Code,
+			result.Log)
+	}
+
+	return result, nil
+}
+
 // BroadcastTx broadcasts transaction bytes to a Tendermint node.
 func (ctx CLIContext) BroadcastTx(tx []byte) (*ctypes.ResultBroadcastTxCommit, error) {
 	node, err := ctx.GetNode()
@@ -300,6 +368,13 @@ func (ctx CLIContext) query(path string, key common.HexBytes) (res []byte, err e
 		return res, errors.Errorf("query failed: (%d) %s", resp.Code, resp.Log)
 	}
 
+	// verify the response unless the node is trusted
+	if !ctx.TrustNode && ctx.Verifier != nil {
+		if err := ctx.Verifier.VerifyQuery(path, key, resp); err != nil {
+			return res, err
+		}
+	}
+
 	return resp.Value, nil
 }
 
@@ -0,0 +1,155 @@
+package context
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmlite "github.com/tendermint/tendermint/lite"
+	tmliteclient "github.com/tendermint/tendermint/lite/client"
+	tmlitefiles "github.com/tendermint/tendermint/lite/files"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// maximum number of certified headers kept in memory by a verifier
+const maxCachedHeaders = 1000
+
+// codec of the pairs returned by subspace queries
+var cdc = wire.NewCodec()
+
+// Verifier verifies the store queries answered by an untrusted node against
+// the headers certified by a Tendermint light client. The light client is
+// seeded from a trusted header, and the headers it certifies are cached.
+type Verifier struct {
+	certifier *tmlite.InquiringCertifier
+	node      rpcclient.Client
+
+	mtx     sync.Mutex
+	headers map[int64]*tmtypes.Header
+	heights []int64 // heights of the cached headers, oldest first
+}
+
+// NewVerifier returns a verifier of the responses of the node, whose light
+// client is seeded from the header of the given height and hash. The commits
+// trusted by the light client are stored under rootDir.
+func NewVerifier(chainID, rootDir string, node rpcclient.Client, trustHeight int64, trustHash []byte) (*Verifier, error) {
+	trusted := tmlite.NewCacheProvider(
+		tmlite.NewMemStoreProvider(),
+		tmlitefiles.NewProvider(rootDir),
+	)
+	source := tmliteclient.NewProvider(node)
+
+	fc, err := source.GetByHeight(trustHeight)
+	if err != nil {
+		return nil, err
+	}
+	if fc.Height() != trustHeight {
+		return nil, fmt.Errorf("no header at trusted height %d", trustHeight)
+	}
+	if err := fc.ValidateBasic(chainID); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(fc.Header.Hash(), trustHash) {
+		return nil, fmt.Errorf("header at trusted height %d has hash %X, expected %X",
+			trustHeight, fc.Header.Hash(), trustHash)
+	}
+
+	certifier, err := tmlite.NewInquiringCertifier(chainID, fc, trusted, source)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Verifier{
+		certifier: certifier,
+		node:      node,
+		headers:   make(map[int64]*tmtypes.Header),
+	}, nil
+}
+
+// CertifiedHeader returns the header of the given height once certified by
+// the light client.
+func (v *Verifier) CertifiedHeader(height int64) (*tmtypes.Header, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	if header, ok := v.headers[height]; ok {
+		return header, nil
+	}
+
+	res, err := v.node.Commit(&height)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := v.certifier.Certify(tmlite.Commit(res.SignedHeader)); err != nil {
+		return nil, ErrVerification(err.Error())
+	}
+
+	if len(v.heights) == maxCachedHeaders {
+		delete(v.headers, v.heights[0])
+		v.heights = v.heights[1:]
+	}
+	v.headers[height] = res.Header
+	v.heights = append(v.heights, height)
+
+	return res.Header, nil
+}
+
+// VerifyQuery verifies the response of a query of the given path and key.
+// Store queries of a key, a subspace or a page of a subspace are proven
+// against the app hash of the certified header following the queried height,
+// other queries are not verified.
+func (v *Verifier) VerifyQuery(path string, key []byte, resp abci.ResponseQuery) error {
+	// store paths are /store/<storeName>/<subpath>
+	paths := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 3)
+	if len(paths) != 3 || paths[0] != "store" {
+		return nil
+	}
+	storeName, subpath := paths[1], paths[2]
+
+	if subpath != "key" && subpath != "subspace" && subpath != "subspace_page" {
+		return ErrVerification(fmt.Sprintf("%s queries return no proof", subpath))
+	}
+	if len(resp.Proof) == 0 {
+		return ErrVerification("the node returned no proof")
+	}
+
+	// the app hash of a height is committed in the header of the next height
+	header, err := v.CertifiedHeader(resp.Height + 1)
+	if err != nil {
+		return err
+	}
+
+	switch subpath {
+	case "subspace":
+		var kvs []store.KVPair
+		if err = cdc.UnmarshalBinary(resp.Value, &kvs); err != nil {
+			return ErrVerification(err.Error())
+		}
+		err = store.VerifyMultiStoreRangeProof(resp.Proof, storeName, key, sdk.PrefixEndBytes(key), 0, kvs, header.AppHash)
+	case "subspace_page":
+		var page store.SubspacePageQuery
+		var kvs []store.KVPair
+		if err = cdc.UnmarshalBinary(key, &page); err != nil {
+			return ErrVerification(err.Error())
+		}
+		if err = cdc.UnmarshalBinary(resp.Value, &kvs); err != nil {
+			return ErrVerification(err.Error())
+		}
+		err = store.VerifyMultiStoreRangeProof(resp.Proof, storeName, page.Start, sdk.PrefixEndBytes(page.Subspace), page.Limit, kvs, header.AppHash)
+	default:
+		err = store.VerifyMultiStoreProof(resp.Proof, storeName, key, resp.Value, header.AppHash)
+	}
+	if err != nil {
+		return ErrVerification(err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package context
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestVerifyQueryUnprovable(t *testing.T) {
+	// the paths checked before any header is certified
+	v := &Verifier{}
+
+	// queries outside the stores are not verified
+	err := v.VerifyQuery("/app/simulate", []byte("tx"), abci.ResponseQuery{})
+	require.NoError(t, err)
+
+	// nor are the subspace queries without proof
+	err = v.VerifyQuery("/store/acc/subspace", []byte("key"), abci.ResponseQuery{Height: 1})
+	require.True(t, IsVerificationError(err), "%v", err)
+	err = v.VerifyQuery("/store/stake/subspace_page", []byte("page"), abci.ResponseQuery{Height: 1})
+	require.True(t, IsVerificationError(err), "%v", err)
+
+	// neither does a dishonest node
+	err = v.VerifyQuery("/store/acc/key", []byte("key"), abci.ResponseQuery{Height: 1, Value: []byte("value")})
+	require.True(t, IsVerificationError(err), "%v", err)
+
+	require.False(t, IsVerificationError(ErrInvalidAccount(nil)))
+}
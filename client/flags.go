@@ -1,6 +1,21 @@
 package client
 
-import "github.com/spf13/cobra"
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+)
+
+// nolint
+const (
+	// GasFlagAuto is the value of the gas flag estimating the gas of a tx by
+	// simulating it
+	GasFlagAuto = "auto"
+
+	DefaultGasLimit      = 200000
+	DefaultGasAdjustment = 1.0
+)
 
 // nolint
 const (
@@ -9,6 +24,7 @@ const (
 	FlagNode          = "node"
 	FlagHeight        = "height"
 	FlagGas           = "gas"
+	FlagGasAdjustment = "gas-adjustment"
 	FlagTrustNode     = "trust-node"
 	FlagFrom          = "from"
 	FlagName          = "name"
@@ -16,9 +32,11 @@ const (
 	FlagSequence      = "sequence"
 	FlagMemo          = "memo"
 	FlagFee           = "fee"
+	FlagTimeoutHeight = "timeout-height"
 	FlagAsync         = "async"
 	FlagJson          = "json"
 	FlagPrintResponse = "print-response"
+	FlagGenerateOnly  = "generate-only"
 )
 
 // LineBreak can be included in a command list to provide a blank line
@@ -46,13 +64,48 @@ func PostCommands(cmds ...*cobra.Command) []*cobra.Command {
 		c.Flags().Int64(FlagSequence, 0, "Sequence number to sign the tx")
 		c.Flags().String(FlagMemo, "", "Memo to send along with transaction")
 		c.Flags().String(FlagFee, "", "Fee to pay along with transaction")
+		c.Flags().Int64(FlagTimeoutHeight, 0, "Block height after which the tx is no longer valid (0 for no timeout)")
 		c.Flags().String(FlagChainID, "", "Chain ID of tendermint node")
 		c.Flags().String(FlagNode, "tcp://localhost:26657", "<host>:<port> to tendermint rpc interface for this chain")
 		c.Flags().Bool(FlagUseLedger, false, "Use a connected Ledger device")
-		c.Flags().Int64(FlagGas, 200000, "gas limit to set per-transaction")
+		gas := gasValue(strconv.Itoa(DefaultGasLimit))
+		c.Flags().Var(&gas, FlagGas, fmt.Sprintf(
+			"gas limit to set per-transaction; set to %q to estimate it by simulating the transaction", GasFlagAuto))
+		c.Flags().Float64(FlagGasAdjustment, DefaultGasAdjustment,
+			"factor by which the estimated gas is multiplied to get the gas limit, used with --gas=auto")
 		c.Flags().Bool(FlagAsync, false, "broadcast transactions asynchronously")
 		c.Flags().Bool(FlagJson, false, "return output in json format")
 		c.Flags().Bool(FlagPrintResponse, false, "return tx response (only works with async = false)")
+		c.Flags().Bool(FlagGenerateOnly, false, "build an unsigned transaction and write it to STDOUT")
 	}
 	return cmds
 }
+
+// ParseGas parses the value of the gas flag, which is either a gas limit or
+// GasFlagAuto to estimate the gas by simulation.
+func ParseGas(s string) (simulate bool, gas int64, err error) {
+	if s == GasFlagAuto {
+		return true, 0, nil
+	}
+
+	gas, err = strconv.ParseInt(s, 10, 64)
+	if err != nil || gas < 0 {
+		return false, 0, fmt.Errorf("gas must be either a non-negative integer or %q", GasFlagAuto)
+	}
+	return false, gas, nil
+}
+
+// gasValue is the value of the gas flag, validated when the flag is parsed
+type gasValue string
+
+func (v *gasValue) Set(s string) error {
+	if _, _, err := ParseGas(s); err != nil {
+		return err
+	}
+	*v = gasValue(s)
+	return nil
+}
+
+func (v *gasValue) String() string { return string(*v) }
+
+func (v *gasValue) Type() string { return "string" }
@@ -2,7 +2,7 @@ package keys
 
 import (
 	"github.com/cosmos/cosmos-sdk/client"
-	"github.com/gorilla/mux"
+	"github.com/cosmos/cosmos-sdk/client/routes"
 	"github.com/spf13/cobra"
 )
 
@@ -30,11 +30,56 @@ func Commands() *cobra.Command {
 }
 
 // resgister REST routes
-func RegisterRoutes(r *mux.Router) {
-	r.HandleFunc("/keys", QueryKeysRequestHandler).Methods("GET")
-	r.HandleFunc("/keys", AddNewKeyRequestHandler).Methods("POST")
-	r.HandleFunc("/keys/seed", SeedRequestHandler).Methods("GET")
-	r.HandleFunc("/keys/{name}", GetKeyRequestHandler).Methods("GET")
-	r.HandleFunc("/keys/{name}", UpdateKeyRequestHandler).Methods("PUT")
-	r.HandleFunc("/keys/{name}", DeleteKeyRequestHandler).Methods("DELETE")
+func RegisterRoutes(r *routes.Router) {
+	r.Register(
+		routes.Route{
+			Name:     "ListKeys",
+			Method:   "GET",
+			Path:     "/keys",
+			Summary:  "List the keys of the keybase",
+			Response: new([]KeyOutput),
+			Handler:  QueryKeysRequestHandler,
+		},
+		routes.Route{
+			Name:     "AddKey",
+			Method:   "POST",
+			Path:     "/keys",
+			Summary:  "Add a key to the keybase, recovered from the seed if any",
+			Request:  &NewKeyBody{},
+			Response: new(KeyOutput),
+			Handler:  AddNewKeyRequestHandler,
+		},
+		routes.Route{
+			Name:     "NewSeed",
+			Method:   "GET",
+			Path:     "/keys/seed",
+			Summary:  "Generate a seed to recover a key from",
+			Response: new(string),
+			Handler:  SeedRequestHandler,
+		},
+		routes.Route{
+			Name:     "GetKey",
+			Method:   "GET",
+			Path:     "/keys/{name}",
+			Summary:  "Get a key of the keybase",
+			Response: new(KeyOutput),
+			Handler:  GetKeyRequestHandler,
+		},
+		routes.Route{
+			Name:    "UpdateKey",
+			Method:  "PUT",
+			Path:    "/keys/{name}",
+			Summary: "Update the password of a key",
+			Request: &UpdateKeyBody{},
+			Handler: UpdateKeyRequestHandler,
+		},
+		routes.Route{
+			Name:    "DeleteKey",
+			Method:  "DELETE",
+			Path:    "/keys/{name}",
+			Summary: "Delete a key of the keybase",
+			Request: &DeleteKeyBody{},
+			Handler: DeleteKeyRequestHandler,
+		},
+	)
 }
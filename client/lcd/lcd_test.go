@@ -2,9 +2,12 @@ package lcd
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"regexp"
+	"strings"
 	"testing"
 	"time"
 
@@ -20,11 +23,15 @@ import (
 
 	client "github.com/cosmos/cosmos-sdk/client"
 	keys "github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/client/lcd/restclient"
+	"github.com/cosmos/cosmos-sdk/client/routes"
 	rpc "github.com/cosmos/cosmos-sdk/client/rpc"
+	"github.com/cosmos/cosmos-sdk/client/utils"
 	tests "github.com/cosmos/cosmos-sdk/tests"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	bankrest "github.com/cosmos/cosmos-sdk/x/bank/client/rest"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/stake"
@@ -138,6 +145,96 @@ func TestVersion(t *testing.T) {
 	require.True(t, match, body)
 }
 
+func TestOpenAPI(t *testing.T) {
+	cleanup, _, port := InitializeTestLCD(t, 1, []sdk.AccAddress{})
+	defer cleanup()
+
+	res, body := Request(t, port, "GET", "/swagger.json", nil)
+	require.Equal(t, http.StatusOK, res.StatusCode, body)
+
+	var doc routes.Document
+	require.Nil(t, json.Unmarshal([]byte(body), &doc))
+	require.Equal(t, "2.0", doc.Swagger)
+
+	// every route is described
+	for _, route := range Routes(cdc) {
+		op, ok := doc.Paths[route.Path][strings.ToLower(route.Method)]
+		require.True(t, ok, "%s %s is not described", route.Method, route.Path)
+		require.Equal(t, route.Name, op.OperationID)
+	}
+
+	op := doc.Paths["/accounts/{address}/send"]["post"]
+	require.Equal(t, "address", op.Parameters[0].Name)
+	require.Equal(t, &routes.Schema{Ref: "#/definitions/rest.SendBody"}, op.Parameters[1].Schema)
+	require.Equal(t, &routes.Schema{Type: "string", Format: "int64"}, doc.Definitions["rest.SendBody"].Properties["sequence"])
+}
+
+func TestRESTClientGenerated(t *testing.T) {
+	src, err := routes.GenerateClient("restclient", Routes(wire.NewCodec()))
+	require.Nil(t, err)
+
+	generated, err := ioutil.ReadFile("restclient/routes.go")
+	require.Nil(t, err)
+	require.Equal(t, string(src), string(generated), "run go generate ./client/lcd/restclient")
+}
+
+func TestRESTClient(t *testing.T) {
+	name, password := "test", "1234567890"
+	addr, _ := CreateAddr(t, "test", password, GetKeyBase(t))
+	cleanup, _, port := InitializeTestLCD(t, 1, []sdk.AccAddress{addr})
+	defer cleanup()
+
+	c := restclient.NewClient(fmt.Sprintf("http://localhost:%s", port), cdc)
+
+	// plain text responses
+	version, err := c.GetVersion()
+	require.Nil(t, err)
+	require.Regexp(t, `\d+\.\d+\.\d+(-dev)?`, version)
+
+	key, err := c.GetKey(name)
+	require.Nil(t, err)
+	require.Equal(t, addr, key.Address)
+
+	acc, err := c.GetAccount(addr.String())
+	require.Nil(t, err)
+	initialBalance := acc.GetCoins()
+
+	bz, err := hex.DecodeString("8FA6AB57AD6870F6B5B2E57735F38F2F30E73CB6")
+	require.NoError(t, err)
+	receiveAddr := sdk.AccAddress(bz)
+
+	// the request bodies are amino encoded
+	resultTx, err := c.SendCoins(receiveAddr.String(), bankrest.SendBody{
+		Amount:           sdk.Coins{sdk.NewInt64Coin("steak", 1)},
+		LocalAccountName: name,
+		Password:         password,
+		ChainID:          viper.GetString(client.FlagChainID),
+		AccountNumber:    acc.GetAccountNumber(),
+		Sequence:         acc.GetSequence(),
+		Gas:              10000,
+	})
+	require.Nil(t, err)
+	require.Equal(t, uint32(0), resultTx.DeliverTx.Code)
+	tests.WaitForHeight(resultTx.Height+1, port)
+
+	info, err := c.GetTx(resultTx.Hash.String(), nil)
+	require.Nil(t, err)
+	require.Equal(t, resultTx.Height, info.Height)
+
+	acc, err = c.GetAccount(addr.String())
+	require.Nil(t, err)
+	require.Equal(t, initialBalance[0].Amount.SubRaw(1), acc.GetCoins()[0].Amount)
+	acc, err = c.GetAccount(receiveAddr.String())
+	require.Nil(t, err)
+	require.Equal(t, int64(1), acc.GetCoins()[0].Amount.Int64())
+
+	// the status of failed requests is returned
+	_, err = c.GetKey("unknown")
+	restErr, ok := err.(*restclient.Error)
+	require.True(t, ok, err)
+	require.Equal(t, http.StatusNotFound, restErr.StatusCode)
+}
+
 func TestNodeStatus(t *testing.T) {
 	cleanup, _, port := InitializeTestLCD(t, 1, []sdk.AccAddress{})
 	defer cleanup()
@@ -263,6 +360,174 @@ func TestCoinSend(t *testing.T) {
 
 	require.Equal(t, "steak", mycoins.Denom)
 	require.Equal(t, int64(1), mycoins.Amount.Int64())
+
+	// simulate a TX, which is not committed
+	acc = getAccount(t, port, addr)
+	gasEstimate := doSendSimulate(t, port, name, password, addr, receiveAddr, "1.5")
+	require.True(t, gasEstimate > 0)
+	require.Equal(t, acc.GetCoins(), getAccount(t, port, addr).GetCoins())
+
+	// the adjustment must be positive
+	payload := []byte(fmt.Sprintf(`{"name":"%s","password":"%s","amount":[],"chain_id":"%s","simulate":true,"gas_adjustment":"-1"}`,
+		name, password, viper.GetString(client.FlagChainID)))
+	res, body = Request(t, port, "POST", fmt.Sprintf("/accounts/%s/send", receiveAddr), payload)
+	require.Equal(t, http.StatusBadRequest, res.StatusCode, body)
+}
+
+func TestCoinSendGenerateSignAndBroadcast(t *testing.T) {
+	name, password := "test", "1234567890"
+	addr, seed := CreateAddr(t, "test", password, GetKeyBase(t))
+	cleanup, _, port := InitializeTestLCD(t, 1, []sdk.AccAddress{addr})
+	defer cleanup()
+
+	acc := getAccount(t, port, addr)
+	initialBalance := acc.GetCoins()
+
+	// generate the unsigned TX
+	receiveAddr, body := doSendGenerateOnly(t, port, seed, name, addr)
+	var msg auth.StdTx
+	require.Nil(t, cdc.UnmarshalJSON([]byte(body), &msg))
+	require.Equal(t, 1, len(msg.Msgs))
+	require.Equal(t, 0, len(msg.Signatures))
+
+	// sign it
+	chainID := viper.GetString(client.FlagChainID)
+	payload, err := cdc.MarshalJSON(tx.SignTxBody{
+		Name:          name,
+		Password:      password,
+		ChainID:       chainID,
+		AccountNumber: acc.GetAccountNumber(),
+		Sequence:      acc.GetSequence(),
+		Tx:            msg,
+	})
+	require.Nil(t, err)
+	res, body := Request(t, port, "POST", "/txs/sign", payload)
+	require.Equal(t, http.StatusOK, res.StatusCode, body)
+
+	var signedMsg auth.StdTx
+	require.Nil(t, cdc.UnmarshalJSON([]byte(body), &signedMsg))
+	require.Equal(t, 1, len(signedMsg.Signatures))
+	require.Equal(t, msg.Msgs, signedMsg.Msgs)
+
+	// a wrong password doesn't sign
+	payload, err = cdc.MarshalJSON(tx.SignTxBody{
+		Name:     name,
+		Password: "wrong",
+		ChainID:  chainID,
+		Tx:       msg,
+	})
+	require.Nil(t, err)
+	res, body = Request(t, port, "POST", "/txs/sign", payload)
+	require.Equal(t, http.StatusUnauthorized, res.StatusCode, body)
+
+	// broadcast it
+	payload, err = cdc.MarshalJSON(tx.BroadcastTxBody{Tx: signedMsg})
+	require.Nil(t, err)
+	res, body = Request(t, port, "POST", "/txs/broadcast", payload)
+	require.Equal(t, http.StatusOK, res.StatusCode, body)
+
+	var resultTx ctypes.ResultBroadcastTxCommit
+	require.Nil(t, cdc.UnmarshalJSON([]byte(body), &resultTx))
+	require.Equal(t, uint32(0), resultTx.CheckTx.Code)
+	require.Equal(t, uint32(0), resultTx.DeliverTx.Code)
+	tests.WaitForHeight(resultTx.Height+1, port)
+
+	acc = getAccount(t, port, addr)
+	require.Equal(t, initialBalance[0].Amount.SubRaw(1), acc.GetCoins()[0].Amount)
+	acc = getAccount(t, port, receiveAddr)
+	require.Equal(t, int64(1), acc.GetCoins()[0].Amount.Int64())
+}
+
+func TestCoinSendWithoutKeys(t *testing.T) {
+	name, password := "test", "1234567890"
+	kb := GetKeyBase(t)
+	addr, _ := CreateAddr(t, "test", password, kb)
+	cleanup, _, port := InitializeTestLCDWithoutKeys(t, 1, []sdk.AccAddress{addr})
+	defer cleanup()
+
+	// the keys are not served
+	res, body := Request(t, port, "GET", "/keys", nil)
+	require.Equal(t, http.StatusNotFound, res.StatusCode, body)
+
+	acc := getAccount(t, port, addr)
+	initialBalance := acc.GetCoins()
+
+	// get the TX to sign
+	bz, err := hex.DecodeString("8FA6AB57AD6870F6B5B2E57735F38F2F30E73CB6")
+	require.NoError(t, err)
+	receiveAddr := sdk.AccAddress(bz)
+	coinbz, err := cdc.MarshalJSON(sdk.NewInt64Coin("steak", 1))
+	require.Nil(t, err)
+
+	jsonStr := []byte(fmt.Sprintf(`{
+		"from":"%s",
+		"account_number":"%d",
+		"sequence":"%d",
+		"gas": "10000",
+		"amount":[%s],
+		"chain_id":"%s"
+	}`, addr, acc.GetAccountNumber(), acc.GetSequence(), coinbz, viper.GetString(client.FlagChainID)))
+	res, body = Request(t, port, "POST", fmt.Sprintf("/accounts/%s/send", receiveAddr), jsonStr)
+	require.Equal(t, http.StatusOK, res.StatusCode, body)
+
+	var unsigned utils.UnsignedTxResponse
+	require.Nil(t, cdc.UnmarshalJSON([]byte(body), &unsigned))
+	require.Equal(t, 0, len(unsigned.Tx.Signatures))
+
+	// sign it on the client
+	sig, pubkey, err := kb.Sign(name, password, []byte(unsigned.SignBytes))
+	require.Nil(t, err)
+	signedTx := auth.NewStdTx(unsigned.Tx.Msgs, unsigned.Tx.Fee, []auth.StdSignature{{
+		AccountNumber: acc.GetAccountNumber(),
+		Sequence:      acc.GetSequence(),
+		PubKey:        pubkey,
+		Signature:     sig,
+	}}, unsigned.Tx.Memo, unsigned.Tx.TimeoutHeight)
+
+	// broadcast it
+	payload, err := cdc.MarshalJSON(tx.BroadcastTxBody{Tx: signedTx})
+	require.Nil(t, err)
+	res, body = Request(t, port, "POST", "/txs/broadcast", payload)
+	require.Equal(t, http.StatusOK, res.StatusCode, body)
+
+	var resultTx ctypes.ResultBroadcastTxCommit
+	require.Nil(t, cdc.UnmarshalJSON([]byte(body), &resultTx))
+	require.Equal(t, uint32(0), resultTx.DeliverTx.Code)
+	tests.WaitForHeight(resultTx.Height+1, port)
+
+	acc = getAccount(t, port, addr)
+	require.Equal(t, initialBalance[0].Amount.SubRaw(1), acc.GetCoins()[0].Amount)
+	acc = getAccount(t, port, receiveAddr)
+	require.Equal(t, int64(1), acc.GetCoins()[0].Amount.Int64())
+}
+
+func TestVerifyingLCD(t *testing.T) {
+	name, password := "test", "1234567890"
+	addr, seed := CreateAddr(t, "test", password, GetKeyBase(t))
+	cleanup, _, port := InitializeVerifyingTestLCD(t, 1, []sdk.AccAddress{addr})
+	defer cleanup()
+
+	// the account is proven against the certified headers
+	acc := getAccount(t, port, addr)
+	require.Equal(t, int64(100), acc.GetCoins().AmountOf("steak").Int64())
+
+	// so is the absence of an account
+	bz, err := hex.DecodeString("8FA6AB57AD6870F6B5B2E57735F38F2F30E73CB6")
+	require.NoError(t, err)
+	res, body := Request(t, port, "GET", fmt.Sprintf("/accounts/%s", sdk.AccAddress(bz)), nil)
+	require.Equal(t, http.StatusNoContent, res.StatusCode, body)
+
+	// the state after a TX is proven against later headers
+	receiveAddr, resultTx := doSend(t, port, seed, name, password, addr)
+	require.Equal(t, uint32(0), resultTx.DeliverTx.Code)
+	tests.WaitForHeight(resultTx.Height+1, port)
+
+	acc = getAccount(t, port, receiveAddr)
+	require.Equal(t, int64(1), acc.GetCoins().AmountOf("steak").Int64())
+
+	// so are all the pairs of a subspace
+	validators := getValidators(t, port)
+	require.Equal(t, 1, len(validators))
 }
 
 func TestIBCTransfer(t *testing.T) {
@@ -419,6 +684,15 @@ func TestBonding(t *testing.T) {
 	bondedValidator := getDelegatorValidator(t, port, addr, validator1Operator)
 	require.Equal(t, validator1Operator, bondedValidator.Operator)
 
+	foundBond := false
+	for _, delegation := range getValidatorDelegations(t, port, validator1Operator) {
+		if delegation.DelegatorAddr.String() == addr.String() {
+			require.Equal(t, "60.0000000000", delegation.Shares)
+			foundBond = true
+		}
+	}
+	require.True(t, foundBond, "Validator delegations hold the delegation")
+
 	//////////////////////
 	// testing unbonding
 
@@ -712,6 +986,57 @@ func doSend(t *testing.T, port, seed, name, password string, addr sdk.AccAddress
 	return receiveAddr, resultTx
 }
 
+func doSendSimulate(t *testing.T, port, name, password string, addr, receiveAddr sdk.AccAddress, gasAdjustment string) int64 {
+	acc := getAccount(t, port, addr)
+	chainID := viper.GetString(client.FlagChainID)
+	coinbz, err := cdc.MarshalJSON(sdk.NewInt64Coin("steak", 1))
+	require.Nil(t, err)
+
+	jsonStr := []byte(fmt.Sprintf(`{
+		"name":"%s",
+		"password":"%s",
+		"account_number":"%d",
+		"sequence":"%d",
+		"amount":[%s],
+		"chain_id":"%s",
+		"simulate":true,
+		"gas_adjustment":"%s"
+	}`, name, password, acc.GetAccountNumber(), acc.GetSequence(), coinbz, chainID, gasAdjustment))
+	res, body := Request(t, port, "POST", fmt.Sprintf("/accounts/%s/send", receiveAddr), jsonStr)
+	require.Equal(t, http.StatusOK, res.StatusCode, body)
+
+	var result struct {
+		GasEstimate int64 `json:"gas_estimate"`
+	}
+	require.Nil(t, cdc.UnmarshalJSON([]byte(body), &result))
+	return result.GasEstimate
+}
+
+func doSendGenerateOnly(t *testing.T, port, seed, name string, addr sdk.AccAddress) (receiveAddr sdk.AccAddress, body string) {
+	// create receive address
+	kb := client.MockKeyBase()
+	receiveInfo, _, err := kb.CreateMnemonic("receive_address", cryptoKeys.English, "1234567890", cryptoKeys.SigningAlgo("secp256k1"))
+	require.Nil(t, err)
+	receiveAddr = sdk.AccAddress(receiveInfo.GetPubKey().Address())
+
+	chainID := viper.GetString(client.FlagChainID)
+	coinbz, err := cdc.MarshalJSON(sdk.NewInt64Coin("steak", 1))
+	require.Nil(t, err)
+
+	// no password is needed to only generate the tx
+	jsonStr := []byte(fmt.Sprintf(`{
+		"name":"%s",
+		"gas": "10000",
+		"amount":[%s],
+		"chain_id":"%s",
+		"generate_only":true
+	}`, name, coinbz, chainID))
+	res, body := Request(t, port, "POST", fmt.Sprintf("/accounts/%s/send", receiveAddr), jsonStr)
+	require.Equal(t, http.StatusOK, res.StatusCode, body)
+
+	return receiveAddr, body
+}
+
 func doIBCTransfer(t *testing.T, port, seed, name, password string, addr sdk.AccAddress) (resultTx ctypes.ResultBroadcastTxCommit) {
 	// create receive address
 	kb := client.MockKeyBase()
@@ -829,6 +1154,17 @@ func getDelegatorValidators(t *testing.T, port string, delegatorAddr sdk.AccAddr
 	return bondedValidators
 }
 
+func getValidatorDelegations(t *testing.T, port string, validatorAddr sdk.AccAddress) []rest.DelegationWithoutRat {
+	res, body := Request(t, port, "GET", fmt.Sprintf("/stake/validators/%s/delegations", validatorAddr), nil)
+	require.Equal(t, http.StatusOK, res.StatusCode, body)
+
+	var delegations []rest.DelegationWithoutRat
+	err := cdc.UnmarshalJSON([]byte(body), &delegations)
+	require.Nil(t, err)
+
+	return delegations
+}
+
 func getDelegatorValidator(t *testing.T, port string, delegatorAddr sdk.AccAddress, validatorAddr sdk.AccAddress) stake.BechValidator {
 	res, body := Request(t, port, "GET", fmt.Sprintf("/stake/delegators/%s/validators/%s", delegatorAddr, validatorAddr), nil)
 	require.Equal(t, http.StatusOK, res.StatusCode, body)
@@ -0,0 +1,21 @@
+package lcd
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/routes"
+)
+
+// OpenAPI document REST handler endpoint
+func OpenAPIRequestHandler(doc routes.Document) http.HandlerFunc {
+	output, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(output)
+	}
+}
@@ -0,0 +1,101 @@
+// Package restclient is a Go client of the REST server (LCD), whose methods
+// are generated from the routes of the server by running go generate.
+package restclient
+
+//go:generate go run gen/main.go
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/cosmos/cosmos-sdk/wire"
+)
+
+// Client calls the routes of a REST server
+type Client struct {
+	url  string
+	cdc  *wire.Codec
+	http *http.Client
+}
+
+// NewClient returns a client of the REST server at the URL, ex.
+// http://localhost:1317, encoding the requests and decoding the responses
+// with the codec, which must register the interfaces they hold
+func NewClient(url string, cdc *wire.Codec) *Client {
+	return &Client{
+		url:  url,
+		cdc:  cdc,
+		http: &http.Client{},
+	}
+}
+
+// Error is returned by the methods of a client when the server responds
+// with a status other than 200 OK or 204 No Content
+type Error struct {
+	StatusCode int
+	Body       string
+}
+
+// implements error
+func (err *Error) Error() string {
+	return fmt.Sprintf("%d %s: %s", err.StatusCode, http.StatusText(err.StatusCode), err.Body)
+}
+
+// calls a route, encoding req unless nil as JSON and decoding the response
+// into resp unless nil, or setting resp to the plain text response if a
+// *string. resp is left unchanged if the server responds with no content.
+func (c *Client) do(method, path string, query url.Values, req, resp interface{}) error {
+	var body io.Reader
+	if req != nil {
+		bz, err := c.cdc.MarshalJSON(req)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(bz)
+	}
+
+	u := c.url + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	httpReq, err := http.NewRequest(method, u, body)
+	if err != nil {
+		return err
+	}
+	if req != nil {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := c.http.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	bz, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusNoContent:
+		return nil
+	default:
+		return &Error{StatusCode: res.StatusCode, Body: string(bz)}
+	}
+
+	if resp == nil {
+		return nil
+	}
+	if s, ok := resp.(*string); ok {
+		*s = string(bz)
+		return nil
+	}
+	return c.cdc.UnmarshalJSON(bz, resp)
+}
@@ -0,0 +1,27 @@
+// Command gen generates the methods of the REST client from the routes of
+// the REST server, into the routes.go file of the working directory.
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client/lcd"
+	"github.com/cosmos/cosmos-sdk/client/routes"
+	"github.com/cosmos/cosmos-sdk/wire"
+)
+
+func main() {
+	src, err := routes.GenerateClient("restclient", lcd.Routes(wire.NewCodec()))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	err = ioutil.WriteFile("routes.go", src, 0644)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
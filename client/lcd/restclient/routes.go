@@ -0,0 +1,365 @@
+// Code generated by routes.GenerateClient. DO NOT EDIT.
+
+package restclient
+
+import (
+	"net/url"
+
+	keys "github.com/cosmos/cosmos-sdk/client/keys"
+	rpc "github.com/cosmos/cosmos-sdk/client/rpc"
+	tx "github.com/cosmos/cosmos-sdk/client/tx"
+	cosmossdktypes "github.com/cosmos/cosmos-sdk/types"
+	auth "github.com/cosmos/cosmos-sdk/x/auth"
+	bankrest "github.com/cosmos/cosmos-sdk/x/bank/client/rest"
+	evidence "github.com/cosmos/cosmos-sdk/x/evidence"
+	gov "github.com/cosmos/cosmos-sdk/x/gov"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	ibcrest "github.com/cosmos/cosmos-sdk/x/ibc/client/rest"
+	slashing "github.com/cosmos/cosmos-sdk/x/slashing"
+	slashingrest "github.com/cosmos/cosmos-sdk/x/slashing/client/rest"
+	stakerest "github.com/cosmos/cosmos-sdk/x/stake/client/rest"
+	staketypes "github.com/cosmos/cosmos-sdk/x/stake/types"
+	p2p "github.com/tendermint/tendermint/p2p"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+// ListKeys calls GET /keys
+// List the keys of the keybase
+func (c *Client) ListKeys() (resp []keys.KeyOutput, err error) {
+	err = c.do("GET", "/keys", nil, nil, &resp)
+	return
+}
+
+// AddKey calls POST /keys
+// Add a key to the keybase, recovered from the seed if any
+func (c *Client) AddKey(req keys.NewKeyBody) (resp keys.KeyOutput, err error) {
+	err = c.do("POST", "/keys", nil, req, &resp)
+	return
+}
+
+// NewSeed calls GET /keys/seed
+// Generate a seed to recover a key from
+func (c *Client) NewSeed() (resp string, err error) {
+	err = c.do("GET", "/keys/seed", nil, nil, &resp)
+	return
+}
+
+// GetKey calls GET /keys/{name}
+// Get a key of the keybase
+func (c *Client) GetKey(name string) (resp keys.KeyOutput, err error) {
+	err = c.do("GET", "/keys/"+url.PathEscape(name), nil, nil, &resp)
+	return
+}
+
+// UpdateKey calls PUT /keys/{name}
+// Update the password of a key
+func (c *Client) UpdateKey(name string, req keys.UpdateKeyBody) error {
+	return c.do("PUT", "/keys/"+url.PathEscape(name), nil, req, nil)
+}
+
+// DeleteKey calls DELETE /keys/{name}
+// Delete a key of the keybase
+func (c *Client) DeleteKey(name string, req keys.DeleteKeyBody) error {
+	return c.do("DELETE", "/keys/"+url.PathEscape(name), nil, req, nil)
+}
+
+// GetVersion calls GET /version
+// Get the version of the REST server
+func (c *Client) GetVersion() (resp string, err error) {
+	err = c.do("GET", "/version", nil, nil, &resp)
+	return
+}
+
+// GetNodeVersion calls GET /node_version
+// Get the version of the application of the node
+func (c *Client) GetNodeVersion() (resp string, err error) {
+	err = c.do("GET", "/node_version", nil, nil, &resp)
+	return
+}
+
+// GetNodeInfo calls GET /node_info
+// Get the information of the node
+func (c *Client) GetNodeInfo() (resp p2p.NodeInfo, err error) {
+	err = c.do("GET", "/node_info", nil, nil, &resp)
+	return
+}
+
+// GetSyncing calls GET /syncing
+// Get whether the node is catching up with the chain
+func (c *Client) GetSyncing() (resp bool, err error) {
+	err = c.do("GET", "/syncing", nil, nil, &resp)
+	return
+}
+
+// GetLatestBlock calls GET /blocks/latest
+// Get the latest block
+func (c *Client) GetLatestBlock() (resp coretypes.ResultBlock, err error) {
+	err = c.do("GET", "/blocks/latest", nil, nil, &resp)
+	return
+}
+
+// GetBlock calls GET /blocks/{height}
+// Get the block of a height
+func (c *Client) GetBlock(height string) (resp coretypes.ResultBlock, err error) {
+	err = c.do("GET", "/blocks/"+url.PathEscape(height), nil, nil, &resp)
+	return
+}
+
+// GetLatestValidatorSet calls GET /validatorsets/latest
+// Get the latest validator set
+func (c *Client) GetLatestValidatorSet() (resp rpc.ResultValidatorsOutput, err error) {
+	err = c.do("GET", "/validatorsets/latest", nil, nil, &resp)
+	return
+}
+
+// GetValidatorSet calls GET /validatorsets/{height}
+// Get the validator set of a height
+func (c *Client) GetValidatorSet(height string) (resp rpc.ResultValidatorsOutput, err error) {
+	err = c.do("GET", "/validatorsets/"+url.PathEscape(height), nil, nil, &resp)
+	return
+}
+
+// GetTx calls GET /txs/{hash}
+// Get a transaction by its hex encoded hash
+func (c *Client) GetTx(hash string, query url.Values) (resp tx.Info, err error) {
+	err = c.do("GET", "/txs/"+url.PathEscape(hash), query, nil, &resp)
+	return
+}
+
+// SearchTxs calls GET /txs
+// Search the transactions by a tag, as key=value
+func (c *Client) SearchTxs(query url.Values) (resp []tx.Info, err error) {
+	err = c.do("GET", "/txs", query, nil, &resp)
+	return
+}
+
+// SignTx calls POST /txs/sign
+// Sign a transaction with a key of the keybase
+func (c *Client) SignTx(req tx.SignTxBody) (resp auth.StdTx, err error) {
+	err = c.do("POST", "/txs/sign", nil, req, &resp)
+	return
+}
+
+// BroadcastTx calls POST /txs/broadcast
+// Broadcast a signed transaction
+func (c *Client) BroadcastTx(req tx.BroadcastTxBody) (resp coretypes.ResultBroadcastTxCommit, err error) {
+	err = c.do("POST", "/txs/broadcast", nil, req, &resp)
+	return
+}
+
+// GetAccount calls GET /accounts/{address}
+// Get an account, empty if it does not exist
+func (c *Client) GetAccount(address string) (resp auth.Account, err error) {
+	err = c.do("GET", "/accounts/"+url.PathEscape(address), nil, nil, &resp)
+	return
+}
+
+// SendCoins calls POST /accounts/{address}/send
+// Send coins to an address
+func (c *Client) SendCoins(address string, req bankrest.SendBody) (resp coretypes.ResultBroadcastTxCommit, err error) {
+	err = c.do("POST", "/accounts/"+url.PathEscape(address)+"/send", nil, req, &resp)
+	return
+}
+
+// GetSupply calls GET /bank/supply/{denom}
+// Get the total supply of a denomination
+func (c *Client) GetSupply(denom string) (resp cosmossdktypes.Coin, err error) {
+	err = c.do("GET", "/bank/supply/"+url.PathEscape(denom), nil, nil, &resp)
+	return
+}
+
+// Transfer calls POST /ibc/{destchain}/{address}/send
+// Transfer coins to an address of another chain
+func (c *Client) Transfer(destchain string, address string, req ibcrest.TransferBody) (resp coretypes.ResultBroadcastTxCommit, err error) {
+	err = c.do("POST", "/ibc/"+url.PathEscape(destchain)+"/"+url.PathEscape(address)+"/send", nil, req, &resp)
+	return
+}
+
+// GetDelegator calls GET /stake/delegators/{delegatorAddr}
+// Get the delegations, unbonding delegations and redelegations of a delegator
+func (c *Client) GetDelegator(delegatorAddr string) (resp stakerest.DelegationSummary, err error) {
+	err = c.do("GET", "/stake/delegators/"+url.PathEscape(delegatorAddr), nil, nil, &resp)
+	return
+}
+
+// GetDelegatorTxs calls GET /stake/delegators/{delegatorAddr}/txs
+// Get the staking txs of a delegator, filtered by the space separated types bond, unbond and redelegate
+func (c *Client) GetDelegatorTxs(delegatorAddr string, query url.Values) (resp []tx.Info, err error) {
+	err = c.do("GET", "/stake/delegators/"+url.PathEscape(delegatorAddr)+"/txs", query, nil, &resp)
+	return
+}
+
+// GetDelegatorValidators calls GET /stake/delegators/{delegatorAddr}/validators
+// Get the validators a delegator is bonded to
+func (c *Client) GetDelegatorValidators(delegatorAddr string, query url.Values) (resp []staketypes.BechValidator, err error) {
+	err = c.do("GET", "/stake/delegators/"+url.PathEscape(delegatorAddr)+"/validators", query, nil, &resp)
+	return
+}
+
+// GetDelegatorValidator calls GET /stake/delegators/{delegatorAddr}/validators/{validatorAddr}
+// Get a validator a delegator is bonded to
+func (c *Client) GetDelegatorValidator(delegatorAddr string, validatorAddr string) (resp staketypes.BechValidator, err error) {
+	err = c.do("GET", "/stake/delegators/"+url.PathEscape(delegatorAddr)+"/validators/"+url.PathEscape(validatorAddr), nil, nil, &resp)
+	return
+}
+
+// GetDelegation calls GET /stake/delegators/{delegatorAddr}/delegations/{validatorAddr}
+// Get the delegation of a delegator to a validator
+func (c *Client) GetDelegation(delegatorAddr string, validatorAddr string) (resp stakerest.DelegationWithoutRat, err error) {
+	err = c.do("GET", "/stake/delegators/"+url.PathEscape(delegatorAddr)+"/delegations/"+url.PathEscape(validatorAddr), nil, nil, &resp)
+	return
+}
+
+// GetUnbondingDelegations calls GET /stake/delegators/{delegatorAddr}/unbonding_delegations/{validatorAddr}
+// Get the unbonding delegations of a delegator from a validator
+func (c *Client) GetUnbondingDelegations(delegatorAddr string, validatorAddr string) (resp []staketypes.UnbondingDelegation, err error) {
+	err = c.do("GET", "/stake/delegators/"+url.PathEscape(delegatorAddr)+"/unbonding_delegations/"+url.PathEscape(validatorAddr), nil, nil, &resp)
+	return
+}
+
+// GetValidators calls GET /stake/validators
+// Get the validators
+func (c *Client) GetValidators(query url.Values) (resp []staketypes.BechValidator, err error) {
+	err = c.do("GET", "/stake/validators", query, nil, &resp)
+	return
+}
+
+// GetValidator calls GET /stake/validators/{addr}
+// Get a validator
+func (c *Client) GetValidator(addr string) (resp staketypes.BechValidator, err error) {
+	err = c.do("GET", "/stake/validators/"+url.PathEscape(addr), nil, nil, &resp)
+	return
+}
+
+// GetValidatorDelegations calls GET /stake/validators/{addr}/delegations
+// Get the delegations to a validator
+func (c *Client) GetValidatorDelegations(addr string, query url.Values) (resp []stakerest.DelegationWithoutRat, err error) {
+	err = c.do("GET", "/stake/validators/"+url.PathEscape(addr)+"/delegations", query, nil, &resp)
+	return
+}
+
+// GetValidatorUnbondingDelegations calls GET /stake/validators/{addr}/unbonding_delegations
+// Get the unbonding delegations from a validator
+func (c *Client) GetValidatorUnbondingDelegations(addr string, query url.Values) (resp []staketypes.UnbondingDelegation, err error) {
+	err = c.do("GET", "/stake/validators/"+url.PathEscape(addr)+"/unbonding_delegations", query, nil, &resp)
+	return
+}
+
+// GetValidatorRedelegationsFrom calls GET /stake/validators/{addr}/redelegations_from
+// Get the redelegations from a validator
+func (c *Client) GetValidatorRedelegationsFrom(addr string, query url.Values) (resp []staketypes.Redelegation, err error) {
+	err = c.do("GET", "/stake/validators/"+url.PathEscape(addr)+"/redelegations_from", query, nil, &resp)
+	return
+}
+
+// GetValidatorRedelegationsTo calls GET /stake/validators/{addr}/redelegations_to
+// Get the redelegations to a validator
+func (c *Client) GetValidatorRedelegationsTo(addr string, query url.Values) (resp []staketypes.Redelegation, err error) {
+	err = c.do("GET", "/stake/validators/"+url.PathEscape(addr)+"/redelegations_to", query, nil, &resp)
+	return
+}
+
+// GetValidatorSelfDelegation calls GET /stake/validators/{addr}/self_delegation
+// Get the self-delegation of a validator
+func (c *Client) GetValidatorSelfDelegation(addr string) (resp stakerest.DelegationWithoutRat, err error) {
+	err = c.do("GET", "/stake/validators/"+url.PathEscape(addr)+"/self_delegation", nil, nil, &resp)
+	return
+}
+
+// GetHistoricalInfo calls GET /stake/historical_info/{height}
+// Get the header and validator set of a recent block
+func (c *Client) GetHistoricalInfo(height string) (resp staketypes.HistoricalInfo, err error) {
+	err = c.do("GET", "/stake/historical_info/"+url.PathEscape(height), nil, nil, &resp)
+	return
+}
+
+// EditDelegations calls POST /stake/delegators/{delegatorAddr}/delegations
+// Delegate, unbond and redelegate in a single transaction
+func (c *Client) EditDelegations(delegatorAddr string, req stakerest.EditDelegationsBody) (resp []coretypes.ResultBroadcastTxCommit, err error) {
+	err = c.do("POST", "/stake/delegators/"+url.PathEscape(delegatorAddr)+"/delegations", nil, req, &resp)
+	return
+}
+
+// GetSigningInfo calls GET /slashing/signing_info/{validator}
+// Get the signing info of a validator by its bech32 public key
+func (c *Client) GetSigningInfo(validator string) (resp slashing.ValidatorSigningInfo, err error) {
+	err = c.do("GET", "/slashing/signing_info/"+url.PathEscape(validator), nil, nil, &resp)
+	return
+}
+
+// GetValidatorEvents calls GET /slashing/validators/{validator}/events
+// Get the slash events and missed blocks of a validator
+func (c *Client) GetValidatorEvents(validator string) (resp slashing.ValidatorEvents, err error) {
+	err = c.do("GET", "/slashing/validators/"+url.PathEscape(validator)+"/events", nil, nil, &resp)
+	return
+}
+
+// Unrevoke calls POST /slashing/unrevoke
+// Unrevoke a validator
+func (c *Client) Unrevoke(req slashingrest.UnrevokeBody) (resp coretypes.ResultBroadcastTxCommit, err error) {
+	err = c.do("POST", "/slashing/unrevoke", nil, req, &resp)
+	return
+}
+
+// GetEvidence calls GET /evidence/{hash}
+// Get a piece of evidence by its hex encoded hash
+func (c *Client) GetEvidence(hash string) (resp evidence.Evidence, err error) {
+	err = c.do("GET", "/evidence/"+url.PathEscape(hash), nil, nil, &resp)
+	return
+}
+
+// SubmitProposal calls POST /gov/proposals
+// Submit a proposal along with an initial deposit
+func (c *Client) SubmitProposal(req govrest.PostProposalReq) (resp coretypes.ResultBroadcastTxCommit, err error) {
+	err = c.do("POST", "/gov/proposals", nil, req, &resp)
+	return
+}
+
+// Deposit calls POST /gov/proposals/{proposalID}/deposits
+// Deposit on a proposal
+func (c *Client) Deposit(proposalID string, req govrest.DepositReq) (resp coretypes.ResultBroadcastTxCommit, err error) {
+	err = c.do("POST", "/gov/proposals/"+url.PathEscape(proposalID)+"/deposits", nil, req, &resp)
+	return
+}
+
+// Vote calls POST /gov/proposals/{proposalID}/votes
+// Vote on a proposal
+func (c *Client) Vote(proposalID string, req govrest.VoteReq) (resp coretypes.ResultBroadcastTxCommit, err error) {
+	err = c.do("POST", "/gov/proposals/"+url.PathEscape(proposalID)+"/votes", nil, req, &resp)
+	return
+}
+
+// GetProposal calls GET /gov/proposals/{proposalID}
+// Get a proposal
+func (c *Client) GetProposal(proposalID string) (resp gov.Proposal, err error) {
+	err = c.do("GET", "/gov/proposals/"+url.PathEscape(proposalID), nil, nil, &resp)
+	return
+}
+
+// GetDeposit calls GET /gov/proposals/{proposalID}/deposits/{depositer}
+// Get the deposit of a depositer on a proposal
+func (c *Client) GetDeposit(proposalID string, depositer string) (resp gov.Deposit, err error) {
+	err = c.do("GET", "/gov/proposals/"+url.PathEscape(proposalID)+"/deposits/"+url.PathEscape(depositer), nil, nil, &resp)
+	return
+}
+
+// GetVote calls GET /gov/proposals/{proposalID}/votes/{voter}
+// Get the vote of a voter on a proposal
+func (c *Client) GetVote(proposalID string, voter string) (resp gov.Vote, err error) {
+	err = c.do("GET", "/gov/proposals/"+url.PathEscape(proposalID)+"/votes/"+url.PathEscape(voter), nil, nil, &resp)
+	return
+}
+
+// GetVotes calls GET /gov/proposals/{proposalID}/votes
+// Get the votes on a proposal
+func (c *Client) GetVotes(proposalID string) (resp []gov.Vote, err error) {
+	err = c.do("GET", "/gov/proposals/"+url.PathEscape(proposalID)+"/votes", nil, nil, &resp)
+	return
+}
+
+// GetProposals calls GET /gov/proposals
+// Get the proposals, filtered by voter, depositer and status
+func (c *Client) GetProposals(query url.Values) (resp []gov.Proposal, err error) {
+	err = c.do("GET", "/gov/proposals", query, nil, &resp)
+	return
+}
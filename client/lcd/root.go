@@ -1,24 +1,32 @@
 package lcd
 
 import (
+	"encoding/hex"
 	"net/http"
 	"os"
+	"path/filepath"
 
 	client "github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	keys "github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/client/routes"
 	rpc "github.com/cosmos/cosmos-sdk/client/rpc"
 	tx "github.com/cosmos/cosmos-sdk/client/tx"
+	cryptokeys "github.com/cosmos/cosmos-sdk/crypto/keys"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/wire"
 	auth "github.com/cosmos/cosmos-sdk/x/auth/client/rest"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/client/rest"
+	evidence "github.com/cosmos/cosmos-sdk/x/evidence/client/rest"
 	gov "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
 	ibc "github.com/cosmos/cosmos-sdk/x/ibc/client/rest"
 	slashing "github.com/cosmos/cosmos-sdk/x/slashing/client/rest"
 	stake "github.com/cosmos/cosmos-sdk/x/stake/client/rest"
 	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
 	cmn "github.com/tendermint/tendermint/libs/common"
 	"github.com/tendermint/tendermint/libs/log"
 	tmserver "github.com/tendermint/tendermint/rpc/lib/server"
@@ -31,13 +39,48 @@ func ServeCommand(cdc *wire.Codec) *cobra.Command {
 	flagListenAddr := "laddr"
 	flagCORS := "cors"
 	flagMaxOpenConnections := "max-open"
+	flagInsecureKeys := "insecure-keys"
+	flagTrustHeight := "trust-height"
+	flagTrustHash := "trust-hash"
 
 	cmd := &cobra.Command{
 		Use:   "rest-server",
 		Short: "Start LCD (light-client daemon), a local REST server",
+		Long: `Start LCD (light-client daemon), a local REST server.
+
+By default the server holds no keys: the tx endpoints return the unsigned
+transaction along with the bytes to sign, and /txs/broadcast accepts the signed
+transaction. With --insecure-keys, the keys of the local keybase are served
+under /keys and the tx endpoints sign with the key named in the request.
+
+Unless --trust-node is set, the responses of the node to store queries are
+verified against the headers certified by a light client, seeded from the
+header of --trust-height whose hash is --trust-hash. The light client stores
+the commits it trusts under the home directory.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			listenAddr := viper.GetString(flagListenAddr)
-			handler := createHandler(cdc)
+
+			var verifier *context.Verifier
+			if !viper.GetBool(client.FlagTrustNode) {
+				trustHeight := viper.GetInt64(flagTrustHeight)
+				trustHash, err := hex.DecodeString(viper.GetString(flagTrustHash))
+				if err != nil {
+					return errors.Wrap(err, "invalid trusted hash")
+				}
+				if trustHeight <= 0 || len(trustHash) == 0 {
+					return errors.Errorf("set --%s and --%s to verify the node, or --%s to trust it",
+						flagTrustHeight, flagTrustHash, client.FlagTrustNode)
+				}
+
+				chainID := viper.GetString(client.FlagChainID)
+				rootDir := filepath.Join(viper.GetString(cli.HomeFlag), "lite", chainID)
+				verifier, err = context.NewVerifier(chainID, rootDir, context.NewCLIContext().Client, trustHeight, trustHash)
+				if err != nil {
+					return err
+				}
+			}
+
+			handler := createHandler(cdc, verifier, viper.GetBool(flagInsecureKeys))
 			logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", "rest-server")
 			maxOpen := viper.GetInt(flagMaxOpenConnections)
 
@@ -66,33 +109,85 @@ func ServeCommand(cdc *wire.Codec) *cobra.Command {
 	cmd.Flags().String(client.FlagChainID, "", "The chain ID to connect to")
 	cmd.Flags().String(client.FlagNode, "tcp://localhost:26657", "Address of the node to connect to")
 	cmd.Flags().Int(flagMaxOpenConnections, 1000, "The number of maximum open connections")
+	cmd.Flags().Bool(flagInsecureKeys, false, "Serve the local keybase and sign transactions on the server")
+	cmd.Flags().Bool(client.FlagTrustNode, false, "Don't verify the responses of the node")
+	cmd.Flags().Int64(flagTrustHeight, 0, "Height of the trusted header seeding the light client")
+	cmd.Flags().String(flagTrustHash, "", "Hex encoded hash of the trusted header seeding the light client")
 
 	return cmd
 }
 
-func createHandler(cdc *wire.Codec) http.Handler {
+// createHandler returns the handler of the REST routes, verifying the
+// responses of the node with the verifier if not nil and only signing with
+// the keys of the local keybase if insecureKeys is set
+func createHandler(cdc *wire.Codec, verifier *context.Verifier, insecureKeys bool) http.Handler {
 	r := mux.NewRouter()
 
-	kb, err := keys.GetKeyBase() //XXX
-	if err != nil {
-		panic(err)
+	var kb cryptokeys.Keybase
+	if insecureKeys {
+		var err error
+		kb, err = keys.GetKeyBase() //XXX
+		if err != nil {
+			panic(err)
+		}
 	}
 
-	cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout)
+	cliCtx := context.NewCLIContext().
+		WithCodec(cdc).
+		WithLogger(os.Stdout).
+		WithTrustNode(verifier == nil).
+		WithVerifier(verifier)
+
+	router := routes.NewRouter(r)
+	registerRoutes(cliCtx, router, cdc, kb)
+
+	doc := routes.NewDocument(routes.Info{Title: "Cosmos SDK REST server", Version: version.GetVersion()}, router.Routes())
+	r.HandleFunc("/swagger.json", OpenAPIRequestHandler(doc)).Methods("GET")
+
+	return r
+}
+
+// registerRoutes registers the REST routes of the modules, those of the keys
+// only if the server holds the keybase
+func registerRoutes(cliCtx context.CLIContext, r *routes.Router, cdc *wire.Codec, kb cryptokeys.Keybase) {
+	if kb != nil {
+		keys.RegisterRoutes(r)
+	}
 
-	// TODO: make more functional? aka r = keys.RegisterRoutes(r)
-	r.HandleFunc("/version", CLIVersionRequestHandler).Methods("GET")
-	r.HandleFunc("/node_version", NodeVersionRequestHandler(cliCtx)).Methods("GET")
+	r.Register(
+		routes.Route{
+			Name:     "GetVersion",
+			Method:   "GET",
+			Path:     "/version",
+			Summary:  "Get the version of the REST server",
+			Response: new(string),
+			Handler:  CLIVersionRequestHandler,
+		},
+		routes.Route{
+			Name:     "GetNodeVersion",
+			Method:   "GET",
+			Path:     "/node_version",
+			Summary:  "Get the version of the application of the node",
+			Response: new(string),
+			Handler:  NodeVersionRequestHandler(cliCtx),
+		},
+	)
 
-	keys.RegisterRoutes(r)
 	rpc.RegisterRoutes(cliCtx, r)
-	tx.RegisterRoutes(cliCtx, r, cdc)
+	tx.RegisterRoutes(cliCtx, r, cdc, kb)
 	auth.RegisterRoutes(cliCtx, r, cdc, "acc")
 	bank.RegisterRoutes(cliCtx, r, cdc, kb)
 	ibc.RegisterRoutes(cliCtx, r, cdc, kb)
 	stake.RegisterRoutes(cliCtx, r, cdc, kb)
 	slashing.RegisterRoutes(cliCtx, r, cdc, kb)
-	gov.RegisterRoutes(cliCtx, r, cdc)
+	evidence.RegisterRoutes(cliCtx, r, cdc)
+	gov.RegisterRoutes(cliCtx, r, cdc, kb)
+}
 
-	return r
+// Routes returns the REST routes of a server holding keys, from which the
+// client of the restclient package is generated
+func Routes(cdc *wire.Codec) []routes.Route {
+	r := routes.NewRouter(mux.NewRouter())
+	registerRoutes(context.NewCLIContext().WithCodec(cdc), r, cdc, client.MockKeyBase())
+	return r.Routes()
 }
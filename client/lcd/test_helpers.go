@@ -11,8 +11,10 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
 	keys "github.com/cosmos/cosmos-sdk/client/keys"
 	gapp "github.com/cosmos/cosmos-sdk/cmd/gaia/app"
 	crkeys "github.com/cosmos/cosmos-sdk/crypto/keys"
@@ -35,6 +37,7 @@ import (
 	nm "github.com/tendermint/tendermint/node"
 	pvm "github.com/tendermint/tendermint/privval"
 	"github.com/tendermint/tendermint/proxy"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
 	tmrpc "github.com/tendermint/tendermint/rpc/lib/server"
 	tmtypes "github.com/tendermint/tendermint/types"
 )
@@ -115,6 +118,22 @@ func CreateAddr(t *testing.T, name, password string, kb crkeys.Keybase) (sdk.Acc
 // and initAddrs are the accounts to initialize with some steak tokens. It
 // returns a cleanup function, a set of validator public keys, and a port.
 func InitializeTestLCD(t *testing.T, nValidators int, initAddrs []sdk.AccAddress) (func(), []crypto.PubKey, string) {
+	return initializeTestLCD(t, nValidators, initAddrs, true, false)
+}
+
+// InitializeTestLCDWithoutKeys is like InitializeTestLCD, but the LCD holds
+// no keys and doesn't sign transactions.
+func InitializeTestLCDWithoutKeys(t *testing.T, nValidators int, initAddrs []sdk.AccAddress) (func(), []crypto.PubKey, string) {
+	return initializeTestLCD(t, nValidators, initAddrs, false, false)
+}
+
+// InitializeVerifyingTestLCD is like InitializeTestLCD, but the LCD verifies
+// the responses of the node with a light client seeded from its first block.
+func InitializeVerifyingTestLCD(t *testing.T, nValidators int, initAddrs []sdk.AccAddress) (func(), []crypto.PubKey, string) {
+	return initializeTestLCD(t, nValidators, initAddrs, true, true)
+}
+
+func initializeTestLCD(t *testing.T, nValidators int, initAddrs []sdk.AccAddress, insecureKeys, verify bool) (func(), []crypto.PubKey, string) {
 	config := GetConfig()
 	config.Consensus.TimeoutCommit = 100
 	config.Consensus.SkipTimeoutCommit = false
@@ -190,7 +209,12 @@ func InitializeTestLCD(t *testing.T, nValidators int, initAddrs []sdk.AccAddress
 	node, err := startTM(config, logger, genDoc, privVal, app)
 	require.NoError(t, err)
 
-	lcd, err := startLCD(logger, listenAddr, cdc)
+	var verifier *context.Verifier
+	if verify {
+		verifier = newTestVerifier(t, genDoc.ChainID, config)
+	}
+
+	lcd, err := startLCD(logger, listenAddr, cdc, verifier, insecureKeys)
 	require.NoError(t, err)
 
 	tests.WaitForLCDStart(port)
@@ -241,11 +265,31 @@ func startTM(
 	return node, err
 }
 
+// newTestVerifier returns a verifier of the responses of the node, seeded from
+// its first block.
+func newTestVerifier(t *testing.T, chainID string, config *tmcfg.Config) *context.Verifier {
+	node := rpcclient.NewHTTP(config.RPC.ListenAddress, "/websocket")
+
+	// wait for the first block to be committed
+	height := int64(1)
+	commit, err := node.Commit(&height)
+	for i := 0; err != nil && i < 100; i++ {
+		time.Sleep(100 * time.Millisecond)
+		commit, err = node.Commit(&height)
+	}
+	require.NoError(t, err)
+
+	verifier, err := context.NewVerifier(chainID, filepath.Join(config.RootDir, "lite"), node, height, commit.Header.Hash())
+	require.NoError(t, err)
+
+	return verifier
+}
+
 // startLCD starts the LCD.
 //
 // NOTE: This causes the thread to block.
-func startLCD(logger log.Logger, listenAddr string, cdc *wire.Codec) (net.Listener, error) {
-	return tmrpc.StartHTTPServer(listenAddr, createHandler(cdc), logger, tmrpc.Config{})
+func startLCD(logger log.Logger, listenAddr string, cdc *wire.Codec, verifier *context.Verifier, insecureKeys bool) (net.Listener, error) {
+	return tmrpc.StartHTTPServer(listenAddr, createHandler(cdc, verifier, insecureKeys), logger, tmrpc.Config{})
 }
 
 // Request makes a test LCD test request. It returns a response object and a
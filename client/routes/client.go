@@ -0,0 +1,256 @@
+package routes
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// GenerateClient returns the Go source, in the package of the given name, of
+// a method of a REST client for each route, named after it. The request and
+// response of the methods are of the types registered by the routes, and the
+// package must declare the client as:
+//
+//	type Client struct { ... }
+//
+//	// calls the route, JSON encoding req and decoding the response into resp
+//	// unless nil, or setting resp to the plain text response if a *string
+//	func (c *Client) do(method, path string, query url.Values, req, resp interface{}) error
+func GenerateClient(pkg string, routes []Route) ([]byte, error) {
+	imports := newImports()
+
+	var methods bytes.Buffer
+	for _, route := range routes {
+		method, err := generateMethod(route, imports)
+		if err != nil {
+			return nil, fmt.Errorf("route %s: %v", route.Name, err)
+		}
+		methods.WriteString(method)
+	}
+
+	aliases := imports.aliases()
+	body := methods.String()
+	for path, alias := range aliases {
+		body = strings.Replace(body, importPlaceholder(path), alias, -1)
+	}
+
+	var src bytes.Buffer
+	fmt.Fprintf(&src, "// Code generated by routes.GenerateClient. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&src, "package %s\n\n", pkg)
+	src.WriteString(imports.decl(aliases))
+	src.WriteString(body)
+
+	return format.Source(src.Bytes())
+}
+
+func generateMethod(route Route, imports *imports) (string, error) {
+	var params []string
+
+	// the path is built from its parameters
+	path := fmt.Sprintf("%q", route.Path)
+	for _, name := range PathParams(route.Path) {
+		params = append(params, name+" string")
+		path = strings.Replace(path, "{"+name+"}", `"+url.PathEscape(`+name+`)+"`, 1)
+		imports.add("net/url")
+	}
+	path = strings.TrimSuffix(strings.TrimPrefix(path, `""+`), `+""`)
+
+	query := "nil"
+	if len(route.Query) > 0 {
+		params = append(params, "query url.Values")
+		query = "query"
+		imports.add("net/url")
+	}
+
+	req := "nil"
+	if route.Request != nil {
+		reqType, err := imports.typeExpr(reflect.TypeOf(route.Request).Elem())
+		if err != nil {
+			return "", err
+		}
+		params = append(params, "req "+reqType)
+		req = "req"
+	}
+
+	var m bytes.Buffer
+	fmt.Fprintf(&m, "// %s calls %s %s\n", route.Name, route.Method, route.Path)
+	if route.Summary != "" {
+		fmt.Fprintf(&m, "// %s\n", route.Summary)
+	}
+
+	if route.Response == nil {
+		fmt.Fprintf(&m, "func (c *Client) %s(%s) error {\n", route.Name, strings.Join(params, ", "))
+		fmt.Fprintf(&m, "return c.do(%q, %s, %s, %s, nil)\n}\n\n", route.Method, path, query, req)
+		return m.String(), nil
+	}
+
+	respType, err := imports.typeExpr(reflect.TypeOf(route.Response).Elem())
+	if err != nil {
+		return "", err
+	}
+	fmt.Fprintf(&m, "func (c *Client) %s(%s) (resp %s, err error) {\n", route.Name, strings.Join(params, ", "), respType)
+	fmt.Fprintf(&m, "err = c.do(%q, %s, %s, %s, &resp)\nreturn\n}\n\n", route.Method, path, query, req)
+	return m.String(), nil
+}
+
+// imports of the generated source, aliased to be unique
+type imports struct {
+	paths map[string]bool
+}
+
+func newImports() *imports {
+	return &imports{paths: make(map[string]bool)}
+}
+
+func (im *imports) add(path string) {
+	im.paths[path] = true
+}
+
+// returns the Go expression of a type, importing the packages of its named
+// types, which must be exported
+func (im *imports) typeExpr(t reflect.Type) (string, error) {
+	if t.Name() != "" {
+		if t.PkgPath() == "" {
+			return t.Name(), nil
+		}
+		if !ast.IsExported(t.Name()) {
+			return "", fmt.Errorf("type %s is not exported", t)
+		}
+		im.add(t.PkgPath())
+		return fmt.Sprintf("%s.%s", importPlaceholder(t.PkgPath()), t.Name()), nil
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		elem, err := im.typeExpr(t.Elem())
+		return "*" + elem, err
+	case reflect.Slice:
+		elem, err := im.typeExpr(t.Elem())
+		return "[]" + elem, err
+	case reflect.Array:
+		elem, err := im.typeExpr(t.Elem())
+		return fmt.Sprintf("[%d]%s", t.Len(), elem), err
+	case reflect.Map:
+		key, err := im.typeExpr(t.Key())
+		if err != nil {
+			return "", err
+		}
+		elem, err := im.typeExpr(t.Elem())
+		return fmt.Sprintf("map[%s]%s", key, elem), err
+	default:
+		return "", fmt.Errorf("type %s is not supported", t)
+	}
+}
+
+// the aliases of the imports are only known once all are added, so type
+// expressions refer to their packages by placeholders until then
+func importPlaceholder(path string) string {
+	return "<" + path + ">"
+}
+
+var nonIdentifierRegexp = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+// returns the aliases of the imported packages. A package is aliased after
+// the last element of its path, prefixed if shared by other packages with the
+// elements that differ between their paths, ex. .../x/bank/client/rest is
+// aliased to bankrest along with .../x/gov/client/rest
+func (im *imports) aliases() map[string]string {
+	byLast := make(map[string][]string)
+	for path := range im.paths {
+		elems := strings.Split(path, "/")
+		last := elems[len(elems)-1]
+		byLast[last] = append(byLast[last], path)
+	}
+
+	aliases := make(map[string]string)
+	for last, paths := range byLast {
+		names := make([]string, len(paths))
+		for i := range paths {
+			names[i] = last
+		}
+
+		for depth := 2; !unique(names); depth++ {
+			elems := make([]string, len(paths))
+			exhausted := true
+			for i, path := range paths {
+				split := strings.Split(path, "/")
+				if depth <= len(split) {
+					elems[i] = split[len(split)-depth]
+					exhausted = false
+				}
+			}
+			if exhausted {
+				break
+			}
+			if allEqual(elems) {
+				continue
+			}
+			for i := range names {
+				names[i] = elems[i] + names[i]
+			}
+		}
+
+		for i, path := range paths {
+			aliases[path] = nonIdentifierRegexp.ReplaceAllString(names[i], "")
+		}
+	}
+	return aliases
+}
+
+// returns the import declaration, with the packages of the standard library
+// first
+func (im *imports) decl(aliases map[string]string) string {
+	if len(im.paths) == 0 {
+		return ""
+	}
+
+	var std, others []string
+	for path := range im.paths {
+		if strings.Contains(strings.Split(path, "/")[0], ".") {
+			others = append(others, path)
+		} else {
+			std = append(std, path)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(others)
+
+	var decl bytes.Buffer
+	decl.WriteString("import (\n")
+	for _, path := range std {
+		fmt.Fprintf(&decl, "%q\n", path)
+	}
+	if len(std) > 0 && len(others) > 0 {
+		decl.WriteString("\n")
+	}
+	for _, path := range others {
+		fmt.Fprintf(&decl, "%s %q\n", aliases[path], path)
+	}
+	decl.WriteString(")\n\n")
+	return decl.String()
+}
+
+func unique(names []string) bool {
+	seen := make(map[string]bool)
+	for _, name := range names {
+		if seen[name] {
+			return false
+		}
+		seen[name] = true
+	}
+	return true
+}
+
+func allEqual(elems []string) bool {
+	for _, elem := range elems {
+		if elem != elems[0] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,231 @@
+package routes
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Document is an OpenAPI 2.0 document, describing the routes of a REST server
+type Document struct {
+	Swagger     string                          `json:"swagger"`
+	Info        Info                            `json:"info"`
+	Consumes    []string                        `json:"consumes"`
+	Produces    []string                        `json:"produces"`
+	Paths       map[string]map[string]Operation `json:"paths"`
+	Definitions map[string]*Schema              `json:"definitions"`
+}
+
+// Info describes the API of an OpenAPI document
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// Operation describes a route of an OpenAPI document
+type Operation struct {
+	OperationID string              `json:"operationId"`
+	Summary     string              `json:"summary,omitempty"`
+	Produces    []string            `json:"produces,omitempty"`
+	Parameters  []Parameter         `json:"parameters,omitempty"`
+	Responses   map[string]Response `json:"responses"`
+}
+
+// Parameter describes a path, query or body parameter of an operation
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Type     string  `json:"type,omitempty"`
+	Schema   *Schema `json:"schema,omitempty"`
+}
+
+// Response describes a response of an operation
+type Response struct {
+	Description string  `json:"description"`
+	Schema      *Schema `json:"schema,omitempty"`
+}
+
+// Schema describes a JSON value
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+}
+
+var pathParamRegexp = regexp.MustCompile(`{([^}]+)}`)
+
+// PathParams returns the names of the parameters of a path template
+func PathParams(path string) []string {
+	var params []string
+	for _, match := range pathParamRegexp.FindAllStringSubmatch(path, -1) {
+		params = append(params, match[1])
+	}
+	return params
+}
+
+// NewDocument returns the OpenAPI document of the routes, whose bodies are
+// described as encoded by amino JSON
+func NewDocument(info Info, routes []Route) Document {
+	doc := Document{
+		Swagger:     "2.0",
+		Info:        info,
+		Consumes:    []string{"application/json"},
+		Produces:    []string{"application/json"},
+		Paths:       make(map[string]map[string]Operation),
+		Definitions: make(map[string]*Schema),
+	}
+	schemas := newSchemaBuilder(doc.Definitions)
+
+	for _, route := range routes {
+		op := Operation{
+			OperationID: route.Name,
+			Summary:     route.Summary,
+			Responses:   make(map[string]Response),
+		}
+
+		for _, name := range PathParams(route.Path) {
+			op.Parameters = append(op.Parameters, Parameter{Name: name, In: "path", Required: true, Type: "string"})
+		}
+		for _, name := range route.Query {
+			op.Parameters = append(op.Parameters, Parameter{Name: name, In: "query", Type: "string"})
+		}
+		if route.Request != nil {
+			op.Parameters = append(op.Parameters, Parameter{
+				Name:     "body",
+				In:       "body",
+				Required: true,
+				Schema:   schemas.schema(reflect.TypeOf(route.Request).Elem()),
+			})
+		}
+
+		ok := Response{Description: "OK"}
+		if route.Response != nil {
+			t := reflect.TypeOf(route.Response).Elem()
+			if t.Kind() == reflect.String {
+				op.Produces = []string{"text/plain"}
+			}
+			ok.Schema = schemas.schema(t)
+		}
+		op.Responses["200"] = ok
+
+		if doc.Paths[route.Path] == nil {
+			doc.Paths[route.Path] = make(map[string]Operation)
+		}
+		doc.Paths[route.Path][strings.ToLower(route.Method)] = op
+	}
+
+	return doc
+}
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	timeType          = reflect.TypeOf(time.Time{})
+)
+
+// builds the schemas of the amino JSON encoding of types, adding the named
+// structs to the definitions
+type schemaBuilder struct {
+	definitions map[string]*Schema
+	names       map[reflect.Type]string
+}
+
+func newSchemaBuilder(definitions map[string]*Schema) *schemaBuilder {
+	return &schemaBuilder{
+		definitions: definitions,
+		names:       make(map[reflect.Type]string),
+	}
+}
+
+func (b *schemaBuilder) schema(t reflect.Type) *Schema {
+	if t == timeType {
+		return &Schema{Type: "string", Format: "date-time"}
+	}
+	// types with a custom encoding, ex. bech32 addresses, are encoded as strings
+	if t.Kind() != reflect.Interface &&
+		(t.Implements(jsonMarshalerType) || reflect.PtrTo(t).Implements(jsonMarshalerType)) {
+		return &Schema{Type: "string"}
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return b.schema(t.Elem())
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64:
+		// amino encodes 64 bit integers as strings
+		return &Schema{Type: "string", Format: "int64"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: b.schema(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: b.schema(t.Elem())}
+	case reflect.Interface:
+		// amino encodes the registered concrete type of interfaces along with
+		// their value
+		return &Schema{Type: "object", Properties: map[string]*Schema{
+			"type":  {Type: "string"},
+			"value": {Type: "object"},
+		}}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return b.structSchema(t)
+		}
+		return &Schema{Ref: "#/definitions/" + b.define(t)}
+	default:
+		return &Schema{}
+	}
+}
+
+// adds the definition of a named struct, returning its name
+func (b *schemaBuilder) define(t reflect.Type) string {
+	if name, ok := b.names[t]; ok {
+		return name
+	}
+
+	// named after its package, or its whole path if two packages have the
+	// same name
+	path := strings.Split(t.PkgPath(), "/")
+	name := path[len(path)-1] + "." + t.Name()
+	if _, ok := b.definitions[name]; ok {
+		name = strings.Join(path, ".") + "." + t.Name()
+	}
+
+	// registered before being built for recursive types
+	b.names[t] = name
+	b.definitions[name] = &Schema{}
+	*b.definitions[name] = *b.structSchema(t)
+	return name
+}
+
+func (b *schemaBuilder) structSchema(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue // unexported
+		}
+
+		name := field.Name
+		if tag := strings.Split(field.Tag.Get("json"), ",")[0]; tag == "-" {
+			continue
+		} else if tag != "" {
+			name = tag
+		}
+		schema.Properties[name] = b.schema(field.Type)
+	}
+	return schema
+}
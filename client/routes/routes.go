@@ -0,0 +1,68 @@
+package routes
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// Route is a REST route along with the metadata describing it, from which
+// the OpenAPI document of the REST server and its Go client are generated.
+type Route struct {
+	// name of the operation, and of its method in the generated client
+	Name   string
+	Method string
+	// gorilla mux path template, ex. /accounts/{address}
+	Path    string
+	Summary string
+	// names of the optional query parameters
+	Query []string
+
+	// pointers to values of the types of the JSON request and response
+	// bodies, nil if none, ex. new(auth.Account). A string response is
+	// written as plain text.
+	Request  interface{}
+	Response interface{}
+
+	Handler http.HandlerFunc
+}
+
+// Router registers routes to a mux router and keeps their metadata
+type Router struct {
+	mux    *mux.Router
+	routes []Route
+	names  map[string]bool
+}
+
+// NewRouter returns a router registering routes to the mux router
+func NewRouter(r *mux.Router) *Router {
+	return &Router{
+		mux:   r,
+		names: make(map[string]bool),
+	}
+}
+
+// Register registers the routes. It panics if the name of a route is empty
+// or already registered.
+func (r *Router) Register(routes ...Route) {
+	for _, route := range routes {
+		if route.Name == "" {
+			panic(fmt.Sprintf("route %s %s has no name", route.Method, route.Path))
+		}
+		if r.names[route.Name] {
+			panic(fmt.Sprintf("route %s is already registered", route.Name))
+		}
+		r.names[route.Name] = true
+
+		r.mux.HandleFunc(route.Path, route.Handler).Methods(route.Method)
+		r.routes = append(r.routes, route)
+	}
+}
+
+// Routes returns the registered routes, in the order of their registration
+func (r *Router) Routes() []Route {
+	routes := make([]Route, len(r.routes))
+	copy(routes, r.routes)
+	return routes
+}
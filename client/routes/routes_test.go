@@ -0,0 +1,197 @@
+package routes
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+)
+
+type testAddress []byte
+
+func (a testAddress) MarshalJSON() ([]byte, error) {
+	return []byte(`"addr"`), nil
+}
+
+type testInterface interface {
+	Test()
+}
+
+type testNested struct {
+	Amount int64 `json:"amount"`
+}
+
+type TestRequest struct {
+	Name     string        `json:"name"`
+	Count    int64         `json:"count"`
+	Small    int32         `json:"small"`
+	Flag     bool          `json:"flag,omitempty"`
+	Address  testAddress   `json:"address"`
+	Time     time.Time     `json:"time"`
+	Bytes    []byte        `json:"bytes"`
+	Nested   []testNested  `json:"nested"`
+	Any      testInterface `json:"any"`
+	Skipped  string        `json:"-"`
+	NoTag    string
+	internal string
+}
+
+type TestResponse struct {
+	Self *TestResponse `json:"self"`
+}
+
+func testHandler(body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(body))
+	}
+}
+
+func TestRouterRegister(t *testing.T) {
+	r := NewRouter(mux.NewRouter())
+	r.Register(
+		Route{Name: "Get", Method: "GET", Path: "/things/{id}", Handler: testHandler("get")},
+		Route{Name: "Post", Method: "POST", Path: "/things/{id}", Handler: testHandler("post")},
+	)
+
+	routes := r.Routes()
+	require.Equal(t, 2, len(routes))
+	require.Equal(t, "Get", routes[0].Name)
+	require.Equal(t, "Post", routes[1].Name)
+
+	// the handlers are registered to the mux router by method
+	for method, body := range map[string]string{"GET": "get", "POST": "post"} {
+		w := httptest.NewRecorder()
+		r.mux.ServeHTTP(w, httptest.NewRequest(method, "/things/1", nil))
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, body, w.Body.String())
+	}
+
+	require.Panics(t, func() {
+		r.Register(Route{Name: "Get", Method: "GET", Path: "/other", Handler: testHandler("")})
+	})
+	require.Panics(t, func() {
+		r.Register(Route{Method: "GET", Path: "/other", Handler: testHandler("")})
+	})
+}
+
+func TestPathParams(t *testing.T) {
+	require.Equal(t, []string{"a", "b"}, PathParams("/x/{a}/y/{b}"))
+	require.Nil(t, PathParams("/x"))
+}
+
+func TestNewDocument(t *testing.T) {
+	doc := NewDocument(Info{Title: "test", Version: "1"}, []Route{
+		{
+			Name:     "PostThing",
+			Method:   "POST",
+			Path:     "/things/{id}",
+			Summary:  "Post a thing",
+			Query:    []string{"page"},
+			Request:  &TestRequest{},
+			Response: new(TestResponse),
+		},
+		{
+			Name:     "GetVersion",
+			Method:   "GET",
+			Path:     "/version",
+			Response: new(string),
+		},
+	})
+
+	op := doc.Paths["/things/{id}"]["post"]
+	require.Equal(t, "PostThing", op.OperationID)
+	require.Equal(t, []Parameter{
+		{Name: "id", In: "path", Required: true, Type: "string"},
+		{Name: "page", In: "query", Type: "string"},
+		{Name: "body", In: "body", Required: true, Schema: &Schema{Ref: "#/definitions/routes.TestRequest"}},
+	}, op.Parameters)
+	require.Equal(t, &Schema{Ref: "#/definitions/routes.TestResponse"}, op.Responses["200"].Schema)
+
+	// the request is described as encoded by amino JSON
+	request := doc.Definitions["routes.TestRequest"]
+	require.Equal(t, "object", request.Type)
+	require.Equal(t, 10, len(request.Properties))
+	require.Equal(t, &Schema{Type: "string"}, request.Properties["name"])
+	require.Equal(t, &Schema{Type: "string", Format: "int64"}, request.Properties["count"])
+	require.Equal(t, &Schema{Type: "integer"}, request.Properties["small"])
+	require.Equal(t, &Schema{Type: "boolean"}, request.Properties["flag"])
+	require.Equal(t, &Schema{Type: "string"}, request.Properties["address"])
+	require.Equal(t, &Schema{Type: "string", Format: "date-time"}, request.Properties["time"])
+	require.Equal(t, &Schema{Type: "string", Format: "byte"}, request.Properties["bytes"])
+	require.Equal(t, &Schema{Type: "array", Items: &Schema{Ref: "#/definitions/routes.testNested"}}, request.Properties["nested"])
+	require.Equal(t, "object", request.Properties["any"].Type)
+	require.Equal(t, &Schema{Type: "string"}, request.Properties["NoTag"])
+
+	// recursive types refer to their own definition
+	require.Equal(t, &Schema{Ref: "#/definitions/routes.TestResponse"}, doc.Definitions["routes.TestResponse"].Properties["self"])
+
+	// string responses are plain text
+	op = doc.Paths["/version"]["get"]
+	require.Equal(t, []string{"text/plain"}, op.Produces)
+	require.Equal(t, &Schema{Type: "string"}, op.Responses["200"].Schema)
+}
+
+func TestGenerateClient(t *testing.T) {
+	src, err := GenerateClient("client", []Route{
+		{
+			Name:     "PostThing",
+			Method:   "POST",
+			Path:     "/things/{id}/post",
+			Summary:  "Post a thing",
+			Query:    []string{"page"},
+			Request:  &TestRequest{},
+			Response: new([]TestResponse),
+		},
+		{
+			Name:   "DeleteThing",
+			Method: "DELETE",
+			Path:   "/things/{id}",
+		},
+	})
+	require.Nil(t, err)
+
+	require.True(t, strings.HasPrefix(string(src), "// Code generated by routes.GenerateClient. DO NOT EDIT.\n\npackage client\n"))
+	require.Contains(t, string(src), `routes "github.com/cosmos/cosmos-sdk/client/routes"`)
+	require.Contains(t, string(src), `func (c *Client) PostThing(id string, query url.Values, req routes.TestRequest) (resp []routes.TestResponse, err error) {
+	err = c.do("POST", "/things/"+url.PathEscape(id)+"/post", query, req, &resp)
+	return
+}`)
+	require.Contains(t, string(src), `func (c *Client) DeleteThing(id string) error {
+	return c.do("DELETE", "/things/"+url.PathEscape(id), nil, nil, nil)
+}`)
+
+	// the types must be exported
+	_, err = GenerateClient("client", []Route{
+		{Name: "GetNested", Method: "GET", Path: "/nested", Response: new(testNested)},
+	})
+	require.NotNil(t, err)
+}
+
+func TestImportAliases(t *testing.T) {
+	im := newImports()
+	for _, path := range []string{
+		"net/url",
+		"github.com/cosmos/cosmos-sdk/types",
+		"github.com/cosmos/cosmos-sdk/x/stake/types",
+		"github.com/tendermint/tendermint/types",
+		"github.com/tendermint/tendermint/rpc/core/types",
+		"github.com/cosmos/cosmos-sdk/x/bank/client/rest",
+		"github.com/cosmos/cosmos-sdk/x/gov/client/rest",
+	} {
+		im.add(path)
+	}
+
+	require.Equal(t, map[string]string{
+		"net/url":                                         "url",
+		"github.com/cosmos/cosmos-sdk/types":              "cosmossdktypes",
+		"github.com/cosmos/cosmos-sdk/x/stake/types":      "staketypes",
+		"github.com/tendermint/tendermint/types":          "tenderminttypes",
+		"github.com/tendermint/tendermint/rpc/core/types": "coretypes",
+		"github.com/cosmos/cosmos-sdk/x/bank/client/rest": "bankrest",
+		"github.com/cosmos/cosmos-sdk/x/gov/client/rest":  "govrest",
+	}, im.aliases())
+}
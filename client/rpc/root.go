@@ -1,12 +1,14 @@
 package rpc
 
 import (
-	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/routes"
+	"github.com/tendermint/tendermint/p2p"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 )
 
 const (
@@ -44,11 +46,55 @@ func initClientCommand() *cobra.Command {
 }
 
 // Register REST endpoints
-func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
-	r.HandleFunc("/node_info", NodeInfoRequestHandlerFn(cliCtx)).Methods("GET")
-	r.HandleFunc("/syncing", NodeSyncingRequestHandlerFn(cliCtx)).Methods("GET")
-	r.HandleFunc("/blocks/latest", LatestBlockRequestHandlerFn(cliCtx)).Methods("GET")
-	r.HandleFunc("/blocks/{height}", BlockRequestHandlerFn(cliCtx)).Methods("GET")
-	r.HandleFunc("/validatorsets/latest", LatestValidatorSetRequestHandlerFn(cliCtx)).Methods("GET")
-	r.HandleFunc("/validatorsets/{height}", ValidatorSetRequestHandlerFn(cliCtx)).Methods("GET")
+func RegisterRoutes(cliCtx context.CLIContext, r *routes.Router) {
+	r.Register(
+		routes.Route{
+			Name:     "GetNodeInfo",
+			Method:   "GET",
+			Path:     "/node_info",
+			Summary:  "Get the information of the node",
+			Response: new(p2p.NodeInfo),
+			Handler:  NodeInfoRequestHandlerFn(cliCtx),
+		},
+		routes.Route{
+			Name:     "GetSyncing",
+			Method:   "GET",
+			Path:     "/syncing",
+			Summary:  "Get whether the node is catching up with the chain",
+			Response: new(bool),
+			Handler:  NodeSyncingRequestHandlerFn(cliCtx),
+		},
+		routes.Route{
+			Name:     "GetLatestBlock",
+			Method:   "GET",
+			Path:     "/blocks/latest",
+			Summary:  "Get the latest block",
+			Response: new(ctypes.ResultBlock),
+			Handler:  LatestBlockRequestHandlerFn(cliCtx),
+		},
+		routes.Route{
+			Name:     "GetBlock",
+			Method:   "GET",
+			Path:     "/blocks/{height}",
+			Summary:  "Get the block of a height",
+			Response: new(ctypes.ResultBlock),
+			Handler:  BlockRequestHandlerFn(cliCtx),
+		},
+		routes.Route{
+			Name:     "GetLatestValidatorSet",
+			Method:   "GET",
+			Path:     "/validatorsets/latest",
+			Summary:  "Get the latest validator set",
+			Response: new(ResultValidatorsOutput),
+			Handler:  LatestValidatorSetRequestHandlerFn(cliCtx),
+		},
+		routes.Route{
+			Name:     "GetValidatorSet",
+			Method:   "GET",
+			Path:     "/validatorsets/{height}",
+			Summary:  "Get the validator set of a height",
+			Response: new(ResultValidatorsOutput),
+			Handler:  ValidatorSetRequestHandlerFn(cliCtx),
+		},
+	)
 }
@@ -1,37 +1,59 @@
 package tx
 
 import (
-	"encoding/json"
+	"io/ioutil"
 	"net/http"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 )
 
-// Tx Broadcast Body
+// BroadcastTxBody is the REST request body broadcasting a signed transaction
 type BroadcastTxBody struct {
-	TxBytes string `json:"tx"`
+	Tx auth.StdTx `json:"tx"`
 }
 
-// BroadcastTx REST Handler
-func BroadcastTxRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+// BroadcastTxRequestHandlerFn returns the REST handler broadcasting a signed
+// transaction, and writing the result of its commit
+func BroadcastTxRequestHandlerFn(cdc *wire.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var m BroadcastTxBody
 
-		decoder := json.NewDecoder(r.Body)
-		err := decoder.Decode(&m)
+		body, err := ioutil.ReadAll(r.Body)
 		if err != nil {
-			w.WriteHeader(400)
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		err = cdc.UnmarshalJSON(body, &m)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		txBytes, err := cdc.MarshalBinary(m.Tx)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		res, err := cliCtx.BroadcastTx(txBytes)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
 			w.Write([]byte(err.Error()))
 			return
 		}
 
-		res, err := cliCtx.BroadcastTx([]byte(m.TxBytes))
+		output, err := wire.MarshalJSONIndent(cdc, res)
 		if err != nil {
-			w.WriteHeader(500)
+			w.WriteHeader(http.StatusInternalServerError)
 			w.Write([]byte(err.Error()))
 			return
 		}
 
-		w.Write([]byte(string(res.Height)))
+		w.Write(output)
 	}
 }
@@ -1,11 +1,15 @@
 package tx
 
 import (
-	"github.com/gorilla/mux"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/routes"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
 	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 )
 
 // AddCommands adds a number of tx-query related subcommands
@@ -16,10 +20,46 @@ func AddCommands(cmd *cobra.Command, cdc *wire.Codec) {
 	)
 }
 
-// register REST routes
-func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *wire.Codec) {
-	r.HandleFunc("/txs/{hash}", QueryTxRequestHandlerFn(cdc, cliCtx)).Methods("GET")
-	r.HandleFunc("/txs", SearchTxRequestHandlerFn(cliCtx, cdc)).Methods("GET")
-	// r.HandleFunc("/txs/sign", SignTxRequstHandler).Methods("POST")
-	// r.HandleFunc("/txs/broadcast", BroadcastTxRequestHandler).Methods("POST")
+// register REST routes, signing transactions only if the server holds keys
+func RegisterRoutes(cliCtx context.CLIContext, r *routes.Router, cdc *wire.Codec, kb keys.Keybase) {
+	r.Register(
+		routes.Route{
+			Name:     "GetTx",
+			Method:   "GET",
+			Path:     "/txs/{hash}",
+			Summary:  "Get a transaction by its hex encoded hash",
+			Query:    []string{"trust_node"},
+			Response: new(Info),
+			Handler:  QueryTxRequestHandlerFn(cdc, cliCtx),
+		},
+		routes.Route{
+			Name:     "SearchTxs",
+			Method:   "GET",
+			Path:     "/txs",
+			Summary:  "Search the transactions by a tag, as key=value",
+			Query:    []string{"tag"},
+			Response: new([]Info),
+			Handler:  SearchTxRequestHandlerFn(cliCtx, cdc),
+		},
+	)
+	if kb != nil {
+		r.Register(routes.Route{
+			Name:     "SignTx",
+			Method:   "POST",
+			Path:     "/txs/sign",
+			Summary:  "Sign a transaction with a key of the keybase",
+			Request:  &SignTxBody{},
+			Response: new(auth.StdTx),
+			Handler:  SignTxRequestHandlerFn(cdc),
+		})
+	}
+	r.Register(routes.Route{
+		Name:     "BroadcastTx",
+		Method:   "POST",
+		Path:     "/txs/broadcast",
+		Summary:  "Broadcast a signed transaction",
+		Request:  &BroadcastTxBody{},
+		Response: new(ctypes.ResultBroadcastTxCommit),
+		Handler:  BroadcastTxRequestHandlerFn(cdc, cliCtx),
+	})
 }
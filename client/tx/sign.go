@@ -1,48 +1,65 @@
 package tx
 
 import (
-	"encoding/json"
+	"io/ioutil"
 	"net/http"
 
-	keybase "github.com/cosmos/cosmos-sdk/client/keys"
-	keys "github.com/cosmos/cosmos-sdk/crypto/keys"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authctx "github.com/cosmos/cosmos-sdk/x/auth/client/context"
 )
 
-// REST request body for signed txs
-// TODO does this need to be exposed?
+// SignTxBody is the REST request body signing a transaction generated
+// offline with a key of the keybase
 type SignTxBody struct {
-	Name     string `json:"name"`
-	Password string `json:"password"`
-	TxBytes  string `json:"tx"`
+	Name          string     `json:"name"`
+	Password      string     `json:"password"`
+	ChainID       string     `json:"chain_id"`
+	AccountNumber int64      `json:"account_number"`
+	Sequence      int64      `json:"sequence"`
+	Tx            auth.StdTx `json:"tx"`
 }
 
-// sign transaction REST Handler
-func SignTxRequstHandler(w http.ResponseWriter, r *http.Request) {
-	var kb keys.Keybase
-	var m SignTxBody
-
-	decoder := json.NewDecoder(r.Body)
-	err := decoder.Decode(&m)
-	if err != nil {
-		w.WriteHeader(400)
-		w.Write([]byte(err.Error()))
-		return
-	}
+// SignTxRequestHandlerFn returns the REST handler appending to a transaction
+// the signature of a key, and writing the signed transaction
+func SignTxRequestHandlerFn(cdc *wire.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var m SignTxBody
 
-	kb, err = keybase.GetKeyBase()
-	if err != nil {
-		w.WriteHeader(500)
-		w.Write([]byte(err.Error()))
-		return
-	}
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		err = cdc.UnmarshalJSON(body, &m)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
 
-	//TODO check if account exists
-	sig, _, err := kb.Sign(m.Name, m.Password, []byte(m.TxBytes))
-	if err != nil {
-		w.WriteHeader(403)
-		w.Write([]byte(err.Error()))
-		return
-	}
+		txCtx := authctx.TxContext{
+			Codec:         cdc,
+			ChainID:       m.ChainID,
+			AccountNumber: m.AccountNumber,
+			Sequence:      m.Sequence,
+		}
 
-	w.Write(sig)
+		signed, err := txCtx.SignStdTx(m.Name, m.Password, m.Tx)
+		if err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		output, err := wire.MarshalJSONIndent(cdc, signed)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		w.Write(output)
+	}
 }
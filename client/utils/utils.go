@@ -1,18 +1,40 @@
 package utils
 
 import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/keys"
+	cryptokeys "github.com/cosmos/cosmos-sdk/crypto/keys"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	authctx "github.com/cosmos/cosmos-sdk/x/auth/client/context"
+
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 )
 
+// gas limit of the transactions simulated to estimate their gas, high enough
+// for any transaction to complete
+const simulationGas = 10000000
+
 // SendTx implements a auxiliary handler that facilitates sending a series of
 // messages in a signed transaction given a TxContext and a QueryContext. It
 // ensures that the account exists, has a proper number and sequence set. In
-// addition, it builds and signs a transaction with the supplied messages.
-// Finally, it broadcasts the signed transaction to a node.
+// addition, it builds and signs a transaction with the supplied messages,
+// estimating its gas by simulation if the context is set to. Finally, it
+// broadcasts the signed transaction to a node. If the context is in generate
+// only mode, the unsigned transaction is printed instead.
 func SendTx(txCtx authctx.TxContext, cliCtx context.CLIContext, msgs []sdk.Msg) error {
+	if cliCtx.GenerateOnly {
+		return PrintUnsignedStdTx(txCtx, cliCtx, msgs)
+	}
+
 	if err := cliCtx.EnsureAccountExists(); err != nil {
 		return err
 	}
@@ -49,6 +71,14 @@ func SendTx(txCtx authctx.TxContext, cliCtx context.CLIContext, msgs []sdk.Msg)
 		return err
 	}
 
+	if txCtx.SimulateGas {
+		txCtx, err = EnrichCtxWithGas(txCtx, cliCtx, cliCtx.FromAddressName, passphrase, msgs)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "estimated gas = %v\n", txCtx.Gas)
+	}
+
 	// build and sign the transaction
 	txBytes, err := txCtx.BuildAndSign(cliCtx.FromAddressName, passphrase, msgs)
 	if err != nil {
@@ -58,3 +88,213 @@ func SendTx(txCtx authctx.TxContext, cliCtx context.CLIContext, msgs []sdk.Msg)
 	// broadcast to a Tendermint node
 	return cliCtx.EnsureBroadcastTx(txBytes)
 }
+
+// EnrichCtxWithGas returns a copy of the context with the gas set to the
+// adjusted estimate of the gas of the messages.
+func EnrichCtxWithGas(txCtx authctx.TxContext, cliCtx context.CLIContext, name, passphrase string, msgs []sdk.Msg) (authctx.TxContext, error) {
+	_, adjusted, err := EstimateGas(txCtx, cliCtx, name, passphrase, msgs)
+	if err != nil {
+		return txCtx, err
+	}
+
+	return txCtx.WithGas(adjusted), nil
+}
+
+// EstimateGas simulates a transaction of the messages signed with the given
+// key, and returns the gas it used along with that gas multiplied by the gas
+// adjustment of the context.
+func EstimateGas(txCtx authctx.TxContext, cliCtx context.CLIContext, name, passphrase string, msgs []sdk.Msg) (estimate, adjusted int64, err error) {
+	txBytes, err := txCtx.WithGas(simulationGas).BuildAndSign(name, passphrase, msgs)
+	if err != nil {
+		return
+	}
+
+	res, err := cliCtx.Simulate(txBytes)
+	if err != nil {
+		return
+	}
+
+	estimate = res.GasUsed
+	adjusted = int64(txCtx.GasAdjustment * float64(estimate))
+	return
+}
+
+// ParseGasAdjustment parses the gas adjustment of a REST request, defaulting
+// to client.DefaultGasAdjustment if empty.
+func ParseGasAdjustment(s string) (float64, error) {
+	if s == "" {
+		return client.DefaultGasAdjustment, nil
+	}
+
+	adjustment, err := strconv.ParseFloat(s, 64)
+	if err != nil || adjustment <= 0 {
+		return 0, fmt.Errorf("gas adjustment must be a positive number, got %q", s)
+	}
+	return adjustment, nil
+}
+
+// PrintUnsignedStdTx builds an unsigned transaction of the messages and
+// prints it to STDOUT as JSON, so that it can be signed offline.
+func PrintUnsignedStdTx(txCtx authctx.TxContext, cliCtx context.CLIContext, msgs []sdk.Msg) error {
+	// the simulation runs the signature checks
+	if txCtx.SimulateGas {
+		return fmt.Errorf("the gas of an unsigned transaction cannot be estimated, set --gas to a limit")
+	}
+
+	stdTx, err := txCtx.BuildUnsigned(msgs)
+	if err != nil {
+		return err
+	}
+
+	output, err := txCtx.Codec.MarshalJSON(stdTx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(output))
+	return nil
+}
+
+// SignStdTx appends to a transaction the signature of the given key. Unless
+// offline, the account number and sequence of the key are queried from the
+// node rather than taken from the context.
+func SignStdTx(txCtx authctx.TxContext, cliCtx context.CLIContext, name string, stdTx auth.StdTx, offline bool) (auth.StdTx, error) {
+	if !offline {
+		cliCtx = cliCtx.WithFromAddressName(name)
+		if err := cliCtx.EnsureAccountExists(); err != nil {
+			return auth.StdTx{}, err
+		}
+
+		from, err := cliCtx.GetFromAddress()
+		if err != nil {
+			return auth.StdTx{}, err
+		}
+
+		account, err := cliCtx.GetAccount(from)
+		if err != nil {
+			return auth.StdTx{}, err
+		}
+
+		txCtx = txCtx.
+			WithAccountNumber(account.GetAccountNumber()).
+			WithSequence(account.GetSequence())
+	}
+
+	passphrase, err := keys.GetPassphrase(name)
+	if err != nil {
+		return auth.StdTx{}, err
+	}
+
+	return txCtx.SignStdTx(name, passphrase, stdTx)
+}
+
+// ReadStdTxFromFile reads a transaction encoded as JSON from a file.
+func ReadStdTxFromFile(cdc *wire.Codec, filename string) (stdTx auth.StdTx, err error) {
+	bz, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return
+	}
+
+	err = cdc.UnmarshalJSON(bz, &stdTx)
+	return
+}
+
+// WriteGenerateStdTxResponse writes to the response of a REST request the
+// unsigned transaction of the messages as JSON.
+func WriteGenerateStdTxResponse(w http.ResponseWriter, txCtx authctx.TxContext, msgs []sdk.Msg) {
+	stdTx, err := txCtx.BuildUnsigned(msgs)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	output, err := wire.MarshalJSONIndent(txCtx.Codec, stdTx)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	w.Write(output)
+}
+
+// WriteSimulationResponse writes to the response of a REST request the
+// adjusted gas of the messages, estimated by simulating a transaction signed
+// with the given key.
+func WriteSimulationResponse(w http.ResponseWriter, txCtx authctx.TxContext, cliCtx context.CLIContext,
+	name, passphrase, gasAdjustment string, msgs []sdk.Msg) {
+
+	adjustment, err := ParseGasAdjustment(gasAdjustment)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	_, adjusted, err := EstimateGas(txCtx.WithGasAdjustment(adjustment), cliCtx, name, passphrase, msgs)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	w.Write([]byte(fmt.Sprintf(`{"gas_estimate":"%d"}`, adjusted)))
+}
+
+// UnsignedTxResponse is the response of the REST tx endpoints of a server
+// holding no keys: the unsigned transaction along with the bytes its signer
+// must sign.
+type UnsignedTxResponse struct {
+	Tx        auth.StdTx `json:"tx"`
+	SignBytes string     `json:"sign_bytes"`
+}
+
+// WriteUnsignedTxResponse writes to the response of a REST request the
+// unsigned transaction of the messages along with the bytes to sign, which
+// commit to the chain ID, account number and sequence of the context.
+func WriteUnsignedTxResponse(w http.ResponseWriter, txCtx authctx.TxContext, msgs []sdk.Msg) {
+	signMsg, err := txCtx.Build(msgs)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	output, err := wire.MarshalJSONIndent(txCtx.Codec, UnsignedTxResponse{
+		Tx:        auth.NewStdTx(signMsg.Msgs, signMsg.Fee, nil, signMsg.Memo, signMsg.TimeoutHeight),
+		SignBytes: string(signMsg.Bytes()),
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	w.Write(output)
+}
+
+// TxResponse returns a pointer to a value of the type of the response of the
+// REST tx endpoints, for their route metadata: the unsigned transaction if the
+// server holds no keys, otherwise the result of its commit.
+func TxResponse(kb cryptokeys.Keybase) interface{} {
+	if kb == nil {
+		return new(UnsignedTxResponse)
+	}
+	return new(ctypes.ResultBroadcastTxCommit)
+}
+
+// GetRESTFromAddress returns the address of the signer of a REST tx request:
+// that of the named key if the server holds keys, otherwise the given bech32
+// address.
+func GetRESTFromAddress(kb cryptokeys.Keybase, name, from string) (sdk.AccAddress, error) {
+	if kb == nil {
+		return sdk.AccAddressFromBech32(from)
+	}
+
+	info, err := kb.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.AccAddress(info.GetPubKey().Address()), nil
+}
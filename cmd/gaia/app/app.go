@@ -16,8 +16,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+	"github.com/cosmos/cosmos-sdk/x/evidence"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/ibc"
+	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/stake"
@@ -39,15 +42,18 @@ type GaiaApp struct {
 	cdc *wire.Codec
 
 	// keys to access the substores
-	keyMain          *sdk.KVStoreKey
-	keyAccount       *sdk.KVStoreKey
-	keyIBC           *sdk.KVStoreKey
-	keyStake         *sdk.KVStoreKey
-	keySlashing      *sdk.KVStoreKey
-	keyGov           *sdk.KVStoreKey
-	keyFeeCollection *sdk.KVStoreKey
-	keyParams        *sdk.KVStoreKey
-	tkeyParams       *sdk.TransientStoreKey
+	keyMain     *sdk.KVStoreKey
+	keyAccount  *sdk.KVStoreKey
+	keyBank     *sdk.KVStoreKey
+	keyIBC      *sdk.KVStoreKey
+	keyStake    *sdk.KVStoreKey
+	keySlashing *sdk.KVStoreKey
+	keyMint     *sdk.KVStoreKey
+	keyGov      *sdk.KVStoreKey
+	keyParams   *sdk.KVStoreKey
+	keyCrisis   *sdk.KVStoreKey
+	keyEvidence *sdk.KVStoreKey
+	tkeyParams  *sdk.TransientStoreKey
 
 	// Manage getting and setting accounts
 	accountMapper       auth.AccountMapper
@@ -56,8 +62,11 @@ type GaiaApp struct {
 	ibcMapper           ibc.Mapper
 	stakeKeeper         stake.Keeper
 	slashingKeeper      slashing.Keeper
+	mintKeeper          mint.Keeper
 	govKeeper           gov.Keeper
 	paramsKeeper        params.Keeper
+	crisisKeeper        crisis.Keeper
+	evidenceKeeper      evidence.Keeper
 }
 
 // NewGaiaApp returns a reference to an initialized GaiaApp.
@@ -68,17 +77,20 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, baseAppOptio
 	bApp.SetCommitMultiStoreTracer(traceStore)
 
 	var app = &GaiaApp{
-		BaseApp:          bApp,
-		cdc:              cdc,
-		keyMain:          sdk.NewKVStoreKey("main"),
-		keyAccount:       sdk.NewKVStoreKey("acc"),
-		keyIBC:           sdk.NewKVStoreKey("ibc"),
-		keyStake:         sdk.NewKVStoreKey("stake"),
-		keySlashing:      sdk.NewKVStoreKey("slashing"),
-		keyGov:           sdk.NewKVStoreKey("gov"),
-		keyFeeCollection: sdk.NewKVStoreKey("fee"),
-		keyParams:        sdk.NewKVStoreKey("params"),
-		tkeyParams:       sdk.NewTransientStoreKey("params"),
+		BaseApp:     bApp,
+		cdc:         cdc,
+		keyMain:     sdk.NewKVStoreKey("main"),
+		keyAccount:  sdk.NewKVStoreKey("acc"),
+		keyBank:     sdk.NewKVStoreKey("bank"),
+		keyIBC:      sdk.NewKVStoreKey("ibc"),
+		keyStake:    sdk.NewKVStoreKey("stake"),
+		keySlashing: sdk.NewKVStoreKey("slashing"),
+		keyMint:     sdk.NewKVStoreKey("mint"),
+		keyGov:      sdk.NewKVStoreKey("gov"),
+		keyParams:   sdk.NewKVStoreKey("params"),
+		keyCrisis:   sdk.NewKVStoreKey("crisis"),
+		keyEvidence: sdk.NewKVStoreKey("evidence"),
+		tkeyParams:  sdk.NewTransientStoreKey("params"),
 	}
 
 	// define the accountMapper
@@ -89,13 +101,33 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, baseAppOptio
 	)
 
 	// add handlers
-	app.coinKeeper = bank.NewKeeper(app.accountMapper)
-	app.ibcMapper = ibc.NewMapper(app.cdc, app.keyIBC, app.RegisterCodespace(ibc.DefaultCodespace))
 	app.paramsKeeper = params.NewKeeper(app.cdc, app.keyParams)
+	app.coinKeeper = bank.NewKeeper(app.cdc, app.keyBank, app.accountMapper, app.paramsKeeper.Setter(), app.RegisterCodespace(bank.DefaultCodespace))
+	app.ibcMapper = ibc.NewMapper(app.cdc, app.keyIBC, app.RegisterCodespace(ibc.DefaultCodespace))
 	app.stakeKeeper = stake.NewKeeper(app.cdc, app.keyStake, app.coinKeeper, app.RegisterCodespace(stake.DefaultCodespace))
 	app.govKeeper = gov.NewKeeper(app.cdc, app.keyGov, app.paramsKeeper.Setter(), app.coinKeeper, app.stakeKeeper, app.RegisterCodespace(gov.DefaultCodespace))
-	app.feeCollectionKeeper = auth.NewFeeCollectionKeeper(app.cdc, app.keyFeeCollection)
+	app.feeCollectionKeeper = auth.NewFeeCollectionKeeper(app.accountMapper)
+	app.coinKeeper.RegisterModuleAccount(auth.FeeCollectorName)
 	app.slashingKeeper = slashing.NewKeeper(app.cdc, app.keySlashing, app.stakeKeeper, app.paramsKeeper.Getter(), app.RegisterCodespace(slashing.DefaultCodespace))
+	app.mintKeeper = mint.NewKeeper(app.cdc, app.keyMint, app.coinKeeper, app.stakeKeeper, mint.BondedRatioMinter{})
+	app.crisisKeeper = crisis.NewKeeper(app.cdc, app.keyCrisis, app.paramsKeeper.Getter(), app.RegisterCodespace(crisis.DefaultCodespace))
+	app.evidenceKeeper = evidence.NewKeeper(app.cdc, app.keyEvidence, app.RegisterCodespace(evidence.DefaultCodespace))
+
+	// register staking hooks
+	app.stakeKeeper.AddHooks(app.slashingKeeper.Hooks())
+
+	// register evidence handlers
+	app.evidenceKeeper.AddRoute(evidence.RouteEquivocation, slashing.NewEquivocationHandler(app.slashingKeeper))
+
+	// register IBC ports
+	app.ibcMapper.AddRoute(ibc.PortTransfer, ibc.NewTransferHandler(app.coinKeeper))
+
+	// register invariants
+	app.crisisKeeper.RegisterRoute("bank", "supply", bank.SupplyInvariant(app.coinKeeper))
+	app.crisisKeeper.RegisterRoute("stake", "bonded-tokens", stake.BondedTokensInvariant(app.stakeKeeper))
+	app.crisisKeeper.RegisterRoute("stake", "module-account", stake.ModuleAccountInvariant(app.stakeKeeper))
+	app.crisisKeeper.RegisterRoute("stake", "tokenized-shares", stake.TokenizedSharesInvariant(app.stakeKeeper))
+	app.crisisKeeper.RegisterRoute("gov", "deposits", gov.DepositsInvariant(app.govKeeper))
 
 	// register message routes
 	app.Router().
@@ -103,14 +135,16 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, baseAppOptio
 		AddRoute("ibc", ibc.NewHandler(app.ibcMapper, app.coinKeeper)).
 		AddRoute("stake", stake.NewHandler(app.stakeKeeper)).
 		AddRoute("slashing", slashing.NewHandler(app.slashingKeeper)).
-		AddRoute("gov", gov.NewHandler(app.govKeeper))
+		AddRoute("gov", gov.NewHandler(app.govKeeper)).
+		AddRoute("crisis", crisis.NewHandler(app.crisisKeeper)).
+		AddRoute("evidence", evidence.NewHandler(app.evidenceKeeper))
 
 	// initialize BaseApp
 	app.SetInitChainer(app.initChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
 	app.SetAnteHandler(auth.NewAnteHandler(app.accountMapper, app.feeCollectionKeeper))
-	app.MountStoresIAVL(app.keyMain, app.keyAccount, app.keyIBC, app.keyStake, app.keySlashing, app.keyGov, app.keyFeeCollection, app.keyParams)
+	app.MountStoresIAVL(app.keyMain, app.keyAccount, app.keyBank, app.keyIBC, app.keyStake, app.keySlashing, app.keyMint, app.keyGov, app.keyParams, app.keyCrisis, app.keyEvidence)
 	app.MountStore(app.tkeyParams, sdk.StoreTypeTransient)
 	err := app.LoadLatestVersion(app.keyMain)
 	if err != nil {
@@ -128,6 +162,8 @@ func MakeCodec() *wire.Codec {
 	stake.RegisterWire(cdc)
 	slashing.RegisterWire(cdc)
 	gov.RegisterWire(cdc)
+	crisis.RegisterWire(cdc)
+	evidence.RegisterWire(cdc)
 	auth.RegisterWire(cdc)
 	sdk.RegisterWire(cdc)
 	wire.RegisterCrypto(cdc)
@@ -136,7 +172,9 @@ func MakeCodec() *wire.Codec {
 
 // application updates every end block
 func (app *GaiaApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	mint.BeginBlocker(ctx, app.mintKeeper)
 	tags := slashing.BeginBlocker(ctx, req, app.slashingKeeper)
+	tags = tags.AppendTags(evidence.BeginBlocker(ctx, req, app.evidenceKeeper))
 
 	return abci.ResponseBeginBlock{
 		Tags: tags.ToKVPairs(),
@@ -148,8 +186,8 @@ func (app *GaiaApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) ab
 func (app *GaiaApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
 	tags := gov.EndBlocker(ctx, app.govKeeper)
 	validatorUpdates := stake.EndBlocker(ctx, app.stakeKeeper)
-	// Add these new validators to the addr -> pubkey map.
-	app.slashingKeeper.AddValidators(ctx, validatorUpdates)
+	// halts the chain if an invariant is broken
+	crisis.EndBlocker(ctx, app.crisisKeeper)
 	return abci.ResponseEndBlock{
 		ValidatorUpdates: validatorUpdates,
 		Tags:             tags,
@@ -175,6 +213,13 @@ func (app *GaiaApp) initChainer(ctx sdk.Context, req abci.RequestInitChain) abci
 		app.accountMapper.SetAccount(ctx, acc)
 	}
 
+	// load the issuance parameters and the initial supply
+	err = bank.InitGenesis(ctx, app.coinKeeper, genesisState.BankData)
+	if err != nil {
+		panic(err) // TODO https://github.com/cosmos/cosmos-sdk/issues/468
+		// return sdk.ErrGenesisParse("").TraceCause(err, "")
+	}
+
 	// load the initial stake information
 	validators, err := stake.InitGenesis(ctx, app.stakeKeeper, genesisState.StakeData)
 	if err != nil {
@@ -185,6 +230,20 @@ func (app *GaiaApp) initChainer(ctx sdk.Context, req abci.RequestInitChain) abci
 	// load the address to pubkey map
 	slashing.InitGenesis(ctx, app.slashingKeeper, genesisState.StakeData)
 
+	// load the minting parameters and state
+	err = mint.InitGenesis(ctx, app.mintKeeper, genesisState.MintData)
+	if err != nil {
+		panic(err) // TODO https://github.com/cosmos/cosmos-sdk/issues/468
+		// return sdk.ErrGenesisParse("").TraceCause(err, "")
+	}
+
+	// load the light client creators
+	err = ibc.InitGenesis(ctx, app.ibcMapper, genesisState.IBCData)
+	if err != nil {
+		panic(err) // TODO https://github.com/cosmos/cosmos-sdk/issues/468
+		// return sdk.ErrGenesisParse("").TraceCause(err, "")
+	}
+
 	gov.InitGenesis(ctx, app.govKeeper, gov.DefaultGenesisState())
 
 	return abci.ResponseInitChain{
@@ -199,6 +258,10 @@ func (app *GaiaApp) ExportAppStateAndValidators() (appState json.RawMessage, val
 	// iterate to get the accounts
 	accounts := []GenesisAccount{}
 	appendAccount := func(acc auth.Account) (stop bool) {
+		// module accounts are recreated by the modules owning them
+		if _, ok := acc.(*auth.ModuleAccount); ok {
+			return false
+		}
 		account := NewGenesisAccountI(acc)
 		accounts = append(accounts, account)
 		return false
@@ -207,7 +270,10 @@ func (app *GaiaApp) ExportAppStateAndValidators() (appState json.RawMessage, val
 
 	genState := GenesisState{
 		Accounts:  accounts,
+		BankData:  bank.WriteGenesis(ctx, app.coinKeeper),
 		StakeData: stake.WriteGenesis(ctx, app.stakeKeeper),
+		MintData:  mint.WriteGenesis(ctx, app.mintKeeper),
+		IBCData:   ibc.WriteGenesis(ctx, app.ibcMapper),
 	}
 	appState, err = wire.MarshalJSONIndent(app.cdc, genState)
 	if err != nil {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/stake"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/db"
@@ -23,6 +24,7 @@ func setGenesis(gapp *GaiaApp, accs ...*auth.BaseAccount) error {
 	genesisState := GenesisState{
 		Accounts:  genaccs,
 		StakeData: stake.DefaultGenesisState(),
+		MintData:  mint.DefaultGenesisState(),
 	}
 
 	stateBytes, err := wire.MarshalJSONIndent(gapp.cdc, genesisState)
@@ -11,6 +11,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/ibc"
+	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/stake"
 
 	"github.com/spf13/pflag"
@@ -31,7 +34,10 @@ var (
 // State to Unmarshal
 type GenesisState struct {
 	Accounts  []GenesisAccount   `json:"accounts"`
+	BankData  bank.GenesisState  `json:"bank"`
 	StakeData stake.GenesisState `json:"stake"`
+	MintData  mint.GenesisState  `json:"mint"`
+	IBCData   ibc.GenesisState   `json:"ibc"`
 }
 
 // GenesisAccount doesn't need pubkey or sequence
@@ -215,7 +221,10 @@ func GaiaAppGenState(cdc *wire.Codec, appGenTxs []json.RawMessage) (genesisState
 	// create the final app state
 	genesisState = GenesisState{
 		Accounts:  genaccs,
+		BankData:  bank.DefaultGenesisState(),
 		StakeData: stakeData,
+		MintData:  mint.DefaultGenesisState(),
+		IBCData:   ibc.DefaultGenesisState(),
 	}
 	return
 }
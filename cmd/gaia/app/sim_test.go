@@ -12,7 +12,10 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	banksim "github.com/cosmos/cosmos-sdk/x/bank/simulation"
+	"github.com/cosmos/cosmos-sdk/x/ibc"
+	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/mock/simulation"
 	stake "github.com/cosmos/cosmos-sdk/x/stake"
 	stakesim "github.com/cosmos/cosmos-sdk/x/stake/simulation"
@@ -51,7 +54,10 @@ func appStateFn(r *rand.Rand, accs []sdk.AccAddress) json.RawMessage {
 	stakeGenesis.Pool.LooseTokens = sdk.NewDec(1000)
 	genesis := GenesisState{
 		Accounts:  genesisAccounts,
+		BankData:  bank.DefaultGenesisState(),
 		StakeData: stakeGenesis,
+		MintData:  mint.DefaultGenesisState(),
+		IBCData:   ibc.DefaultGenesisState(),
 	}
 
 	// Marshal genesis
@@ -86,6 +92,8 @@ func TestFullGaiaSimulation(t *testing.T) {
 			stakesim.SimulateMsgCompleteUnbonding(app.stakeKeeper),
 			stakesim.SimulateMsgBeginRedelegate(app.accountMapper, app.stakeKeeper),
 			stakesim.SimulateMsgCompleteRedelegate(app.stakeKeeper),
+			stakesim.SimulateMsgTokenizeShares(app.accountMapper, app.stakeKeeper),
+			stakesim.SimulateMsgRedeemTokens(app.accountMapper, app.stakeKeeper),
 		},
 		[]simulation.RandSetup{},
 		[]simulation.Invariant{
@@ -5,6 +5,7 @@ package clitest
 import (
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"testing"
 
@@ -84,6 +85,73 @@ func TestGaiaCLISend(t *testing.T) {
 	require.Equal(t, int64(30), barAcc.GetCoins().AmountOf("steak").Int64())
 	fooAcc = executeGetAccount(t, fmt.Sprintf("gaiacli account %s %v", fooAddr, flags))
 	require.Equal(t, int64(20), fooAcc.GetCoins().AmountOf("steak").Int64())
+
+	// test gas estimation
+	success := executeWrite(t, fmt.Sprintf("gaiacli send %v --amount=10steak --to=%s --from=foo --gas=auto --gas-adjustment=1.2", flags, barAddr), app.DefaultKeyPass)
+	require.True(t, success)
+	tests.WaitForNextNBlocksTM(2, port)
+
+	barAcc = executeGetAccount(t, fmt.Sprintf("gaiacli account %s %v", barAddr, flags))
+	require.Equal(t, int64(40), barAcc.GetCoins().AmountOf("steak").Int64())
+	fooAcc = executeGetAccount(t, fmt.Sprintf("gaiacli account %s %v", fooAddr, flags))
+	require.Equal(t, int64(10), fooAcc.GetCoins().AmountOf("steak").Int64())
+
+	// an invalid gas is rejected
+	success = executeWrite(t, fmt.Sprintf("gaiacli send %v --amount=10steak --to=%s --from=foo --gas=lots", flags, barAddr), app.DefaultKeyPass)
+	require.False(t, success)
+}
+
+func TestGaiaCLISendGenerateSignAndBroadcast(t *testing.T) {
+	tests.ExecuteT(t, fmt.Sprintf("gaiad --home=%s unsafe-reset-all", gaiadHome), "")
+	executeWrite(t, fmt.Sprintf("gaiacli keys delete --home=%s foo", gaiacliHome), app.DefaultKeyPass)
+	executeWrite(t, fmt.Sprintf("gaiacli keys delete --home=%s bar", gaiacliHome), app.DefaultKeyPass)
+
+	chainID := executeInit(t, fmt.Sprintf("gaiad init -o --name=foo --home=%s --home-client=%s", gaiadHome, gaiacliHome))
+	executeWrite(t, fmt.Sprintf("gaiacli keys add --home=%s bar", gaiacliHome), app.DefaultKeyPass)
+
+	// get a free port, also setup some common flags
+	servAddr, port, err := server.FreeTCPAddr()
+	require.NoError(t, err)
+	flags := fmt.Sprintf("--home=%s --node=%v --chain-id=%v", gaiacliHome, servAddr, chainID)
+
+	// start gaiad server
+	proc := tests.GoExecuteTWithStdout(t, fmt.Sprintf("gaiad start --home=%s --rpc.laddr=%v", gaiadHome, servAddr))
+
+	defer proc.Stop(false)
+	tests.WaitForTMStart(port)
+	tests.WaitForNextNBlocksTM(2, port)
+
+	fooAddr, _ := executeGetAddrPK(t, fmt.Sprintf("gaiacli keys show foo --output=json --home=%s", gaiacliHome))
+	barAddr, _ := executeGetAddrPK(t, fmt.Sprintf("gaiacli keys show bar --output=json --home=%s", gaiacliHome))
+
+	// generate the unsigned tx, no passphrase is needed
+	out := tests.ExecuteT(t, fmt.Sprintf("gaiacli send %v --amount=10steak --to=%s --from=foo --generate-only", flags, barAddr), "")
+	msg := unmarshalStdTx(t, out)
+	require.Equal(t, 1, len(msg.Msgs))
+	require.Equal(t, 0, len(msg.GetSignatures()))
+
+	// nothing was sent
+	fooAcc := executeGetAccount(t, fmt.Sprintf("gaiacli account %s %v", fooAddr, flags))
+	require.Equal(t, int64(50), fooAcc.GetCoins().AmountOf("steak").Int64())
+
+	// sign it offline
+	unsignedTxFile := writeToNewTempFile(t, out)
+	defer os.Remove(unsignedTxFile.Name())
+	out = tests.ExecuteT(t, fmt.Sprintf("gaiacli tx sign %v --from=foo --offline --account-number=%d --sequence=%d %v",
+		flags, fooAcc.GetAccountNumber(), fooAcc.GetSequence(), unsignedTxFile.Name()), app.DefaultKeyPass)
+	msg = unmarshalStdTx(t, out)
+	require.Equal(t, 1, len(msg.GetSignatures()))
+
+	// broadcast it
+	signedTxFile := writeToNewTempFile(t, out)
+	defer os.Remove(signedTxFile.Name())
+	executeWrite(t, fmt.Sprintf("gaiacli tx broadcast %v %v", flags, signedTxFile.Name()))
+	tests.WaitForNextNBlocksTM(2, port)
+
+	barAcc := executeGetAccount(t, fmt.Sprintf("gaiacli account %s %v", barAddr, flags))
+	require.Equal(t, int64(10), barAcc.GetCoins().AmountOf("steak").Int64())
+	fooAcc = executeGetAccount(t, fmt.Sprintf("gaiacli account %s %v", fooAddr, flags))
+	require.Equal(t, int64(40), fooAcc.GetCoins().AmountOf("steak").Int64())
 }
 
 func TestGaiaCLICreateValidator(t *testing.T) {
@@ -262,6 +330,22 @@ func getTestingHomeDirs() (string, string) {
 	return gaiadHome, gaiacliHome
 }
 
+func writeToNewTempFile(t *testing.T, s string) *os.File {
+	fp, err := ioutil.TempFile(os.TempDir(), "cosmos_cli_test_")
+	require.NoError(t, err)
+	defer fp.Close()
+
+	_, err = fp.WriteString(s)
+	require.NoError(t, err)
+	return fp
+}
+
+func unmarshalStdTx(t *testing.T, s string) (stdTx auth.StdTx) {
+	cdc := app.MakeCodec()
+	require.NoError(t, cdc.UnmarshalJSON([]byte(s), &stdTx))
+	return
+}
+
 //___________________________________________________________________________________
 // executors
 
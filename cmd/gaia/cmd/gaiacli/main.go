@@ -13,6 +13,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
 	bankcmd "github.com/cosmos/cosmos-sdk/x/bank/client/cli"
+	crisiscmd "github.com/cosmos/cosmos-sdk/x/crisis/client/cli"
+	evidencecmd "github.com/cosmos/cosmos-sdk/x/evidence/client/cli"
 	govcmd "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	ibccmd "github.com/cosmos/cosmos-sdk/x/ibc/client/cli"
 	slashingcmd "github.com/cosmos/cosmos-sdk/x/slashing/client/cli"
@@ -88,15 +90,30 @@ func main() {
 			stakecmd.GetCmdQueryValidators("stake", cdc),
 			stakecmd.GetCmdQueryDelegation("stake", cdc),
 			stakecmd.GetCmdQueryDelegations("stake", cdc),
+			stakecmd.GetCmdQueryUnbondingDelegation("stake", cdc),
+			stakecmd.GetCmdQueryUnbondingDelegations("stake", cdc),
+			stakecmd.GetCmdQueryRedelegation("stake", cdc),
+			stakecmd.GetCmdQueryRedelegations("stake", cdc),
+			stakecmd.GetCmdQueryValidatorDelegations("stake", cdc),
+			stakecmd.GetCmdQueryValidatorUnbondingDelegations("stake", cdc),
+			stakecmd.GetCmdQueryValidatorRedelegationsFrom("stake", cdc),
+			stakecmd.GetCmdQueryValidatorRedelegationsTo("stake", cdc),
+			stakecmd.GetCmdQueryDelegatorValidators("stake", cdc),
+			stakecmd.GetCmdQuerySelfDelegation("stake", cdc),
+			stakecmd.GetCmdQueryHistoricalInfo("stake", cdc),
 			slashingcmd.GetCmdQuerySigningInfo("slashing", cdc),
+			slashingcmd.GetCmdQueryValidatorEvents("stake", "slashing", cdc),
 		)...)
 	stakeCmd.AddCommand(
 		client.PostCommands(
 			stakecmd.GetCmdCreateValidator(cdc),
 			stakecmd.GetCmdEditValidator(cdc),
+			stakecmd.GetCmdRotateConsPubKey(cdc),
 			stakecmd.GetCmdDelegate(cdc),
 			stakecmd.GetCmdUnbond("stake", cdc),
 			stakecmd.GetCmdRedelegate("stake", cdc),
+			stakecmd.GetCmdTokenizeShares(cdc),
+			stakecmd.GetCmdRedeemTokens(cdc),
 			slashingcmd.GetCmdUnrevoke(cdc),
 		)...)
 	rootCmd.AddCommand(
@@ -129,12 +146,32 @@ func main() {
 	rootCmd.AddCommand(
 		client.GetCommands(
 			authcmd.GetAccountCmd("acc", cdc, authcmd.GetAccountDecoder(cdc)),
+			bankcmd.GetCmdQuerySupply("bank", cdc),
+			evidencecmd.GetCmdQueryEvidence("evidence", cdc),
+			evidencecmd.GetCmdQueryAllEvidence("evidence", cdc),
 		)...)
 	rootCmd.AddCommand(
 		client.PostCommands(
 			bankcmd.SendTxCmd(cdc),
+			bankcmd.IssueTxCmd(cdc),
+			bankcmd.BurnTxCmd(cdc),
+			crisiscmd.GetCmdVerifyInvariant(cdc),
+			evidencecmd.GetCmdSubmitEvidence(cdc),
 		)...)
 
+	//Add commands signing and broadcasting txs generated offline
+	txCmd := &cobra.Command{
+		Use:   "tx",
+		Short: "Offline transaction subcommands",
+	}
+	txCmd.AddCommand(
+		authcmd.GetSignCommand(cdc, authcmd.GetAccountDecoder(cdc)),
+		authcmd.GetBroadcastCommand(cdc),
+	)
+	rootCmd.AddCommand(
+		txCmd,
+	)
+
 	// add proxy, version and key info
 	rootCmd.AddCommand(
 		keys.Commands(),
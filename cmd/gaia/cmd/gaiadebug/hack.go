@@ -24,7 +24,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/evidence"
 	"github.com/cosmos/cosmos-sdk/x/ibc"
+	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/stake"
@@ -132,10 +134,13 @@ type GaiaApp struct {
 	// keys to access the substores
 	keyMain     *sdk.KVStoreKey
 	keyAccount  *sdk.KVStoreKey
+	keyBank     *sdk.KVStoreKey
 	keyIBC      *sdk.KVStoreKey
 	keyStake    *sdk.KVStoreKey
 	keySlashing *sdk.KVStoreKey
+	keyMint     *sdk.KVStoreKey
 	keyParams   *sdk.KVStoreKey
+	keyEvidence *sdk.KVStoreKey
 
 	// Manage getting and setting accounts
 	accountMapper       auth.AccountMapper
@@ -144,7 +149,9 @@ type GaiaApp struct {
 	ibcMapper           ibc.Mapper
 	stakeKeeper         stake.Keeper
 	slashingKeeper      slashing.Keeper
+	mintKeeper          mint.Keeper
 	paramsKeeper        params.Keeper
+	evidenceKeeper      evidence.Keeper
 }
 
 func NewGaiaApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.BaseApp)) *GaiaApp {
@@ -159,10 +166,13 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.BaseAp
 		cdc:         cdc,
 		keyMain:     sdk.NewKVStoreKey("main"),
 		keyAccount:  sdk.NewKVStoreKey("acc"),
+		keyBank:     sdk.NewKVStoreKey("bank"),
 		keyIBC:      sdk.NewKVStoreKey("ibc"),
 		keyStake:    sdk.NewKVStoreKey("stake"),
 		keySlashing: sdk.NewKVStoreKey("slashing"),
+		keyMint:     sdk.NewKVStoreKey("mint"),
 		keyParams:   sdk.NewKVStoreKey("params"),
+		keyEvidence: sdk.NewKVStoreKey("evidence"),
 	}
 
 	// define the accountMapper
@@ -173,11 +183,20 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.BaseAp
 	)
 
 	// add handlers
-	app.coinKeeper = bank.NewKeeper(app.accountMapper)
-	app.ibcMapper = ibc.NewMapper(app.cdc, app.keyIBC, app.RegisterCodespace(ibc.DefaultCodespace))
 	app.paramsKeeper = params.NewKeeper(app.cdc, app.keyParams)
+	app.coinKeeper = bank.NewKeeper(app.cdc, app.keyBank, app.accountMapper, app.paramsKeeper.Setter(), app.RegisterCodespace(bank.DefaultCodespace))
+	app.ibcMapper = ibc.NewMapper(app.cdc, app.keyIBC, app.RegisterCodespace(ibc.DefaultCodespace))
 	app.stakeKeeper = stake.NewKeeper(app.cdc, app.keyStake, app.coinKeeper, app.RegisterCodespace(stake.DefaultCodespace))
+	app.feeCollectionKeeper = auth.NewFeeCollectionKeeper(app.accountMapper)
+	app.coinKeeper.RegisterModuleAccount(auth.FeeCollectorName)
 	app.slashingKeeper = slashing.NewKeeper(app.cdc, app.keySlashing, app.stakeKeeper, app.paramsKeeper.Getter(), app.RegisterCodespace(slashing.DefaultCodespace))
+	app.stakeKeeper.AddHooks(app.slashingKeeper.Hooks())
+	app.mintKeeper = mint.NewKeeper(app.cdc, app.keyMint, app.coinKeeper, app.stakeKeeper, mint.BondedRatioMinter{})
+	app.evidenceKeeper = evidence.NewKeeper(app.cdc, app.keyEvidence, app.RegisterCodespace(evidence.DefaultCodespace))
+	app.evidenceKeeper.AddRoute(evidence.RouteEquivocation, slashing.NewEquivocationHandler(app.slashingKeeper))
+
+	// register IBC ports
+	app.ibcMapper.AddRoute(ibc.PortTransfer, ibc.NewTransferHandler(app.coinKeeper))
 
 	// register message routes
 	app.Router().
@@ -190,7 +209,7 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.BaseAp
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
 	app.SetAnteHandler(auth.NewAnteHandler(app.accountMapper, app.feeCollectionKeeper))
-	app.MountStoresIAVL(app.keyMain, app.keyAccount, app.keyIBC, app.keyStake, app.keySlashing)
+	app.MountStoresIAVL(app.keyMain, app.keyAccount, app.keyBank, app.keyIBC, app.keyStake, app.keySlashing, app.keyMint, app.keyParams, app.keyEvidence)
 	err := app.LoadLatestVersion(app.keyMain)
 	if err != nil {
 		cmn.Exit(err.Error())
@@ -208,6 +227,7 @@ func MakeCodec() *wire.Codec {
 	bank.RegisterWire(cdc)
 	stake.RegisterWire(cdc)
 	slashing.RegisterWire(cdc)
+	evidence.RegisterWire(cdc)
 	auth.RegisterWire(cdc)
 	sdk.RegisterWire(cdc)
 	wire.RegisterCrypto(cdc)
@@ -217,7 +237,9 @@ func MakeCodec() *wire.Codec {
 
 // application updates every end block
 func (app *GaiaApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	mint.BeginBlocker(ctx, app.mintKeeper)
 	tags := slashing.BeginBlocker(ctx, req, app.slashingKeeper)
+	tags = tags.AppendTags(evidence.BeginBlocker(ctx, req, app.evidenceKeeper))
 
 	return abci.ResponseBeginBlock{
 		Tags: tags.ToKVPairs(),
@@ -251,12 +273,30 @@ func (app *GaiaApp) initChainer(ctx sdk.Context, req abci.RequestInitChain) abci
 		app.accountMapper.SetAccount(ctx, acc)
 	}
 
+	// load the issuance parameters and the initial supply
+	err = bank.InitGenesis(ctx, app.coinKeeper, genesisState.BankData)
+	if err != nil {
+		panic(err) // TODO https://github.com/cosmos/cosmos-sdk/issues/468 // return sdk.ErrGenesisParse("").TraceCause(err, "")
+	}
+
 	// load the initial stake information
 	validators, err := stake.InitGenesis(ctx, app.stakeKeeper, genesisState.StakeData)
 	if err != nil {
 		panic(err) // TODO https://github.com/cosmos/cosmos-sdk/issues/468 // return sdk.ErrGenesisParse("").TraceCause(err, "")
 	}
 
+	// load the minting parameters and state
+	err = mint.InitGenesis(ctx, app.mintKeeper, genesisState.MintData)
+	if err != nil {
+		panic(err) // TODO https://github.com/cosmos/cosmos-sdk/issues/468 // return sdk.ErrGenesisParse("").TraceCause(err, "")
+	}
+
+	// load the light client creators
+	err = ibc.InitGenesis(ctx, app.ibcMapper, genesisState.IBCData)
+	if err != nil {
+		panic(err) // TODO https://github.com/cosmos/cosmos-sdk/issues/468 // return sdk.ErrGenesisParse("").TraceCause(err, "")
+	}
+
 	return abci.ResponseInitChain{
 		Validators: validators,
 	}
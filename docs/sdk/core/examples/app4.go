@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
 const (
@@ -26,14 +27,16 @@ func NewApp4(logger log.Logger, db dbm.DB) *bapp.BaseApp {
 
 	// Create a key for accessing the account store.
 	keyAccount := sdk.NewKVStoreKey("acc")
+	keyBank := sdk.NewKVStoreKey("bank")
+	keyParams := sdk.NewKVStoreKey("params")
 
 	// Set various mappers/keepers to interact easily with underlying stores
 	accountMapper := auth.NewAccountMapper(cdc, keyAccount, auth.ProtoBaseAccount)
-	coinKeeper := bank.NewKeeper(accountMapper)
+	paramsKeeper := params.NewKeeper(cdc, keyParams)
+	coinKeeper := bank.NewKeeper(cdc, keyBank, accountMapper, paramsKeeper.Setter(), bank.DefaultCodespace)
 
 	// TODO
-	keyFees := sdk.NewKVStoreKey("fee")
-	feeKeeper := auth.NewFeeCollectionKeeper(cdc, keyFees)
+	feeKeeper := auth.NewFeeCollectionKeeper(accountMapper)
 
 	app.SetAnteHandler(auth.NewAnteHandler(accountMapper, feeKeeper))
 
@@ -46,7 +49,7 @@ func NewApp4(logger log.Logger, db dbm.DB) *bapp.BaseApp {
 		AddRoute("bank", bank.NewHandler(coinKeeper))
 
 	// Mount stores and load the latest state.
-	app.MountStoresIAVL(keyAccount, keyFees)
+	app.MountStoresIAVL(keyAccount, keyBank, keyParams)
 	err := app.LoadLatestVersion(keyAccount)
 	if err != nil {
 		cmn.Exit(err.Error())
@@ -58,6 +58,11 @@ func (v Validator) GetMoniker() string {
 	return ""
 }
 
+// Implements sdk.Validator
+func (v Validator) GetMinSelfDelegation() sdk.Int {
+	return sdk.ZeroInt()
+}
+
 // Implements sdk.Validator
 type ValidatorSet struct {
 	Validators []Validator
@@ -101,6 +106,11 @@ func (vs *ValidatorSet) TotalPower(ctx sdk.Context) sdk.Dec {
 	return res
 }
 
+// Delegation implements sdk.ValidatorSet
+func (vs *ValidatorSet) Delegation(ctx sdk.Context, addrDel sdk.AccAddress, addrVal sdk.AccAddress) sdk.Delegation {
+	panic("not implemented")
+}
+
 // Helper function for adding new validator
 func (vs *ValidatorSet) AddValidator(val Validator) {
 	vs.Validators = append(vs.Validators, val)
@@ -122,7 +132,7 @@ func (vs *ValidatorSet) RemoveValidator(addr sdk.AccAddress) {
 }
 
 // Implements sdk.ValidatorSet
-func (vs *ValidatorSet) Slash(ctx sdk.Context, pubkey crypto.PubKey, height int64, power int64, amt sdk.Dec) {
+func (vs *ValidatorSet) Slash(ctx sdk.Context, pubkey crypto.PubKey, height int64, power int64, amt sdk.Dec, infraction sdk.Infraction) {
 	panic("not implemented")
 }
 
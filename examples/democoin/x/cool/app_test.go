@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	bank "github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/mock"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/ed25519"
@@ -49,13 +50,16 @@ func getMockApp(t *testing.T) *mock.App {
 
 	RegisterWire(mapp.Cdc)
 	keyCool := sdk.NewKVStoreKey("cool")
-	coinKeeper := bank.NewKeeper(mapp.AccountMapper)
+	keyBank := sdk.NewKVStoreKey("bank")
+	keyParams := sdk.NewKVStoreKey("params")
+	paramsKeeper := params.NewKeeper(mapp.Cdc, keyParams)
+	coinKeeper := bank.NewKeeper(mapp.Cdc, keyBank, mapp.AccountMapper, paramsKeeper.Setter(), mapp.RegisterCodespace(bank.DefaultCodespace))
 	keeper := NewKeeper(keyCool, coinKeeper, mapp.RegisterCodespace(DefaultCodespace))
 	mapp.Router().AddRoute("cool", NewHandler(keeper))
 
 	mapp.SetInitChainer(getInitChainer(mapp, keeper, "ice-cold"))
 
-	require.NoError(t, mapp.CompleteSetup([]*sdk.KVStoreKey{keyCool}))
+	require.NoError(t, mapp.CompleteSetup([]*sdk.KVStoreKey{keyCool, keyBank, keyParams}))
 	return mapp
 }
 
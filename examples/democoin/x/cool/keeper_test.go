@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/wire"
 	auth "github.com/cosmos/cosmos-sdk/x/auth"
 	bank "github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
 func setupMultiStore() (sdk.MultiStore, *sdk.KVStoreKey) {
@@ -31,7 +32,8 @@ func TestCoolKeeper(t *testing.T) {
 
 	am := auth.NewAccountMapper(cdc, capKey, auth.ProtoBaseAccount)
 	ctx := sdk.NewContext(ms, abci.Header{}, false, nil)
-	ck := bank.NewKeeper(am)
+	pk := params.NewKeeper(cdc, capKey)
+	ck := bank.NewKeeper(cdc, capKey, am, pk.Setter(), bank.DefaultCodespace)
 	keeper := NewKeeper(capKey, ck, DefaultCodespace)
 
 	err := InitGenesis(ctx, keeper, Genesis{"icy"})
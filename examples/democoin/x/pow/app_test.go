@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/mock"
+	"github.com/cosmos/cosmos-sdk/x/params"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/ed25519"
@@ -25,14 +26,17 @@ func getMockApp(t *testing.T) *mock.App {
 
 	RegisterWire(mapp.Cdc)
 	keyPOW := sdk.NewKVStoreKey("pow")
-	coinKeeper := bank.NewKeeper(mapp.AccountMapper)
+	keyBank := sdk.NewKVStoreKey("bank")
+	keyParams := sdk.NewKVStoreKey("params")
+	paramsKeeper := params.NewKeeper(mapp.Cdc, keyParams)
+	coinKeeper := bank.NewKeeper(mapp.Cdc, keyBank, mapp.AccountMapper, paramsKeeper.Setter(), mapp.RegisterCodespace(bank.DefaultCodespace))
 	config := Config{"pow", 1}
 	keeper := NewKeeper(keyPOW, config, coinKeeper, mapp.RegisterCodespace(DefaultCodespace))
 	mapp.Router().AddRoute("pow", keeper.Handler)
 
 	mapp.SetInitChainer(getInitChainer(mapp, keeper))
 
-	require.NoError(t, mapp.CompleteSetup([]*sdk.KVStoreKey{keyPOW}))
+	require.NoError(t, mapp.CompleteSetup([]*sdk.KVStoreKey{keyPOW, keyBank, keyParams}))
 
 	mapp.Seal()
 
@@ -12,6 +12,7 @@ import (
 	wire "github.com/cosmos/cosmos-sdk/wire"
 	auth "github.com/cosmos/cosmos-sdk/x/auth"
 	bank "github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
 func TestPowHandler(t *testing.T) {
@@ -22,7 +23,8 @@ func TestPowHandler(t *testing.T) {
 	am := auth.NewAccountMapper(cdc, capKey, auth.ProtoBaseAccount)
 	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
 	config := NewConfig("pow", int64(1))
-	ck := bank.NewKeeper(am)
+	pk := params.NewKeeper(cdc, capKey)
+	ck := bank.NewKeeper(cdc, capKey, am, pk.Setter(), bank.DefaultCodespace)
 	keeper := NewKeeper(capKey, config, ck, DefaultCodespace)
 
 	handler := keeper.Handler
@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/wire"
 	auth "github.com/cosmos/cosmos-sdk/x/auth"
 	bank "github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
 // possibly share this kind of setup functionality between module testsuites?
@@ -35,7 +36,8 @@ func TestPowKeeperGetSet(t *testing.T) {
 	am := auth.NewAccountMapper(cdc, capKey, auth.ProtoBaseAccount)
 	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
 	config := NewConfig("pow", int64(1))
-	ck := bank.NewKeeper(am)
+	pk := params.NewKeeper(cdc, capKey)
+	ck := bank.NewKeeper(cdc, capKey, am, pk.Setter(), bank.DefaultCodespace)
 	keeper := NewKeeper(capKey, config, ck, DefaultCodespace)
 
 	err := InitGenesis(ctx, keeper, Genesis{uint64(1), uint64(0)})
@@ -17,6 +17,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
 func setupMultiStore() (sdk.MultiStore, *sdk.KVStoreKey, *sdk.KVStoreKey) {
@@ -36,7 +37,9 @@ func TestKeeperGetSet(t *testing.T) {
 	auth.RegisterBaseAccount(cdc)
 
 	accountMapper := auth.NewAccountMapper(cdc, authKey, auth.ProtoBaseAccount)
-	stakeKeeper := NewKeeper(capKey, bank.NewKeeper(accountMapper), DefaultCodespace)
+	paramsKeeper := params.NewKeeper(cdc, authKey)
+	coinKeeper := bank.NewKeeper(cdc, authKey, accountMapper, paramsKeeper.Setter(), bank.DefaultCodespace)
+	stakeKeeper := NewKeeper(capKey, coinKeeper, DefaultCodespace)
 	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
 	addr := sdk.AccAddress([]byte("some-address"))
 
@@ -66,7 +69,8 @@ func TestBonding(t *testing.T) {
 	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
 
 	accountMapper := auth.NewAccountMapper(cdc, authKey, auth.ProtoBaseAccount)
-	coinKeeper := bank.NewKeeper(accountMapper)
+	paramsKeeper := params.NewKeeper(cdc, authKey)
+	coinKeeper := bank.NewKeeper(cdc, authKey, accountMapper, paramsKeeper.Setter(), bank.DefaultCodespace)
 	stakeKeeper := NewKeeper(capKey, coinKeeper, DefaultCodespace)
 	addr := sdk.AccAddress([]byte("some-address"))
 	privKey := ed25519.GenPrivKey()
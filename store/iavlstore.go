@@ -1,6 +1,7 @@
 package store
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"sync"
@@ -189,6 +190,15 @@ func getHeight(tree *iavl.VersionedTree, req abci.RequestQuery) int64 {
 	return height
 }
 
+// SubspacePageQuery is the data of a "/subspace_page" query, returning the
+// pairs of a subspace from a start key, at most limit of them. The following
+// page starts from the key following the last returned key.
+type SubspacePageQuery struct {
+	Subspace []byte `json:"subspace"`
+	Start    []byte `json:"start"`
+	Limit    int    `json:"limit"`
+}
+
 // Query implements ABCI interface, allows queries
 //
 // by default we will return from (latest height -1),
@@ -237,11 +247,92 @@ func (st *iavlStore) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 		subspace := req.Data
 		res.Key = subspace
 		var KVs []KVPair
-		iterator := sdk.KVStorePrefixIterator(st, subspace)
-		for ; iterator.Valid(); iterator.Next() {
-			KVs = append(KVs, KVPair{iterator.Key(), iterator.Value()})
+		if req.Prove {
+			if !st.VersionExists(res.Height) {
+				res.Log = cmn.ErrorWrap(iavl.ErrVersionDoesNotExist, "").Error()
+				break
+			}
+			keys, values, proof, err := tree.GetVersionedRangeWithProof(subspace, sdk.PrefixEndBytes(subspace), 0, res.Height)
+			if err != nil {
+				res.Log = err.Error()
+				break
+			}
+			for i, key := range keys {
+				KVs = append(KVs, KVPair{key, values[i]})
+			}
+			p, err := cdc.MarshalBinary(proof)
+			if err != nil {
+				res.Log = err.Error()
+				break
+			}
+			res.Proof = p
+		} else {
+			iterator := sdk.KVStorePrefixIterator(st, subspace)
+			for ; iterator.Valid(); iterator.Next() {
+				KVs = append(KVs, KVPair{iterator.Key(), iterator.Value()})
+			}
+			iterator.Close()
+		}
+		res.Value = cdc.MustMarshalBinary(KVs)
+	case "/subspace_page":
+		var page SubspacePageQuery
+		if err := cdc.UnmarshalBinary(req.Data, &page); err != nil {
+			return sdk.ErrTxDecode(err.Error()).QueryResult()
+		}
+		if !bytes.HasPrefix(page.Start, page.Subspace) || page.Limit <= 0 {
+			msg := fmt.Sprintf("invalid start %X or limit %d of a page of subspace %X", page.Start, page.Limit, page.Subspace)
+			return sdk.ErrUnknownRequest(msg).QueryResult()
+		}
+		res.Key = req.Data
+		end := sdk.PrefixEndBytes(page.Subspace)
+		var KVs []KVPair
+		if req.Prove {
+			if !st.VersionExists(res.Height) {
+				res.Log = cmn.ErrorWrap(iavl.ErrVersionDoesNotExist, "").Error()
+				break
+			}
+			// the proofs of a limited range don't always hold, so bound the
+			// range of the proof by the key following the page instead. The
+			// limit of a range counts the leaves bounding it, which may be out
+			// of it, so that it holds at least the page and the following key.
+			keys, _, _, err := tree.GetVersionedRangeWithProof(page.Start, end, page.Limit+3, res.Height)
+			if err != nil {
+				res.Log = err.Error()
+				break
+			}
+			if len(keys) > page.Limit {
+				end = keys[page.Limit]
+			}
+			proofStart, err := st.rangeProofStart(page.Start, res.Height)
+			if err != nil {
+				res.Log = err.Error()
+				break
+			}
+			keys, values, proof, err := tree.GetVersionedRangeWithProof(proofStart, end, 0, res.Height)
+			if err != nil {
+				res.Log = err.Error()
+				break
+			}
+			// the proof may start before the page
+			for len(keys) > 0 && bytes.Compare(keys[0], page.Start) < 0 {
+				keys, values = keys[1:], values[1:]
+			}
+			for i := 0; i < len(keys) && i < page.Limit; i++ {
+				KVs = append(KVs, KVPair{keys[i], values[i]})
+			}
+			p, err := cdc.MarshalBinary(proof)
+			if err != nil {
+				res.Log = err.Error()
+				break
+			}
+			res.Proof = p
+		} else {
+			iterator := st.Iterator(page.Start, end)
+			for ; iterator.Valid() && len(KVs) < page.Limit; iterator.Next() {
+				KVs = append(KVs, KVPair{iterator.Key(), iterator.Value()})
+			}
+			iterator.Close()
 		}
-		iterator.Close()
 		res.Value = cdc.MustMarshalBinary(KVs)
 	default:
 		msg := fmt.Sprintf("Unexpected Query path: %v", req.Path)
@@ -250,6 +341,37 @@ func (st *iavlStore) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 	return
 }
 
+// rangeProofStart returns the key from which to prove a range starting at
+// start: the proof of a range doesn't hold when its first leaf is the right
+// child of its parent, so it starts from the closest key before start whose
+// first leaf is a left child, or from the first key of the tree.
+func (st *iavlStore) rangeProofStart(start []byte, version int64) ([]byte, error) {
+	_, _, proof, err := st.tree.GetVersionedRangeWithProof(start, nil, 1, version)
+	if err != nil || isLeftLeaf(proof) {
+		return start, err
+	}
+	// the keys of the latest version are as good a guess as any
+	iterator := st.ReverseIterator(nil, start)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		_, _, proof, err = st.tree.GetVersionedRangeWithProof(iterator.Key(), nil, 1, version)
+		if err != nil {
+			return nil, err
+		}
+		if isLeftLeaf(proof) {
+			return iterator.Key(), nil
+		}
+	}
+	return nil, nil
+}
+
+// isLeftLeaf returns whether the leaf from which a range proof starts is the
+// left child of its parent, or the root
+func isLeftLeaf(proof *iavl.RangeProof) bool {
+	path := proof.LeftPath
+	return len(path) == 0 || len(path[len(path)-1].Right) > 0
+}
+
 //----------------------------------------
 
 // Implements Iterator.
@@ -0,0 +1,171 @@
+package store
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/tendermint/iavl"
+)
+
+// MultiStoreProof proves the presence or absence of a key in a substore of
+// the root multistore: the IAVL range proof of the key proves it against the
+// substore commit hash, and the infos of all the substores committed at that
+// version prove the substore commit hash against the app hash.
+type MultiStoreProof struct {
+	StoreInfos []storeInfo
+	StoreName  string
+	RangeProof iavl.RangeProof
+}
+
+// build the multistore proof from the IAVL proof returned by a substore query
+func buildMultiStoreProof(iavlProof []byte, storeName string, storeInfos []storeInfo) ([]byte, error) {
+	var rangeProof iavl.RangeProof
+	err := cdc.UnmarshalBinary(iavlProof, &rangeProof)
+	if err != nil {
+		return nil, err
+	}
+
+	proof := MultiStoreProof{
+		StoreInfos: storeInfos,
+		StoreName:  storeName,
+		RangeProof: rangeProof,
+	}
+	return cdc.MarshalBinary(proof)
+}
+
+// VerifyMultiStoreProof verifies the proof returned by a proven query of the
+// root multistore against the app hash of the queried version. An empty value
+// verifies the absence of the key.
+func VerifyMultiStoreProof(proofBytes []byte, storeName string, key, value, appHash []byte) error {
+	var proof MultiStoreProof
+	err := cdc.UnmarshalBinary(proofBytes, &proof)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode multistore proof")
+	}
+
+	if proof.StoreName != storeName {
+		return fmt.Errorf("proof is for store %s, expected store %s", proof.StoreName, storeName)
+	}
+
+	substoreHash, err := verifyMultiStoreCommitInfo(storeName, proof.StoreInfos, appHash)
+	if err != nil {
+		return err
+	}
+
+	err = proof.RangeProof.Verify(substoreHash)
+	if err != nil {
+		return errors.Wrap(err, "proof root hash doesn't match the substore commit hash")
+	}
+
+	if len(value) == 0 {
+		err = proof.RangeProof.VerifyAbsence(key)
+		return errors.Wrap(err, "failed to verify the absence of the key")
+	}
+
+	err = proof.RangeProof.VerifyItem(key, value)
+	return errors.Wrap(err, "failed to verify the key and value")
+}
+
+// VerifyMultiStoreRangeProof verifies the proof returned by a proven subspace
+// query of the root multistore against the app hash of the queried version:
+// the pairs must be all the pairs of the substore whose keys are in
+// [start, end), with a nil end leaving the range unbounded. A positive limit
+// verifies a page of the range instead: the pairs must be the first limit
+// pairs of the range, or all of them if there are fewer.
+func VerifyMultiStoreRangeProof(proofBytes []byte, storeName string, start, end []byte, limit int, kvs []KVPair, appHash []byte) error {
+	var proof MultiStoreProof
+	err := cdc.UnmarshalBinary(proofBytes, &proof)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode multistore proof")
+	}
+
+	if proof.StoreName != storeName {
+		return fmt.Errorf("proof is for store %s, expected store %s", proof.StoreName, storeName)
+	}
+
+	substoreHash, err := verifyMultiStoreCommitInfo(storeName, proof.StoreInfos, appHash)
+	if err != nil {
+		return err
+	}
+
+	rangeProof := proof.RangeProof
+	err = rangeProof.Verify(substoreHash)
+	if err != nil {
+		return errors.Wrap(err, "proof root hash doesn't match the substore commit hash")
+	}
+
+	// the leaves of the proof are consecutive in the substore, so the range is
+	// complete if no key is missing before the first leaf in range and after
+	// the last one
+	var inRange [][]byte
+	for _, key := range rangeProof.Keys() {
+		if bytes.Compare(key, start) >= 0 && (end == nil || bytes.Compare(key, end) < 0) {
+			inRange = append(inRange, key)
+		}
+	}
+
+	if len(inRange) == 0 || !bytes.Equal(inRange[0], start) {
+		err = rangeProof.VerifyAbsence(start)
+		if err != nil {
+			return errors.Wrap(err, "failed to verify the start of the range")
+		}
+	}
+
+	// a full page needs not reach the end of the range
+	if limit > 0 && len(kvs) == limit && len(inRange) >= limit {
+		inRange = inRange[:limit]
+	} else {
+		keys := rangeProof.Keys()
+		if len(keys) == 0 {
+			return errors.New("the proof has no keys")
+		}
+		last := keys[len(keys)-1]
+		if end == nil || bytes.Compare(last, end) < 0 {
+			// the key right after the last key
+			next := append(append([]byte{}, last...), 0)
+			err = rangeProof.VerifyAbsence(next)
+			if err != nil {
+				return errors.Wrap(err, "failed to verify the end of the range")
+			}
+		}
+	}
+
+	if len(kvs) != len(inRange) {
+		return fmt.Errorf("got %d pairs, the proof has %d keys in range", len(kvs), len(inRange))
+	}
+	for i, kv := range kvs {
+		if !bytes.Equal(kv.Key, inRange[i]) {
+			return fmt.Errorf("got key %X, the proof has key %X", kv.Key, inRange[i])
+		}
+		err = rangeProof.VerifyItem(kv.Key, kv.Value)
+		if err != nil {
+			return errors.Wrap(err, "failed to verify the key and value")
+		}
+	}
+
+	return nil
+}
+
+// verify the substore infos against the app hash and return the commit hash
+// of the given substore
+func verifyMultiStoreCommitInfo(storeName string, storeInfos []storeInfo, appHash []byte) ([]byte, error) {
+	var substoreHash []byte
+	found := false
+	for _, si := range storeInfos {
+		if si.Name == storeName {
+			substoreHash = si.Core.CommitID.Hash
+			found = true
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("store %s not found in the multistore proof", storeName)
+	}
+
+	ci := commitInfo{StoreInfos: storeInfos}
+	if !bytes.Equal(ci.Hash(), appHash) {
+		return nil, errors.New("the substore infos don't match the app hash")
+	}
+
+	return substoreHash, nil
+}
@@ -0,0 +1,177 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestVerifyMultiStoreProof(t *testing.T) {
+	db := dbm.NewMemDB()
+	multi := newMultiStoreWithMounts(db)
+	err := multi.LoadLatestVersion()
+	require.Nil(t, err)
+
+	k, v := []byte("wind"), []byte("blows")
+	k2, v2 := []byte("water"), []byte("flows")
+
+	store1 := multi.getStoreByName("store1").(KVStore)
+	store1.Set(k, v)
+	store2 := multi.getStoreByName("store2").(KVStore)
+	store2.Set(k2, v2)
+
+	cid := multi.Commit()
+
+	// prove the presence of a key
+	query := abci.RequestQuery{Path: "/store1/key", Data: k, Height: cid.Version, Prove: true}
+	qres := multi.Query(query)
+	require.True(t, qres.IsOK(), qres.Log)
+	require.Equal(t, v, qres.Value)
+
+	err = VerifyMultiStoreProof(qres.Proof, "store1", k, v, cid.Hash)
+	require.Nil(t, err)
+
+	// the proof doesn't hold for another value, store or app hash
+	err = VerifyMultiStoreProof(qres.Proof, "store1", k, v2, cid.Hash)
+	require.NotNil(t, err)
+	err = VerifyMultiStoreProof(qres.Proof, "store2", k, v, cid.Hash)
+	require.NotNil(t, err)
+	err = VerifyMultiStoreProof(qres.Proof, "store1", k, v, []byte("garbage"))
+	require.NotNil(t, err)
+
+	// prove the absence of a key
+	query.Data = k2
+	qres = multi.Query(query)
+	require.True(t, qres.IsOK(), qres.Log)
+	require.Nil(t, qres.Value)
+
+	err = VerifyMultiStoreProof(qres.Proof, "store1", k2, nil, cid.Hash)
+	require.Nil(t, err)
+	err = VerifyMultiStoreProof(qres.Proof, "store1", k2, v2, cid.Hash)
+	require.NotNil(t, err)
+}
+
+func TestVerifyMultiStoreRangeProof(t *testing.T) {
+	db := dbm.NewMemDB()
+	multi := newMultiStoreWithMounts(db)
+	err := multi.LoadLatestVersion()
+	require.Nil(t, err)
+
+	store1 := multi.getStoreByName("store1").(KVStore)
+	for _, k := range []string{"a1", "b1", "b2", "b3", "c1"} {
+		store1.Set([]byte(k), []byte("v"+k))
+	}
+
+	cid := multi.Commit()
+
+	querySubspace := func(subspace []byte) (kvs []KVPair, proof []byte) {
+		query := abci.RequestQuery{Path: "/store1/subspace", Data: subspace, Height: cid.Version, Prove: true}
+		qres := multi.Query(query)
+		require.True(t, qres.IsOK(), qres.Log)
+		cdc.MustUnmarshalBinary(qres.Value, &kvs)
+		return kvs, qres.Proof
+	}
+
+	// prove all the pairs of a subspace
+	subspace := []byte("b")
+	kvs, proof := querySubspace(subspace)
+	require.Equal(t, 3, len(kvs))
+
+	err = VerifyMultiStoreRangeProof(proof, "store1", subspace, []byte("c"), 0, kvs, cid.Hash)
+	require.Nil(t, err)
+
+	// the proof doesn't hold if a pair is missing or changed, or for another
+	// store or app hash
+	err = VerifyMultiStoreRangeProof(proof, "store1", subspace, []byte("c"), 0, kvs[:2], cid.Hash)
+	require.NotNil(t, err)
+	err = VerifyMultiStoreRangeProof(proof, "store1", subspace, []byte("c"), 0, []KVPair{kvs[0], kvs[2]}, cid.Hash)
+	require.NotNil(t, err)
+	changed := []KVPair{kvs[0], kvs[1], {Key: kvs[2].Key, Value: []byte("forged")}}
+	err = VerifyMultiStoreRangeProof(proof, "store1", subspace, []byte("c"), 0, changed, cid.Hash)
+	require.NotNil(t, err)
+	err = VerifyMultiStoreRangeProof(proof, "store2", subspace, []byte("c"), 0, kvs, cid.Hash)
+	require.NotNil(t, err)
+	err = VerifyMultiStoreRangeProof(proof, "store1", subspace, []byte("c"), 0, kvs, []byte("garbage"))
+	require.NotNil(t, err)
+
+	// nor for a wider range than the queried subspace
+	err = VerifyMultiStoreRangeProof(proof, "store1", []byte("a"), []byte("c"), 0, kvs, cid.Hash)
+	require.NotNil(t, err)
+	err = VerifyMultiStoreRangeProof(proof, "store1", subspace, nil, 0, kvs, cid.Hash)
+	require.NotNil(t, err)
+
+	// prove empty subspaces, before, between and after the keys
+	for _, subspace := range []string{"0", "b0", "d"} {
+		kvs, proof = querySubspace([]byte(subspace))
+		require.Equal(t, 0, len(kvs))
+
+		err = VerifyMultiStoreRangeProof(proof, "store1", []byte(subspace), sdk.PrefixEndBytes([]byte(subspace)), 0, kvs, cid.Hash)
+		require.Nil(t, err, subspace)
+	}
+}
+
+func TestVerifyMultiStoreRangeProofPage(t *testing.T) {
+	db := dbm.NewMemDB()
+	multi := newMultiStoreWithMounts(db)
+	err := multi.LoadLatestVersion()
+	require.Nil(t, err)
+
+	store1 := multi.getStoreByName("store1").(KVStore)
+	for _, k := range []string{"a1", "b1", "b2", "b3", "b4", "b5", "c1"} {
+		store1.Set([]byte(k), []byte("v"+k))
+	}
+
+	cid := multi.Commit()
+
+	subspace := []byte("b")
+	queryPage := func(start []byte, limit int, prove bool) (kvs []KVPair, proof []byte) {
+		data := cdc.MustMarshalBinary(SubspacePageQuery{subspace, start, limit})
+		query := abci.RequestQuery{Path: "/store1/subspace_page", Data: data, Height: cid.Version, Prove: prove}
+		qres := multi.Query(query)
+		require.True(t, qres.IsOK(), qres.Log)
+		cdc.MustUnmarshalBinary(qres.Value, &kvs)
+		return kvs, qres.Proof
+	}
+
+	// prove the pages of the subspace, each starting after the previous one
+	for _, limit := range []int{1, 2, 3, 5, 6} {
+		var keys []string
+		for start := subspace; ; {
+			kvs, proof := queryPage(start, limit, true)
+			err = VerifyMultiStoreRangeProof(proof, "store1", start, []byte("c"), limit, kvs, cid.Hash)
+			require.Nil(t, err, "limit %d, start %s", limit, start)
+
+			unproven, _ := queryPage(start, limit, false)
+			require.Equal(t, kvs, unproven)
+
+			for _, kv := range kvs {
+				keys = append(keys, string(kv.Key))
+			}
+			if len(kvs) < limit {
+				break
+			}
+			start = append(append([]byte{}, kvs[len(kvs)-1].Key...), 0)
+		}
+		require.Equal(t, []string{"b1", "b2", "b3", "b4", "b5"}, keys, "limit %d", limit)
+	}
+
+	// a page can't miss a pair, nor claim to be the end of the subspace
+	kvs, proof := queryPage([]byte("b2"), 2, true)
+	require.Equal(t, 2, len(kvs))
+	err = VerifyMultiStoreRangeProof(proof, "store1", []byte("b2"), []byte("c"), 2, kvs[:1], cid.Hash)
+	require.NotNil(t, err)
+	err = VerifyMultiStoreRangeProof(proof, "store1", []byte("b2"), []byte("c"), 0, kvs, cid.Hash)
+	require.NotNil(t, err)
+	err = VerifyMultiStoreRangeProof(proof, "store1", []byte("b1"), []byte("c"), 2, kvs, cid.Hash)
+	require.NotNil(t, err)
+
+	// pages must start in the subspace and have a limit
+	for _, page := range []SubspacePageQuery{{subspace, []byte("a1"), 2}, {subspace, subspace, 0}} {
+		query := abci.RequestQuery{Path: "/store1/subspace_page", Data: cdc.MustMarshalBinary(page), Height: cid.Version}
+		require.False(t, multi.Query(query).IsOK())
+	}
+}
@@ -277,8 +277,10 @@ func (coins Coins) Sort() Coins {
 // Parsing
 
 var (
-	// Denominations can be 3 ~ 16 characters long.
-	reDnm  = `[[:alpha:]][[:alnum:]]{2,15}`
+	// Denominations can be 3 ~ 64 characters long, with slashes separating
+	// the parts of derived denominations (ex. stake/<validator>), which may
+	// contain dashes, dots and underscores (ex. transfer/<chain-id>/atom).
+	reDnm  = `[[:alpha:]][[:alnum:]]*(?:/[[:alnum:]\-_.]*)*`
 	reAmt  = `[[:digit:]]+`
 	reSpc  = `[[:space:]]*`
 	reCoin = regexp.MustCompile(fmt.Sprintf(`^(%s)%s(%s)$`, reAmt, reSpc, reDnm))
@@ -295,6 +297,10 @@ func ParseCoin(coinStr string) (coin Coin, err error) {
 		return
 	}
 	denomStr, amountStr := matches[2], matches[1]
+	if len(denomStr) < 3 || len(denomStr) > 64 {
+		err = fmt.Errorf("invalid denomination length: %s", denomStr)
+		return
+	}
 
 	amount, err := strconv.Atoi(amountStr)
 	if err != nil {
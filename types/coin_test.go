@@ -282,6 +282,9 @@ func TestParse(t *testing.T) {
 		{"11me coin, 12you coin", false, nil}, // no spaces in coin names
 		{"1.2btc", false, nil},                // amount must be integer
 		{"5foo-bar", false, nil},              // once more, only letters in coin name
+		{"3stake/foo", true, Coins{{"stake/foo", NewInt(3)}}},
+		{"3transfer/src-chain/foo", true, Coins{{"transfer/src-chain/foo", NewInt(3)}}},
+		{"3foo-bar/foo", false, nil},          // only letters in the first part
 	}
 
 	for tcIndex, tc := range cases {
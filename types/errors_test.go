@@ -20,6 +20,7 @@ var codeTypes = []CodeType{
 	CodeInvalidCoins,
 	CodeOutOfGas,
 	CodeMemoTooLarge,
+	CodeTxTimeout,
 }
 
 type errFn func(msg string) Error
@@ -38,6 +39,7 @@ var errFns = []errFn{
 	ErrInvalidCoins,
 	ErrOutOfGas,
 	ErrMemoTooLarge,
+	ErrTxTimeout,
 }
 
 func TestCodeType(t *testing.T) {
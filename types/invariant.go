@@ -0,0 +1,5 @@
+package types
+
+// Invariant checks a property of the application state which must always
+// hold, returning an error describing the violation if it does not.
+type Invariant func(ctx Context) error
@@ -35,17 +35,42 @@ func (b BondStatus) Equal(b2 BondStatus) bool {
 	return byte(b) == byte(b2)
 }
 
+// infraction for which a validator is slashed
+type Infraction byte
+
+// nolint
+const (
+	InfractionDoubleSign Infraction = 0x01
+	InfractionDowntime   Infraction = 0x02
+	InfractionGovernance Infraction = 0x03
+)
+
+// String returns the name of the infraction
+func (i Infraction) String() string {
+	switch i {
+	case InfractionDoubleSign:
+		return "double_sign"
+	case InfractionDowntime:
+		return "downtime"
+	case InfractionGovernance:
+		return "governance"
+	default:
+		return "unknown"
+	}
+}
+
 // validator for a delegated proof of stake system
 type Validator interface {
-	GetRevoked() bool         // whether the validator is revoked
-	GetMoniker() string       // moniker of the validator
-	GetStatus() BondStatus    // status of the validator
-	GetOperator() AccAddress  // owner AccAddress to receive/return validators coins
-	GetPubKey() crypto.PubKey // validation pubkey
-	GetPower() Dec            // validation power
-	GetTokens() Dec           // validation tokens
-	GetDelegatorShares() Dec  // Total out standing delegator shares
-	GetBondHeight() int64     // height in which the validator became active
+	GetRevoked() bool          // whether the validator is revoked
+	GetMoniker() string        // moniker of the validator
+	GetStatus() BondStatus     // status of the validator
+	GetOperator() AccAddress   // owner AccAddress to receive/return validators coins
+	GetPubKey() crypto.PubKey  // validation pubkey
+	GetPower() Dec             // validation power
+	GetTokens() Dec            // validation tokens
+	GetDelegatorShares() Dec   // Total out standing delegator shares
+	GetBondHeight() int64      // height in which the validator became active
+	GetMinSelfDelegation() Int // minimum tokens of the self-delegation of the operator
 }
 
 // validator which fulfills abci validator interface for use in Tendermint
@@ -70,8 +95,10 @@ type ValidatorSet interface {
 	ValidatorByPubKey(Context, crypto.PubKey) Validator // get a particular validator by signing PubKey
 	TotalPower(Context) Dec                             // total power of the validator set
 
-	// slash the validator and delegators of the validator, specifying offence height, offence power, and slash fraction
-	Slash(Context, crypto.PubKey, int64, int64, Dec)
+	Delegation(Context, AccAddress, AccAddress) Delegation // get the delegation of a delegator (first) to a validator (second)
+
+	// slash the validator and delegators of the validator, specifying offence height, offence power, slash fraction, and infraction
+	Slash(Context, crypto.PubKey, int64, int64, Dec, Infraction)
 	Revoke(Context, crypto.PubKey)   // revoke a validator
 	Unrevoke(Context, crypto.PubKey) // unrevoke a validator
 }
@@ -94,3 +121,20 @@ type DelegationSet interface {
 	IterateDelegations(ctx Context, delegator AccAddress,
 		fn func(index int64, delegation Delegation) (stop bool))
 }
+
+//_______________________________________________________________________________
+
+// event hooks for staking validator and delegation changes, which modules
+// register on the stake keeper
+type StakingHooks interface {
+	OnValidatorCreated(ctx Context, pubKey crypto.PubKey, operator AccAddress)        // a validator is created
+	OnValidatorBonded(ctx Context, pubKey crypto.PubKey, operator AccAddress)         // a validator is bonded
+	OnValidatorBeginUnbonding(ctx Context, pubKey crypto.PubKey, operator AccAddress) // a validator begins unbonding
+	OnValidatorRemoved(ctx Context, pubKey crypto.PubKey, operator AccAddress)        // a validator is removed
+
+	OnValidatorConsPubKeyRotated(ctx Context, oldPubKey, newPubKey crypto.PubKey, operator AccAddress) // the consensus pubkey of a validator is rotated
+
+	OnDelegationCreated(ctx Context, delAddr AccAddress, valAddr AccAddress)        // a delegation is created
+	OnDelegationSharesModified(ctx Context, delAddr AccAddress, valAddr AccAddress) // the shares of a delegation are modified
+	OnDelegationRemoved(ctx Context, delAddr AccAddress, valAddr AccAddress)        // a delegation is removed
+}
@@ -122,5 +122,6 @@ func (acc *BaseAccount) SetSequence(seq int64) error {
 func RegisterBaseAccount(cdc *wire.Codec) {
 	cdc.RegisterInterface((*Account)(nil), nil)
 	cdc.RegisterConcrete(&BaseAccount{}, "cosmos-sdk/BaseAccount", nil)
+	cdc.RegisterConcrete(&ModuleAccount{}, "cosmos-sdk/ModuleAccount", nil)
 	wire.RegisterCrypto(cdc)
 }
@@ -57,6 +57,14 @@ func NewAnteHandler(am AccountMapper, fck FeeCollectionKeeper) sdk.AnteHandler {
 			return newCtx, err.Result(), true
 		}
 
+		// reject txs which are no longer valid at this height
+		timeoutHeight := stdTx.GetTimeoutHeight()
+		if timeoutHeight > 0 && timeoutHeight < newCtx.BlockHeight() {
+			return newCtx, sdk.ErrTxTimeout(
+				fmt.Sprintf("tx timeout height %d is below the current block height %d",
+					timeoutHeight, newCtx.BlockHeight())).Result(), true
+		}
+
 		sigs := stdTx.GetSignatures()
 		signerAddrs := stdTx.GetSigners()
 		msgs := tx.GetMsgs()
@@ -79,7 +87,7 @@ func NewAnteHandler(am AccountMapper, fck FeeCollectionKeeper) sdk.AnteHandler {
 			signerAddr, sig := signerAddrs[i], sigs[i]
 
 			// check signature, return account with incremented nonce
-			signBytes := StdSignBytes(newCtx.ChainID(), accNums[i], sequences[i], fee, msgs, stdTx.GetMemo())
+			signBytes := StdSignBytes(newCtx.ChainID(), accNums[i], sequences[i], fee, msgs, stdTx.GetMemo(), timeoutHeight)
 			signerAcc, res := processSig(
 				newCtx, am,
 				signerAddr, sig, signBytes,
@@ -134,6 +142,10 @@ func validateBasic(tx StdTx) (err sdk.Error) {
 			fmt.Sprintf("maximum number of characters is %d but received %d characters",
 				maxMemoCharacters, len(memo)))
 	}
+
+	if tx.GetTimeoutHeight() < 0 {
+		return sdk.ErrTxTimeout("timeout height cannot be negative")
+	}
 	return nil
 }
 
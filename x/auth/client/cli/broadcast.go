@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/wire"
+
+	"github.com/spf13/cobra"
+)
+
+// GetBroadcastCommand returns the broadcast command, which broadcasts a
+// signed transaction to a node.
+func GetBroadcastCommand(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "broadcast <file>",
+		Short: "Broadcast a transaction signed offline",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			stdTx, err := utils.ReadStdTxFromFile(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			txBytes, err := cdc.MarshalBinary(stdTx)
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout)
+
+			return cliCtx.EnsureBroadcastTx(txBytes)
+		},
+	}
+
+	cmd.Flags().String(client.FlagNode, "tcp://localhost:26657", "<host>:<port> to tendermint rpc interface for this chain")
+	cmd.Flags().Bool(client.FlagAsync, false, "broadcast transactions asynchronously")
+	cmd.Flags().Bool(client.FlagJson, false, "return output in json format")
+	cmd.Flags().Bool(client.FlagPrintResponse, false, "return tx response (only works with async = false)")
+
+	return cmd
+}
@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authctx "github.com/cosmos/cosmos-sdk/x/auth/client/context"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	flagOffline = "offline"
+)
+
+// GetSignCommand returns the sign command, which signs a transaction
+// generated offline and prints it with the signature appended.
+func GetSignCommand(cdc *wire.Codec, decoder auth.AccountDecoder) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign <file>",
+		Short: "Sign a transaction generated offline",
+		Long: `Sign a transaction created with the --generate-only flag, and print it with the
+signature appended. Unless --offline is set, the account number and sequence
+of the key are queried from the node, otherwise they are read from the
+--account-number and --sequence flags.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			stdTx, err := utils.ReadStdTxFromFile(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			name := viper.GetString(client.FlagFrom)
+			if name == "" {
+				return errors.Errorf("must provide the name of the key to sign with")
+			}
+
+			txCtx := authctx.NewTxContextFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(decoder)
+
+			signed, err := utils.SignStdTx(txCtx, cliCtx, name, stdTx, viper.GetBool(flagOffline))
+			if err != nil {
+				return err
+			}
+
+			output, err := wire.MarshalJSONIndent(cdc, signed)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(client.FlagFrom, "", "Name of private key with which to sign")
+	cmd.Flags().Bool(flagOffline, false, "Don't query the account number and sequence of the key from the node")
+	cmd.Flags().Int64(client.FlagAccountNumber, 0, "Account number of the key, used with --offline")
+	cmd.Flags().Int64(client.FlagSequence, 0, "Sequence of the key, used with --offline")
+	cmd.Flags().String(client.FlagChainID, "", "Chain ID of tendermint node")
+	cmd.Flags().String(client.FlagNode, "tcp://localhost:26657", "<host>:<port> to tendermint rpc interface for this chain")
+	cmd.Flags().Bool(client.FlagTrustNode, true, "Don't verify proofs for responses")
+
+	return cmd
+}
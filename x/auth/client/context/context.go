@@ -1,6 +1,8 @@
 package context
 
 import (
+	"bytes"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/keys"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,9 +19,12 @@ type TxContext struct {
 	AccountNumber int64
 	Sequence      int64
 	Gas           int64
+	GasAdjustment float64
+	SimulateGas   bool
 	ChainID       string
 	Memo          string
 	Fee           string
+	TimeoutHeight int64
 }
 
 // NewTxContextFromCLI returns a new initialized TxContext with parameters from
@@ -34,13 +39,20 @@ func NewTxContextFromCLI() TxContext {
 		}
 	}
 
+	// the gas flag is validated when parsed, it is only missing from the
+	// commands which don't build transactions
+	simulateGas, gas, _ := client.ParseGas(viper.GetString(client.FlagGas))
+
 	return TxContext{
 		ChainID:       chainID,
-		Gas:           viper.GetInt64(client.FlagGas),
+		Gas:           gas,
+		GasAdjustment: viper.GetFloat64(client.FlagGasAdjustment),
+		SimulateGas:   simulateGas,
 		AccountNumber: viper.GetInt64(client.FlagAccountNumber),
 		Sequence:      viper.GetInt64(client.FlagSequence),
 		Fee:           viper.GetString(client.FlagFee),
 		Memo:          viper.GetString(client.FlagMemo),
+		TimeoutHeight: viper.GetInt64(client.FlagTimeoutHeight),
 	}
 }
 
@@ -62,6 +74,20 @@ func (ctx TxContext) WithGas(gas int64) TxContext {
 	return ctx
 }
 
+// WithGasAdjustment returns a copy of the context with an updated gas
+// adjustment.
+func (ctx TxContext) WithGasAdjustment(adjustment float64) TxContext {
+	ctx.GasAdjustment = adjustment
+	return ctx
+}
+
+// WithSimulateGas returns a copy of the context with an updated flag to
+// estimate the gas by simulation.
+func (ctx TxContext) WithSimulateGas(simulate bool) TxContext {
+	ctx.SimulateGas = simulate
+	return ctx
+}
+
 // WithFee returns a copy of the context with an updated fee.
 func (ctx TxContext) WithFee(fee string) TxContext {
 	ctx.Fee = fee
@@ -80,6 +106,13 @@ func (ctx TxContext) WithMemo(memo string) TxContext {
 	return ctx
 }
 
+// WithTimeoutHeight returns a copy of the context with an updated timeout
+// height.
+func (ctx TxContext) WithTimeoutHeight(height int64) TxContext {
+	ctx.TimeoutHeight = height
+	return ctx
+}
+
 // WithAccountNumber returns a copy of the context with an account number.
 func (ctx TxContext) WithAccountNumber(accnum int64) TxContext {
 	ctx.AccountNumber = accnum
@@ -110,9 +143,8 @@ func (ctx TxContext) Build(msgs []sdk.Msg) (auth.StdSignMsg, error) {
 		Sequence:      ctx.Sequence,
 		Memo:          ctx.Memo,
 		Msgs:          msgs,
-
-		// TODO: run simulate to estimate gas?
-		Fee: auth.NewStdFee(ctx.Gas, fee),
+		TimeoutHeight: ctx.TimeoutHeight,
+		Fee:           auth.NewStdFee(ctx.Gas, fee),
 	}, nil
 }
 
@@ -136,7 +168,7 @@ func (ctx TxContext) Sign(name, passphrase string, msg auth.StdSignMsg) ([]byte,
 		Signature:     sig,
 	}}
 
-	return ctx.Codec.MarshalBinary(auth.NewStdTx(msg.Msgs, msg.Fee, sigs, msg.Memo))
+	return ctx.Codec.MarshalBinary(auth.NewStdTx(msg.Msgs, msg.Fee, sigs, msg.Memo, msg.TimeoutHeight))
 }
 
 // BuildAndSign builds a single message to be signed, and signs a transaction
@@ -150,3 +182,70 @@ func (ctx TxContext) BuildAndSign(name, passphrase string, msgs []sdk.Msg) ([]by
 
 	return ctx.Sign(name, passphrase, msg)
 }
+
+// BuildUnsigned builds a transaction of the given messages without any
+// signature, to be signed later on, possibly offline.
+func (ctx TxContext) BuildUnsigned(msgs []sdk.Msg) (auth.StdTx, error) {
+	msg, err := ctx.Build(msgs)
+	if err != nil {
+		return auth.StdTx{}, err
+	}
+
+	return auth.NewStdTx(msg.Msgs, msg.Fee, nil, msg.Memo, msg.TimeoutHeight), nil
+}
+
+// SignStdTx appends to a transaction the signature of the given key, made
+// with the chain ID, account number and sequence of the context. An error is
+// returned if the key isn't the next signer of the transaction.
+func (ctx TxContext) SignStdTx(name, passphrase string, stdTx auth.StdTx) (auth.StdTx, error) {
+	if ctx.ChainID == "" {
+		return auth.StdTx{}, errors.Errorf("chain ID required but not specified")
+	}
+
+	keybase, err := keys.GetKeyBase()
+	if err != nil {
+		return auth.StdTx{}, err
+	}
+
+	info, err := keybase.Get(name)
+	if err != nil {
+		return auth.StdTx{}, err
+	}
+
+	// the signatures are ordered as the signers of the transaction
+	signers := stdTx.GetSigners()
+	sigs := stdTx.GetSignatures()
+	if len(sigs) >= len(signers) {
+		return auth.StdTx{}, errors.Errorf("transaction is already signed by all its signers")
+	}
+	if !bytes.Equal(signers[len(sigs)], info.GetPubKey().Address()) {
+		return auth.StdTx{}, errors.Errorf("key %s is not the next signer of the transaction, expected %s",
+			name, signers[len(sigs)])
+	}
+
+	signMsg := auth.StdSignMsg{
+		ChainID:       ctx.ChainID,
+		AccountNumber: ctx.AccountNumber,
+		Sequence:      ctx.Sequence,
+		Fee:           stdTx.Fee,
+		Msgs:          stdTx.GetMsgs(),
+		Memo:          stdTx.GetMemo(),
+		TimeoutHeight: stdTx.GetTimeoutHeight(),
+	}
+
+	sig, pubkey, err := keybase.Sign(name, passphrase, signMsg.Bytes())
+	if err != nil {
+		return auth.StdTx{}, err
+	}
+
+	stdSig := auth.StdSignature{
+		AccountNumber: signMsg.AccountNumber,
+		Sequence:      signMsg.Sequence,
+		PubKey:        pubkey,
+		Signature:     sig,
+	}
+
+	// copy the signatures so that the given transaction is left untouched
+	signed := append(append([]auth.StdSignature{}, sigs...), stdSig)
+	return auth.NewStdTx(stdTx.Msgs, stdTx.Fee, signed, stdTx.Memo, stdTx.TimeoutHeight), nil
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/routes"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -14,11 +15,15 @@ import (
 )
 
 // register REST routes
-func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *wire.Codec, storeName string) {
-	r.HandleFunc(
-		"/accounts/{address}",
-		QueryAccountRequestHandlerFn(storeName, cdc, authcmd.GetAccountDecoder(cdc), cliCtx),
-	).Methods("GET")
+func RegisterRoutes(cliCtx context.CLIContext, r *routes.Router, cdc *wire.Codec, storeName string) {
+	r.Register(routes.Route{
+		Name:     "GetAccount",
+		Method:   "GET",
+		Path:     "/accounts/{address}",
+		Summary:  "Get an account, empty if it does not exist",
+		Response: new(auth.Account),
+		Handler:  QueryAccountRequestHandlerFn(storeName, cdc, authcmd.GetAccountDecoder(cdc), cliCtx),
+	})
 }
 
 // query accountREST Handler
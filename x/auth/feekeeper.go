@@ -2,50 +2,50 @@ package auth
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	wire "github.com/cosmos/cosmos-sdk/wire"
 )
 
-var (
-	collectedFeesKey = []byte("collectedFees")
-)
-
-// This FeeCollectionKeeper handles collection of fees in the anteHandler
-// and setting of MinFees for different fee tokens
+// This FeeCollectionKeeper handles collection of fees in the anteHandler.
+// The collected fees are held by the fee collector module account.
 type FeeCollectionKeeper struct {
 
-	// The (unexposed) key used to access the fee store from the Context.
-	key sdk.StoreKey
-
-	// The wire codec for binary encoding/decoding of accounts.
-	cdc *wire.Codec
+	// The account mapper holding the fee collector module account.
+	am AccountMapper
 }
 
 // NewFeeKeeper returns a new FeeKeeper
-func NewFeeCollectionKeeper(cdc *wire.Codec, key sdk.StoreKey) FeeCollectionKeeper {
+func NewFeeCollectionKeeper(am AccountMapper) FeeCollectionKeeper {
 	return FeeCollectionKeeper{
-		key: key,
-		cdc: cdc,
+		am: am,
 	}
 }
 
-// Adds to Collected Fee Pool
+// Gets the fee collector module account, creating it if needed
+func (fck FeeCollectionKeeper) getFeeCollector(ctx sdk.Context) Account {
+	acc := fck.am.GetAccount(ctx, NewModuleAddress(FeeCollectorName))
+	if acc == nil {
+		acc = fck.am.NewAccount(ctx, NewModuleAccount(FeeCollectorName))
+	}
+	return acc
+}
+
+// Gets the Collected Fee Pool
 func (fck FeeCollectionKeeper) GetCollectedFees(ctx sdk.Context) sdk.Coins {
-	store := ctx.KVStore(fck.key)
-	bz := store.Get(collectedFeesKey)
-	if bz == nil {
+	acc := fck.am.GetAccount(ctx, NewModuleAddress(FeeCollectorName))
+	if acc == nil || acc.GetCoins() == nil {
 		return sdk.Coins{}
 	}
-
-	feePool := &(sdk.Coins{})
-	fck.cdc.MustUnmarshalBinary(bz, feePool)
-	return *feePool
+	return acc.GetCoins()
 }
 
 // Sets to Collected Fee Pool
 func (fck FeeCollectionKeeper) setCollectedFees(ctx sdk.Context, coins sdk.Coins) {
-	bz := fck.cdc.MustMarshalBinary(coins)
-	store := ctx.KVStore(fck.key)
-	store.Set(collectedFeesKey, bz)
+	acc := fck.getFeeCollector(ctx)
+	err := acc.SetCoins(coins)
+	if err != nil {
+		// Handle w/ #870
+		panic(err)
+	}
+	fck.am.SetAccount(ctx, acc)
 }
 
 // Adds to Collected Fee Pool
@@ -19,12 +19,14 @@ var (
 )
 
 func TestFeeCollectionKeeperGetSet(t *testing.T) {
-	ms, _, capKey2 := setupMultiStore()
+	ms, capKey, _ := setupMultiStore()
 	cdc := wire.NewCodec()
+	RegisterBaseAccount(cdc)
+	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
 
 	// make context and keeper
 	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
-	fck := NewFeeCollectionKeeper(cdc, capKey2)
+	fck := NewFeeCollectionKeeper(mapper)
 
 	// no coins initially
 	currFees := fck.GetCollectedFees(ctx)
@@ -38,12 +40,14 @@ func TestFeeCollectionKeeperGetSet(t *testing.T) {
 }
 
 func TestFeeCollectionKeeperAdd(t *testing.T) {
-	ms, _, capKey2 := setupMultiStore()
+	ms, capKey, _ := setupMultiStore()
 	cdc := wire.NewCodec()
+	RegisterBaseAccount(cdc)
+	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
 
 	// make context and keeper
 	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
-	fck := NewFeeCollectionKeeper(cdc, capKey2)
+	fck := NewFeeCollectionKeeper(mapper)
 
 	// no coins initially
 	require.True(t, fck.GetCollectedFees(ctx).IsEqual(emptyCoins))
@@ -58,12 +62,14 @@ func TestFeeCollectionKeeperAdd(t *testing.T) {
 }
 
 func TestFeeCollectionKeeperClear(t *testing.T) {
-	ms, _, capKey2 := setupMultiStore()
+	ms, capKey, _ := setupMultiStore()
 	cdc := wire.NewCodec()
+	RegisterBaseAccount(cdc)
+	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
 
 	// make context and keeper
 	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
-	fck := NewFeeCollectionKeeper(cdc, capKey2)
+	fck := NewFeeCollectionKeeper(mapper)
 
 	// set coins initially
 	fck.setCollectedFees(ctx, twoCoins)
@@ -73,3 +79,21 @@ func TestFeeCollectionKeeperClear(t *testing.T) {
 	fck.ClearCollectedFees(ctx)
 	require.True(t, fck.GetCollectedFees(ctx).IsEqual(emptyCoins))
 }
+
+func TestFeeCollectionKeeperModuleAccount(t *testing.T) {
+	ms, capKey, _ := setupMultiStore()
+	cdc := wire.NewCodec()
+	RegisterBaseAccount(cdc)
+	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
+
+	// make context and keeper
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+	fck := NewFeeCollectionKeeper(mapper)
+
+	// the fees are held by the fee collector module account
+	fck.addCollectedFees(ctx, twoCoins)
+	acc, ok := mapper.GetAccount(ctx, NewModuleAddress(FeeCollectorName)).(*ModuleAccount)
+	require.True(t, ok)
+	require.Equal(t, FeeCollectorName, acc.Name)
+	require.True(t, acc.GetCoins().IsEqual(twoCoins))
+}
@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// Permissions of module accounts
+const (
+	Minter  = "minter"  // may mint new coins into the module account
+	Burner  = "burner"  // may burn coins held by the module account
+	Staking = "staking" // may hold coins delegated by other accounts
+)
+
+// name of the module account collecting fees
+const FeeCollectorName = "fee_collector"
+
+var _ Account = (*ModuleAccount)(nil)
+
+// ModuleAccount - account holding the coins of a module, such as collected
+// fees or bonded tokens. Its address is derived from the name of the module,
+// and it has no public key so it can never sign a transaction.
+type ModuleAccount struct {
+	BaseAccount
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions"`
+}
+
+// NewModuleAddress returns the deterministic address of the account of a module
+func NewModuleAddress(name string) sdk.AccAddress {
+	hash := sha256.Sum256([]byte(name))
+	return sdk.AccAddress(hash[:20])
+}
+
+// NewModuleAccount returns a new account for the module with the permissions
+func NewModuleAccount(name string, permissions ...string) *ModuleAccount {
+	return &ModuleAccount{
+		BaseAccount: NewBaseAccountWithAddress(NewModuleAddress(name)),
+		Name:        name,
+		Permissions: permissions,
+	}
+}
+
+// HasPermission returns whether or not the module account has the permission
+func (acc ModuleAccount) HasPermission(permission string) bool {
+	for _, perm := range acc.Permissions {
+		if perm == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// Implements sdk.Account.
+func (acc *ModuleAccount) SetPubKey(pubKey crypto.PubKey) error {
+	return errors.New("cannot set the public key of a module account")
+}
+
+// Implements sdk.Account.
+func (acc *ModuleAccount) SetSequence(seq int64) error {
+	return errors.New("cannot set the sequence of a module account")
+}
@@ -0,0 +1,45 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	wire "github.com/cosmos/cosmos-sdk/wire"
+)
+
+func TestModuleAddress(t *testing.T) {
+	require.Equal(t, NewModuleAddress("stake"), NewModuleAddress("stake"))
+	require.NotEqual(t, NewModuleAddress("stake"), NewModuleAddress("gov"))
+	require.Len(t, NewModuleAddress("stake"), 20)
+}
+
+func TestModuleAccount(t *testing.T) {
+	acc := NewModuleAccount("stake", Burner, Staking)
+	require.Equal(t, NewModuleAddress("stake"), acc.GetAddress())
+	require.True(t, acc.HasPermission(Burner))
+	require.True(t, acc.HasPermission(Staking))
+	require.False(t, acc.HasPermission(Minter))
+
+	// a module account can never sign
+	require.NotNil(t, acc.SetPubKey(ed25519.GenPrivKey().PubKey()))
+	require.NotNil(t, acc.SetSequence(1))
+	require.Nil(t, acc.GetPubKey())
+
+	// module accounts are stored like any other account
+	ms, capKey, _ := setupMultiStore()
+	cdc := wire.NewCodec()
+	RegisterBaseAccount(cdc)
+	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+
+	err := acc.SetCoins(twoCoins)
+	require.Nil(t, err)
+	mapper.SetAccount(ctx, mapper.NewAccount(ctx, acc))
+	stored := mapper.GetAccount(ctx, acc.GetAddress())
+	require.Equal(t, acc, stored)
+}
@@ -12,19 +12,23 @@ var _ sdk.Tx = (*StdTx)(nil)
 
 // StdTx is a standard way to wrap a Msg with Fee and Signatures.
 // NOTE: the first signature is the FeePayer (Signatures must not be nil).
+// A non-zero TimeoutHeight is the last block height at which the tx
+// may be included; zero means the tx never times out.
 type StdTx struct {
-	Msgs       []sdk.Msg      `json:"msg"`
-	Fee        StdFee         `json:"fee"`
-	Signatures []StdSignature `json:"signatures"`
-	Memo       string         `json:"memo"`
+	Msgs          []sdk.Msg      `json:"msg"`
+	Fee           StdFee         `json:"fee"`
+	Signatures    []StdSignature `json:"signatures"`
+	Memo          string         `json:"memo"`
+	TimeoutHeight int64          `json:"timeout_height"`
 }
 
-func NewStdTx(msgs []sdk.Msg, fee StdFee, sigs []StdSignature, memo string) StdTx {
+func NewStdTx(msgs []sdk.Msg, fee StdFee, sigs []StdSignature, memo string, timeoutHeight int64) StdTx {
 	return StdTx{
-		Msgs:       msgs,
-		Fee:        fee,
-		Signatures: sigs,
-		Memo:       memo,
+		Msgs:          msgs,
+		Fee:           fee,
+		Signatures:    sigs,
+		Memo:          memo,
+		TimeoutHeight: timeoutHeight,
 	}
 }
 
@@ -53,6 +57,10 @@ func (tx StdTx) GetSigners() []sdk.AccAddress {
 //nolint
 func (tx StdTx) GetMemo() string { return tx.Memo }
 
+// GetTimeoutHeight returns the last block height at which the tx is valid,
+// or zero if the tx does not time out.
+func (tx StdTx) GetTimeoutHeight() int64 { return tx.TimeoutHeight }
+
 // Signatures returns the signature of signers who signed the Msg.
 // GetSignatures returns the signature of signers who signed the Msg.
 // CONTRACT: Length returned is same as length of
@@ -107,9 +115,10 @@ func (fee StdFee) Bytes() []byte {
 
 // StdSignDoc is replay-prevention structure.
 // It includes the result of msg.GetSignBytes(),
-// as well as the ChainID (prevent cross chain replay)
-// and the Sequence numbers for each signature (prevent
-// inchain replay and enforce tx ordering per account).
+// as well as the ChainID (prevent cross chain replay),
+// the Sequence numbers for each signature (prevent
+// inchain replay and enforce tx ordering per account)
+// and the TimeoutHeight (bound the replay window in time).
 type StdSignDoc struct {
 	AccountNumber int64             `json:"account_number"`
 	ChainID       string            `json:"chain_id"`
@@ -117,10 +126,11 @@ type StdSignDoc struct {
 	Memo          string            `json:"memo"`
 	Msgs          []json.RawMessage `json:"msgs"`
 	Sequence      int64             `json:"sequence"`
+	TimeoutHeight int64             `json:"timeout_height"`
 }
 
 // StdSignBytes returns the bytes to sign for a transaction.
-func StdSignBytes(chainID string, accnum int64, sequence int64, fee StdFee, msgs []sdk.Msg, memo string) []byte {
+func StdSignBytes(chainID string, accnum int64, sequence int64, fee StdFee, msgs []sdk.Msg, memo string, timeoutHeight int64) []byte {
 	var msgsBytes []json.RawMessage
 	for _, msg := range msgs {
 		msgsBytes = append(msgsBytes, json.RawMessage(msg.GetSignBytes()))
@@ -132,6 +142,7 @@ func StdSignBytes(chainID string, accnum int64, sequence int64, fee StdFee, msgs
 		Memo:          memo,
 		Msgs:          msgsBytes,
 		Sequence:      sequence,
+		TimeoutHeight: timeoutHeight,
 	})
 	if err != nil {
 		panic(err)
@@ -149,11 +160,12 @@ type StdSignMsg struct {
 	Fee           StdFee
 	Msgs          []sdk.Msg
 	Memo          string
+	TimeoutHeight int64
 }
 
 // get message bytes
 func (msg StdSignMsg) Bytes() []byte {
-	return StdSignBytes(msg.ChainID, msg.AccountNumber, msg.Sequence, msg.Fee, msg.Msgs, msg.Memo)
+	return StdSignBytes(msg.ChainID, msg.AccountNumber, msg.Sequence, msg.Fee, msg.Msgs, msg.Memo, msg.TimeoutHeight)
 }
 
 // Standard Signature
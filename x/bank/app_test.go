@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/mock"
+	"github.com/cosmos/cosmos-sdk/x/params"
 
 	"github.com/stretchr/testify/require"
 
@@ -90,6 +91,30 @@ func getMockApp(t *testing.T) *mock.App {
 	return mapp
 }
 
+// initialize the mock application for this module with the provided bank genesis
+func getMockAppWithGenesis(t *testing.T, genesis GenesisState) (*mock.App, Keeper) {
+	mapp := mock.NewApp()
+
+	RegisterWire(mapp.Cdc)
+	keyBank := sdk.NewKVStoreKey("bank")
+	keyParams := sdk.NewKVStoreKey("params")
+	paramsKeeper := params.NewKeeper(mapp.Cdc, keyParams)
+	keeper := NewKeeper(mapp.Cdc, keyBank, mapp.AccountMapper, paramsKeeper.Setter(), mapp.RegisterCodespace(DefaultCodespace))
+	mapp.Router().AddRoute("bank", NewHandler(keeper))
+
+	mapp.SetInitChainer(func(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
+		mapp.InitChainer(ctx, req)
+		err := InitGenesis(ctx, keeper, genesis)
+		if err != nil {
+			panic(err)
+		}
+		return abci.ResponseInitChain{}
+	})
+
+	require.NoError(t, mapp.CompleteSetup([]*sdk.KVStoreKey{keyBank, keyParams}))
+	return mapp, keeper
+}
+
 func TestMsgSendWithAccounts(t *testing.T) {
 	mapp := getMockApp(t)
 	acc := &auth.BaseAccount{
@@ -270,3 +295,203 @@ func TestMsgSendDependent(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgIssueAndBurn(t *testing.T) {
+	genesis := DefaultGenesisState()
+	genesis.Issuances = []Issuance{
+		NewIssuance("barcoin", sdk.NewInt(100), addr1),
+	}
+	mapp, keeper := getMockAppWithGenesis(t, genesis)
+
+	acc1 := &auth.BaseAccount{
+		Address: addr1,
+		Coins:   sdk.Coins{sdk.NewInt64Coin("foocoin", 42)},
+	}
+	acc2 := &auth.BaseAccount{
+		Address: addr2,
+		Coins:   sdk.Coins{sdk.NewInt64Coin("foocoin", 42)},
+	}
+
+	mock.SetGenesis(mapp, []auth.Account{acc1, acc2})
+
+	ctxCheck := mapp.BaseApp.NewContext(true, abci.Header{})
+	require.Equal(t, sdk.NewInt(84), keeper.GetSupply(ctxCheck, "foocoin"))
+	require.True(t, keeper.GetSupply(ctxCheck, "barcoin").IsZero())
+
+	issueMsg := NewMsgIssue(addr1, []Output{
+		NewOutput(addr1, sdk.Coins{sdk.NewInt64Coin("barcoin", 60)}),
+		NewOutput(addr2, sdk.Coins{sdk.NewInt64Coin("barcoin", 30)}),
+	})
+
+	testCases := []appTestCase{
+		// only an issuer of the denomination may issue it
+		{
+			msgs:     []sdk.Msg{NewMsgIssue(addr2, []Output{NewOutput(addr2, sdk.Coins{sdk.NewInt64Coin("barcoin", 10)})})},
+			accNums:  []int64{1},
+			accSeqs:  []int64{0},
+			expPass:  false,
+			privKeys: []crypto.PrivKey{priv2},
+		},
+		// denominations without issuance parameters cannot be issued
+		{
+			msgs:     []sdk.Msg{NewMsgIssue(addr1, []Output{NewOutput(addr1, sdk.Coins{sdk.NewInt64Coin("foocoin", 10)})})},
+			accNums:  []int64{0},
+			accSeqs:  []int64{0},
+			expPass:  false,
+			privKeys: []crypto.PrivKey{priv1},
+		},
+		{
+			msgs:     []sdk.Msg{issueMsg},
+			accNums:  []int64{0},
+			accSeqs:  []int64{1},
+			expPass:  true,
+			privKeys: []crypto.PrivKey{priv1},
+			expectedBalances: []expectedBalance{
+				{addr1, sdk.Coins{sdk.NewInt64Coin("barcoin", 60), sdk.NewInt64Coin("foocoin", 42)}},
+				{addr2, sdk.Coins{sdk.NewInt64Coin("barcoin", 30), sdk.NewInt64Coin("foocoin", 42)}},
+			},
+		},
+		// the supply cap of barcoin would be exceeded
+		{
+			msgs:     []sdk.Msg{issueMsg},
+			accNums:  []int64{0},
+			accSeqs:  []int64{2},
+			expPass:  false,
+			privKeys: []crypto.PrivKey{priv1},
+		},
+		{
+			msgs:     []sdk.Msg{NewMsgBurn(addr2, sdk.Coins{sdk.NewInt64Coin("barcoin", 10), sdk.NewInt64Coin("foocoin", 2)})},
+			accNums:  []int64{1},
+			accSeqs:  []int64{1},
+			expPass:  true,
+			privKeys: []crypto.PrivKey{priv2},
+			expectedBalances: []expectedBalance{
+				{addr2, sdk.Coins{sdk.NewInt64Coin("barcoin", 20), sdk.NewInt64Coin("foocoin", 40)}},
+			},
+		},
+		// cannot burn more coins than held
+		{
+			msgs:     []sdk.Msg{NewMsgBurn(addr2, sdk.Coins{sdk.NewInt64Coin("barcoin", 21)})},
+			accNums:  []int64{1},
+			accSeqs:  []int64{2},
+			expPass:  false,
+			privKeys: []crypto.PrivKey{priv2},
+		},
+	}
+
+	for _, tc := range testCases {
+		mock.SignCheckDeliver(t, mapp.BaseApp, tc.msgs, tc.accNums, tc.accSeqs, tc.expPass, tc.privKeys...)
+
+		for _, eb := range tc.expectedBalances {
+			mock.CheckBalance(t, mapp, eb.addr, eb.coins)
+		}
+	}
+
+	ctxCheck = mapp.BaseApp.NewContext(true, abci.Header{})
+	require.Equal(t, sdk.NewInt(82), keeper.GetSupply(ctxCheck, "foocoin"))
+	require.Equal(t, sdk.NewInt(80), keeper.GetSupply(ctxCheck, "barcoin"))
+}
+
+func TestMsgSendRestrictions(t *testing.T) {
+	genesis := NewGenesisState(
+		[]Issuance{NewIssuance("barcoin", sdk.ZeroInt(), addr1)},
+		false,
+		[]SendEnabled{NewSendEnabled("foocoin", true)},
+		[]sdk.AccAddress{addr3},
+	)
+	mapp, keeper := getMockAppWithGenesis(t, genesis)
+
+	acc1 := &auth.BaseAccount{
+		Address: addr1,
+		Coins:   sdk.Coins{sdk.NewInt64Coin("barcoin", 42), sdk.NewInt64Coin("foocoin", 42)},
+	}
+
+	mock.SetGenesis(mapp, []auth.Account{acc1})
+
+	ctxCheck := mapp.BaseApp.NewContext(true, abci.Header{})
+	require.True(t, keeper.IsSendEnabled(ctxCheck, "foocoin"))
+	require.False(t, keeper.IsSendEnabled(ctxCheck, "barcoin"))
+	require.True(t, keeper.IsBlockedAddr(ctxCheck, addr3))
+
+	testCases := []appTestCase{
+		// barcoin is not send enabled by default
+		{
+			msgs:     []sdk.Msg{NewMsgSend([]Input{NewInput(addr1, sdk.Coins{sdk.NewInt64Coin("barcoin", 10)})}, []Output{NewOutput(addr2, sdk.Coins{sdk.NewInt64Coin("barcoin", 10)})})},
+			accNums:  []int64{0},
+			accSeqs:  []int64{0},
+			expPass:  false,
+			privKeys: []crypto.PrivKey{priv1},
+		},
+		// addr3 is blocked
+		{
+			msgs:     []sdk.Msg{NewMsgSend([]Input{NewInput(addr1, coins)}, []Output{NewOutput(addr3, coins)})},
+			accNums:  []int64{0},
+			accSeqs:  []int64{1},
+			expPass:  false,
+			privKeys: []crypto.PrivKey{priv1},
+		},
+		// blocked addresses cannot receive issued coins either
+		{
+			msgs:     []sdk.Msg{NewMsgIssue(addr1, []Output{NewOutput(addr3, sdk.Coins{sdk.NewInt64Coin("barcoin", 10)})})},
+			accNums:  []int64{0},
+			accSeqs:  []int64{2},
+			expPass:  false,
+			privKeys: []crypto.PrivKey{priv1},
+		},
+		{
+			msgs:     []sdk.Msg{NewMsgSend([]Input{NewInput(addr1, coins)}, []Output{NewOutput(addr2, coins)})},
+			accNums:  []int64{0},
+			accSeqs:  []int64{3},
+			expPass:  true,
+			privKeys: []crypto.PrivKey{priv1},
+			expectedBalances: []expectedBalance{
+				{addr1, sdk.Coins{sdk.NewInt64Coin("barcoin", 42), sdk.NewInt64Coin("foocoin", 32)}},
+				{addr2, coins},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		mock.SignCheckDeliver(t, mapp.BaseApp, tc.msgs, tc.accNums, tc.accSeqs, tc.expPass, tc.privKeys...)
+
+		for _, eb := range tc.expectedBalances {
+			mock.CheckBalance(t, mapp, eb.addr, eb.coins)
+		}
+	}
+
+	// enabling barcoin allows sending it
+	ctxCheck = mapp.BaseApp.NewContext(true, abci.Header{})
+	keeper.SetSendEnabled(ctxCheck, []SendEnabled{NewSendEnabled("barcoin", true)})
+	require.True(t, keeper.IsSendEnabled(ctxCheck, "barcoin"))
+	require.False(t, keeper.IsSendEnabled(ctxCheck, "foocoin"))
+}
+
+func TestMsgSendRestrictionErrors(t *testing.T) {
+	genesis := NewGenesisState([]Issuance{}, false, []SendEnabled{}, []sdk.AccAddress{addr3})
+	mapp, keeper := getMockAppWithGenesis(t, genesis)
+	mock.SetGenesis(mapp, []auth.Account{})
+
+	ctx := mapp.BaseApp.NewContext(true, abci.Header{})
+	handler := NewHandler(keeper)
+
+	res := handler(ctx, NewMsgSend([]Input{NewInput(addr1, coins)}, []Output{NewOutput(addr2, coins)}))
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeSendDisabled), res.Code)
+
+	keeper.SetDefaultSendEnabled(ctx, true)
+	res = handler(ctx, NewMsgSend([]Input{NewInput(addr1, coins)}, []Output{NewOutput(addr3, coins)}))
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeBlockedAddr), res.Code)
+}
+
+func TestGenesisDefaultSendEnabled(t *testing.T) {
+	// a genesis without default_send_enabled keeps sends enabled
+	var genesis GenesisState
+	require.NoError(t, msgCdc.UnmarshalJSON([]byte(`{"blocked_addrs":[]}`), &genesis))
+	require.Nil(t, genesis.DefaultSendEnabled)
+
+	mapp, keeper := getMockAppWithGenesis(t, genesis)
+	mock.SetGenesis(mapp, []auth.Account{})
+
+	ctx := mapp.BaseApp.NewContext(true, abci.Header{})
+	require.True(t, keeper.GetDefaultSendEnabled(ctx))
+	require.True(t, keeper.IsSendEnabled(ctx, "foocoin"))
+}
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/mock"
+	"github.com/cosmos/cosmos-sdk/x/params"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 )
@@ -16,10 +17,13 @@ func getBenchmarkMockApp() (*mock.App, error) {
 	mapp := mock.NewApp()
 
 	RegisterWire(mapp.Cdc)
-	coinKeeper := NewKeeper(mapp.AccountMapper)
+	keyBank := sdk.NewKVStoreKey("bank")
+	keyParams := sdk.NewKVStoreKey("params")
+	paramsKeeper := params.NewKeeper(mapp.Cdc, keyParams)
+	coinKeeper := NewKeeper(mapp.Cdc, keyBank, mapp.AccountMapper, paramsKeeper.Setter(), mapp.RegisterCodespace(DefaultCodespace))
 	mapp.Router().AddRoute("bank", NewHandler(coinKeeper))
 
-	err := mapp.CompleteSetup([]*sdk.KVStoreKey{})
+	err := mapp.CompleteSetup([]*sdk.KVStoreKey{keyBank, keyParams})
 	return mapp, err
 }
 
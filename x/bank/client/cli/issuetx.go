@@ -0,0 +1,107 @@
+package cli
+
+import (
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	authctx "github.com/cosmos/cosmos-sdk/x/auth/client/context"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// IssueTxCmd will create an issue tx minting new coins to an address and
+// sign it with the given issuer key.
+func IssueTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "issue",
+		Short: "Create and sign an issue tx",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txCtx := authctx.NewTxContextFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			to, err := sdk.AccAddressFromBech32(viper.GetString(flagTo))
+			if err != nil {
+				return err
+			}
+
+			// parse coins trying to be issued
+			coins, err := sdk.ParseCoins(viper.GetString(flagAmount))
+			if err != nil {
+				return err
+			}
+
+			from, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			msg := bank.NewMsgIssue(from, []bank.Output{bank.NewOutput(to, coins)})
+
+			return utils.SendTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagTo, "", "Address to issue coins to")
+	cmd.Flags().String(flagAmount, "", "Amount of coins to issue")
+
+	return cmd
+}
+
+// BurnTxCmd will create a burn tx destroying coins held by the given key and
+// sign it with that key.
+func BurnTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn",
+		Short: "Create and sign a burn tx",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txCtx := authctx.NewTxContextFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+
+			// parse coins trying to be burnt
+			coins, err := sdk.ParseCoins(viper.GetString(flagAmount))
+			if err != nil {
+				return err
+			}
+
+			from, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			account, err := cliCtx.GetAccount(from)
+			if err != nil {
+				return err
+			}
+
+			// ensure account has enough coins
+			if !account.GetCoins().IsGTE(coins) {
+				return errors.Errorf("Address %s doesn't have enough coins to burn.", from)
+			}
+
+			msg := bank.NewMsgBurn(from, coins)
+
+			return utils.SendTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagAmount, "", "Amount of coins to burn")
+
+	return cmd
+}
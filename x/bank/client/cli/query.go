@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+// GetCmdQuerySupply implements the command to query the total supply of a
+// denomination.
+func GetCmdQuerySupply(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply [denom]",
+		Short: "Query the total supply of a coin denomination",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			denom := args[0]
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := cliCtx.QueryStore(bank.GetSupplyKey(denom), storeName)
+			if err != nil {
+				return err
+			}
+
+			supply := sdk.ZeroInt()
+			if len(res) != 0 {
+				cdc.MustUnmarshalBinary(res, &supply)
+			}
+
+			output, err := wire.MarshalJSONIndent(cdc, sdk.NewCoin(denom, supply))
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,47 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	"github.com/gorilla/mux"
+)
+
+// QuerySupplyRequestHandlerFn - http request handler to query the total supply
+// of a denomination
+func QuerySupplyRequestHandlerFn(storeName string, cdc *wire.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		denom := mux.Vars(r)["denom"]
+
+		res, err := cliCtx.QueryStore(bank.GetSupplyKey(denom), storeName)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(fmt.Sprintf("couldn't query supply. Error: %s", err.Error())))
+			return
+		}
+
+		supply := sdk.ZeroInt()
+		if len(res) != 0 {
+			err = cdc.UnmarshalBinary(res, &supply)
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write([]byte(fmt.Sprintf("couldn't decode supply. Error: %s", err.Error())))
+				return
+			}
+		}
+
+		output, err := cdc.MarshalJSON(sdk.NewCoin(denom, supply))
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		w.Write(output)
+	}
+}
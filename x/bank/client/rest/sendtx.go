@@ -5,6 +5,8 @@ import (
 	"net/http"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/routes"
+	"github.com/cosmos/cosmos-sdk/client/utils"
 	"github.com/cosmos/cosmos-sdk/crypto/keys"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
@@ -16,11 +18,30 @@ import (
 )
 
 // RegisterRoutes - Central function to define routes that get registered by the main application
-func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *wire.Codec, kb keys.Keybase) {
-	r.HandleFunc("/accounts/{address}/send", SendRequestHandlerFn(cdc, kb, cliCtx)).Methods("POST")
+func RegisterRoutes(cliCtx context.CLIContext, r *routes.Router, cdc *wire.Codec, kb keys.Keybase) {
+	r.Register(
+		routes.Route{
+			Name:     "SendCoins",
+			Method:   "POST",
+			Path:     "/accounts/{address}/send",
+			Summary:  "Send coins to an address",
+			Request:  &SendBody{},
+			Response: utils.TxResponse(kb),
+			Handler:  SendRequestHandlerFn(cdc, kb, cliCtx),
+		},
+		routes.Route{
+			Name:     "GetSupply",
+			Method:   "GET",
+			Path:     "/bank/supply/{denom}",
+			Summary:  "Get the total supply of a denomination",
+			Response: new(sdk.Coin),
+			Handler:  QuerySupplyRequestHandlerFn("bank", cdc, cliCtx),
+		},
+	)
 }
 
-type sendBody struct {
+// SendBody is the REST request body sending coins
+type SendBody struct {
 	// fees is not used currently
 	// Fees             sdk.Coin  `json="fees"`
 	Amount           sdk.Coins `json:"amount"`
@@ -30,6 +51,14 @@ type sendBody struct {
 	AccountNumber    int64     `json:"account_number"`
 	Sequence         int64     `json:"sequence"`
 	Gas              int64     `json:"gas"`
+	TimeoutHeight    int64     `json:"timeout_height"`
+	// bech32 address of the signer, used when the server holds no keys
+	From string `json:"from"`
+	// return the unsigned tx instead of signing and broadcasting it
+	GenerateOnly bool `json:"generate_only"`
+	// return the gas estimated by simulating the tx instead of broadcasting it
+	Simulate      bool   `json:"simulate"`
+	GasAdjustment string `json:"gas_adjustment"`
 }
 
 var msgCdc = wire.NewCodec()
@@ -52,7 +81,7 @@ func SendRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, cliCtx context.CLICo
 			return
 		}
 
-		var m sendBody
+		var m SendBody
 		body, err := ioutil.ReadAll(r.Body)
 		if err != nil {
 			w.WriteHeader(http.StatusBadRequest)
@@ -66,7 +95,7 @@ func SendRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, cliCtx context.CLICo
 			return
 		}
 
-		info, err := kb.Get(m.LocalAccountName)
+		from, err := utils.GetRESTFromAddress(kb, m.LocalAccountName, m.From)
 		if err != nil {
 			w.WriteHeader(http.StatusUnauthorized)
 			w.Write([]byte(err.Error()))
@@ -74,7 +103,7 @@ func SendRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, cliCtx context.CLICo
 		}
 
 		// build message
-		msg := client.BuildMsg(sdk.AccAddress(info.GetPubKey().Address()), to, m.Amount)
+		msg := client.BuildMsg(from, to, m.Amount)
 		if err != nil { // XXX rechecking same error ?
 			w.WriteHeader(http.StatusInternalServerError)
 			w.Write([]byte(err.Error()))
@@ -87,6 +116,23 @@ func SendRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, cliCtx context.CLICo
 			ChainID:       m.ChainID,
 			AccountNumber: m.AccountNumber,
 			Sequence:      m.Sequence,
+			TimeoutHeight: m.TimeoutHeight,
+		}
+
+		// a server holding no keys only returns the tx to sign
+		if kb == nil {
+			utils.WriteUnsignedTxResponse(w, txCtx, []sdk.Msg{msg})
+			return
+		}
+
+		if m.GenerateOnly {
+			utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{msg})
+			return
+		}
+
+		if m.Simulate {
+			utils.WriteSimulationResponse(w, txCtx, cliCtx, m.LocalAccountName, m.Password, m.GasAdjustment, []sdk.Msg{msg})
+			return
 		}
 
 		txBytes, err := txCtx.BuildAndSign(m.LocalAccountName, m.Password, []sdk.Msg{msg})
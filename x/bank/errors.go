@@ -2,6 +2,8 @@
 package bank
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -9,8 +11,14 @@ import (
 const (
 	DefaultCodespace sdk.CodespaceType = 2
 
-	CodeInvalidInput  sdk.CodeType = 101
-	CodeInvalidOutput sdk.CodeType = 102
+	CodeInvalidInput       sdk.CodeType = 101
+	CodeInvalidOutput      sdk.CodeType = 102
+	CodeInvalidIssuer      sdk.CodeType = 103
+	CodeInvalidIssuance    sdk.CodeType = 104
+	CodeSupplyCapExceeded  sdk.CodeType = 105
+	CodeInsufficientSupply sdk.CodeType = 106
+	CodeSendDisabled       sdk.CodeType = 107
+	CodeBlockedAddr        sdk.CodeType = 108
 )
 
 // NOTE: Don't stringer this, we'll put better messages in later.
@@ -20,6 +28,18 @@ func codeToDefaultMsg(code sdk.CodeType) string {
 		return "invalid input coins"
 	case CodeInvalidOutput:
 		return "invalid output coins"
+	case CodeInvalidIssuer:
+		return "invalid issuer"
+	case CodeInvalidIssuance:
+		return "invalid issuance"
+	case CodeSupplyCapExceeded:
+		return "supply cap exceeded"
+	case CodeInsufficientSupply:
+		return "insufficient supply"
+	case CodeSendDisabled:
+		return "send disabled"
+	case CodeBlockedAddr:
+		return "blocked address"
 	default:
 		return sdk.CodeToDefaultMsg(code)
 	}
@@ -44,6 +64,30 @@ func ErrNoOutputs(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeInvalidOutput, "")
 }
 
+func ErrInvalidIssuer(codespace sdk.CodespaceType, issuer sdk.AccAddress, denom string) sdk.Error {
+	return newError(codespace, CodeInvalidIssuer, fmt.Sprintf("%s is not an issuer of %s", issuer, denom))
+}
+
+func ErrInvalidIssuance(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return newError(codespace, CodeInvalidIssuance, msg)
+}
+
+func ErrSupplyCapExceeded(codespace sdk.CodespaceType, denom string, supplyCap sdk.Int) sdk.Error {
+	return newError(codespace, CodeSupplyCapExceeded, fmt.Sprintf("supply of %s cannot exceed %s", denom, supplyCap))
+}
+
+func ErrInsufficientSupply(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return newError(codespace, CodeInsufficientSupply, fmt.Sprintf("cannot burn more %s than the total supply", denom))
+}
+
+func ErrSendDisabled(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return newError(codespace, CodeSendDisabled, fmt.Sprintf("%s may not be sent", denom))
+}
+
+func ErrBlockedAddr(codespace sdk.CodespaceType, addr sdk.AccAddress) sdk.Error {
+	return newError(codespace, CodeBlockedAddr, fmt.Sprintf("%s may not receive coins", addr))
+}
+
 //----------------------------------------
 
 func msgOrDefaultMsg(msg string, code sdk.CodeType) string {
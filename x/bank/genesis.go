@@ -0,0 +1,85 @@
+package bank
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// GenesisState - all bank state that must be provided at genesis
+type GenesisState struct {
+	Issuances []Issuance `json:"issuances"`
+	// sends are enabled by default when default_send_enabled is absent
+	DefaultSendEnabled *bool            `json:"default_send_enabled,omitempty"`
+	SendEnabled        []SendEnabled    `json:"send_enabled"`
+	BlockedAddrs       []sdk.AccAddress `json:"blocked_addrs"`
+}
+
+func NewGenesisState(issuances []Issuance, defaultSendEnabled bool,
+	sendEnabled []SendEnabled, blockedAddrs []sdk.AccAddress) GenesisState {
+
+	return GenesisState{
+		Issuances:          issuances,
+		DefaultSendEnabled: &defaultSendEnabled,
+		SendEnabled:        sendEnabled,
+		BlockedAddrs:       blockedAddrs,
+	}
+}
+
+// get raw genesis raw message for testing
+func DefaultGenesisState() GenesisState {
+	enabled := defaultSendEnabled
+	return GenesisState{
+		Issuances:          []Issuance{},
+		DefaultSendEnabled: &enabled,
+		SendEnabled:        []SendEnabled{},
+		BlockedAddrs:       []sdk.AccAddress{},
+	}
+}
+
+// InitGenesis - store the bank parameters and add the coins held by the
+// genesis accounts to the total supply. The accounts must be loaded before
+// calling InitGenesis.
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) error {
+	for _, issuance := range data.Issuances {
+		if err := issuance.ValidateBasic(k.codespace); err != nil {
+			return err
+		}
+	}
+	for _, sendEnabled := range data.SendEnabled {
+		if len(sendEnabled.Denom) == 0 {
+			return ErrInvalidInput(k.codespace, "send enabled denomination cannot be empty")
+		}
+	}
+	for _, addr := range data.BlockedAddrs {
+		if len(addr) == 0 {
+			return ErrInvalidInput(k.codespace, "blocked address cannot be empty")
+		}
+	}
+	enabled := defaultSendEnabled
+	if data.DefaultSendEnabled != nil {
+		enabled = *data.DefaultSendEnabled
+	}
+	k.SetIssuances(ctx, data.Issuances)
+	k.SetDefaultSendEnabled(ctx, enabled)
+	k.SetSendEnabled(ctx, data.SendEnabled)
+	k.SetBlockedAddrs(ctx, data.BlockedAddrs)
+
+	supply := sdk.Coins{}
+	k.am.IterateAccounts(ctx, func(acc auth.Account) (stop bool) {
+		supply = supply.Plus(acc.GetCoins())
+		return false
+	})
+	k.IncreaseSupply(ctx, supply)
+	return nil
+}
+
+// WriteGenesis - output genesis parameters
+func WriteGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	enabled := k.GetDefaultSendEnabled(ctx)
+	return GenesisState{
+		Issuances:          k.GetIssuances(ctx),
+		DefaultSendEnabled: &enabled,
+		SendEnabled:        k.GetSendEnabled(ctx),
+		BlockedAddrs:       k.GetBlockedAddrs(ctx),
+	}
+}
@@ -14,6 +14,8 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleMsgSend(ctx, k, msg)
 		case MsgIssue:
 			return handleMsgIssue(ctx, k, msg)
+		case MsgBurn:
+			return handleMsgBurn(ctx, k, msg)
 		default:
 			errMsg := "Unrecognized bank Msg type: " + reflect.TypeOf(msg).Name()
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -25,6 +27,19 @@ func NewHandler(k Keeper) sdk.Handler {
 func handleMsgSend(ctx sdk.Context, k Keeper, msg MsgSend) sdk.Result {
 	// NOTE: totalIn == totalOut should already have been checked
 
+	for _, in := range msg.Inputs {
+		for _, coin := range in.Coins {
+			if !k.IsSendEnabled(ctx, coin.Denom) {
+				return ErrSendDisabled(k.codespace, coin.Denom).Result()
+			}
+		}
+	}
+	for _, out := range msg.Outputs {
+		if k.IsBlockedAddr(ctx, out.Address) {
+			return ErrBlockedAddr(k.codespace, out.Address).Result()
+		}
+	}
+
 	tags, err := k.InputOutputCoins(ctx, msg.Inputs, msg.Outputs)
 	if err != nil {
 		return err.Result()
@@ -37,5 +52,39 @@ func handleMsgSend(ctx sdk.Context, k Keeper, msg MsgSend) sdk.Result {
 
 // Handle MsgIssue.
 func handleMsgIssue(ctx sdk.Context, k Keeper, msg MsgIssue) sdk.Result {
-	panic("not implemented yet")
+	// the banker must be an issuer of every issued denomination
+	for _, coin := range msg.TotalCoins() {
+		issuance, found := k.GetIssuance(ctx, coin.Denom)
+		if !found || !issuance.IsIssuer(msg.Banker) {
+			return ErrInvalidIssuer(k.codespace, msg.Banker, coin.Denom).Result()
+		}
+	}
+
+	tags := sdk.NewTags("issuer", []byte(msg.Banker.String()))
+	for _, out := range msg.Outputs {
+		if k.IsBlockedAddr(ctx, out.Address) {
+			return ErrBlockedAddr(k.codespace, out.Address).Result()
+		}
+		mintTags, err := k.MintCoins(ctx, out.Address, out.Coins)
+		if err != nil {
+			return err.Result()
+		}
+		tags = tags.AppendTags(mintTags)
+	}
+
+	return sdk.Result{
+		Tags: tags,
+	}
+}
+
+// Handle MsgBurn.
+func handleMsgBurn(ctx sdk.Context, k Keeper, msg MsgBurn) sdk.Result {
+	tags, err := k.BurnCoins(ctx, msg.Owner, msg.Coins)
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Tags: tags,
+	}
 }
@@ -0,0 +1,37 @@
+package bank
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// SupplyHolder returns the coins held by a module outside of any account,
+// such as collected fees or governance deposits.
+type SupplyHolder func(ctx sdk.Context) sdk.Coins
+
+// SupplyInvariant checks that the total supply of every denomination equals
+// the coins held by all accounts and by the holders.
+func SupplyInvariant(k Keeper, holders ...SupplyHolder) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		held := sdk.Coins{}
+		k.am.IterateAccounts(ctx, func(acc auth.Account) (stop bool) {
+			held = held.Plus(acc.GetCoins())
+			return false
+		})
+		for _, holder := range holders {
+			held = held.Plus(holder(ctx))
+		}
+
+		// compare by denomination, as accounts may hold zero coins
+		supply := k.GetTotalSupply(ctx)
+		for _, coin := range append(supply, held...) {
+			expected, actual := supply.AmountOf(coin.Denom), held.AmountOf(coin.Denom)
+			if !expected.Equal(actual) {
+				return fmt.Errorf("total supply of %s is %v, but %v are held", coin.Denom, expected, actual)
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,95 @@
+package bank
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// nolint
+const (
+	ParamStoreKeyIssuances = "bank/issuances"
+)
+
+// Issuance defines which addresses may issue new coins of a denomination,
+// and the maximum total supply of that denomination. A zero SupplyCap means
+// the supply is not capped.
+type Issuance struct {
+	Denom     string           `json:"denom"`
+	Issuers   []sdk.AccAddress `json:"issuers"`
+	SupplyCap sdk.Int          `json:"supply_cap"`
+}
+
+// NewIssuance returns a new Issuance
+func NewIssuance(denom string, supplyCap sdk.Int, issuers ...sdk.AccAddress) Issuance {
+	return Issuance{
+		Denom:     denom,
+		Issuers:   issuers,
+		SupplyCap: supplyCap,
+	}
+}
+
+// IsIssuer returns whether or not addr may issue coins of the denomination.
+func (issuance Issuance) IsIssuer(addr sdk.AccAddress) bool {
+	for _, issuer := range issuance.Issuers {
+		if bytes.Equal(issuer, addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsCapped returns whether or not the supply of the denomination is capped.
+func (issuance Issuance) IsCapped() bool {
+	return !issuance.SupplyCap.IsZero()
+}
+
+// ValidateBasic validates the issuance, independently of the state.
+func (issuance Issuance) ValidateBasic(codespace sdk.CodespaceType) sdk.Error {
+	if len(issuance.Denom) == 0 {
+		return ErrInvalidIssuance(codespace, "denomination cannot be empty")
+	}
+	if issuance.SupplyCap.Sign() < 0 {
+		return ErrInvalidIssuance(codespace, "supply cap cannot be negative")
+	}
+	for _, issuer := range issuance.Issuers {
+		if len(issuer) == 0 {
+			return ErrInvalidIssuance(codespace, "issuer address cannot be empty")
+		}
+	}
+	return nil
+}
+
+//______________________________________________________________________________________________
+
+// GetIssuances returns the issuance parameters of every issuable denomination.
+func (keeper Keeper) GetIssuances(ctx sdk.Context) []Issuance {
+	var issuances []Issuance
+	if keeper.ps.GetRaw(ctx, ParamStoreKeyIssuances) == nil {
+		return issuances
+	}
+	err := keeper.ps.Get(ctx, ParamStoreKeyIssuances, &issuances)
+	if err != nil {
+		panic(err)
+	}
+	return issuances
+}
+
+// SetIssuances sets the issuance parameters of every issuable denomination,
+// replacing any previously set.
+func (keeper Keeper) SetIssuances(ctx sdk.Context, issuances []Issuance) {
+	err := keeper.ps.Set(ctx, ParamStoreKeyIssuances, issuances)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// GetIssuance returns the issuance parameters of a denomination.
+func (keeper Keeper) GetIssuance(ctx sdk.Context, denom string) (issuance Issuance, found bool) {
+	for _, issuance := range keeper.GetIssuances(ctx) {
+		if issuance.Denom == denom {
+			return issuance, true
+		}
+	}
+	return Issuance{}, false
+}
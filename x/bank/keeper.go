@@ -4,7 +4,9 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
 const (
@@ -13,16 +15,46 @@ const (
 	costSetCoins      sdk.Gas = 100
 	costSubtractCoins sdk.Gas = 10
 	costAddCoins      sdk.Gas = 10
+	costGetSupply     sdk.Gas = 10
+	costSetSupply     sdk.Gas = 100
 )
 
-// Keeper manages transfers between accounts
+// Keeper manages transfers between accounts, and the minting and burning of
+// coins along with the total supply of each denomination
 type Keeper struct {
 	am auth.AccountMapper
+
+	// The reference to the ParamSetter to get and set the bank parameters
+	ps params.Setter
+
+	// The (unexposed) key used to access the supply store from the Context.
+	storeKey sdk.StoreKey
+
+	// The wire codec for binary encoding/decoding.
+	cdc *wire.Codec
+
+	// The permissions of the module accounts, by module name
+	modulePerms map[string][]string
+
+	// Reserved codespace
+	codespace sdk.CodespaceType
 }
 
 // NewKeeper returns a new Keeper
-func NewKeeper(am auth.AccountMapper) Keeper {
-	return Keeper{am: am}
+func NewKeeper(cdc *wire.Codec, key sdk.StoreKey, am auth.AccountMapper, ps params.Setter, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		am:          am,
+		ps:          ps,
+		storeKey:    key,
+		cdc:         cdc,
+		modulePerms: make(map[string][]string),
+		codespace:   codespace,
+	}
+}
+
+// Codespace returns the codespace of the errors of the keeper.
+func (keeper Keeper) Codespace() sdk.CodespaceType {
+	return keeper.codespace
 }
 
 // GetCoins returns the coins at the addr.
@@ -60,6 +92,105 @@ func (keeper Keeper) InputOutputCoins(ctx sdk.Context, inputs []Input, outputs [
 	return inputOutputCoins(ctx, keeper.am, inputs, outputs)
 }
 
+// MintCoins creates amt new coins at the addr and increases the total supply
+// accordingly. It fails if the supply cap of any of the minted denominations
+// would be exceeded.
+func (keeper Keeper) MintCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error) {
+	for _, coin := range amt {
+		supply := keeper.GetSupply(ctx, coin.Denom).Add(coin.Amount)
+		issuance, found := keeper.GetIssuance(ctx, coin.Denom)
+		if found && issuance.IsCapped() && supply.GT(issuance.SupplyCap) {
+			return nil, ErrSupplyCapExceeded(keeper.codespace, coin.Denom, issuance.SupplyCap)
+		}
+	}
+	keeper.IncreaseSupply(ctx, amt)
+
+	_, tags, err := addCoins(ctx, keeper.am, addr, amt)
+	if err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// BurnCoins destroys amt coins held at the addr and decreases the total
+// supply accordingly.
+func (keeper Keeper) BurnCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error) {
+	_, tags, err := subtractCoins(ctx, keeper.am, addr, amt)
+	if err != nil {
+		return nil, err
+	}
+
+	err = keeper.DecreaseSupply(ctx, amt)
+	if err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// IncreaseSupply increases the total supply by amt, for coins created outside
+// of any account such as staking provisions. Unlike MintCoins, it does not
+// enforce the supply caps.
+func (keeper Keeper) IncreaseSupply(ctx sdk.Context, amt sdk.Coins) {
+	for _, coin := range amt {
+		keeper.setSupply(ctx, coin.Denom, keeper.GetSupply(ctx, coin.Denom).Add(coin.Amount))
+	}
+}
+
+// DecreaseSupply decreases the total supply by amt, for coins destroyed
+// outside of any account such as slashed stake.
+func (keeper Keeper) DecreaseSupply(ctx sdk.Context, amt sdk.Coins) sdk.Error {
+	for _, coin := range amt {
+		supply := keeper.GetSupply(ctx, coin.Denom).Sub(coin.Amount)
+		if supply.Sign() < 0 {
+			return ErrInsufficientSupply(keeper.codespace, coin.Denom)
+		}
+		keeper.setSupply(ctx, coin.Denom, supply)
+	}
+	return nil
+}
+
+// GetSupply returns the total supply of a denomination.
+func (keeper Keeper) GetSupply(ctx sdk.Context, denom string) sdk.Int {
+	ctx.GasMeter().ConsumeGas(costGetSupply, "getSupply")
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(GetSupplyKey(denom))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	var supply sdk.Int
+	keeper.cdc.MustUnmarshalBinary(bz, &supply)
+	return supply
+}
+
+// GetTotalSupply returns the total supply of every denomination.
+func (keeper Keeper) GetTotalSupply(ctx sdk.Context) sdk.Coins {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, SupplyKeyPrefix)
+	defer iterator.Close()
+
+	supply := sdk.Coins{}
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Int
+		keeper.cdc.MustUnmarshalBinary(iterator.Value(), &amount)
+		denom := string(iterator.Key()[len(SupplyKeyPrefix):])
+		supply = append(supply, sdk.NewCoin(denom, amount))
+	}
+	return supply
+}
+
+// setSupply sets the total supply of a denomination. A zero supply removes
+// the denomination from the store.
+func (keeper Keeper) setSupply(ctx sdk.Context, denom string, supply sdk.Int) {
+	ctx.GasMeter().ConsumeGas(costSetSupply, "setSupply")
+	store := ctx.KVStore(keeper.storeKey)
+	if supply.IsZero() {
+		store.Delete(GetSupplyKey(denom))
+		return
+	}
+	store.Set(GetSupplyKey(denom), keeper.cdc.MustMarshalBinary(supply))
+}
+
 //______________________________________________________________________________________________
 
 // SendKeeper only allows transfers between accounts, without the possibility of creating coins
@@ -0,0 +1,12 @@
+package bank
+
+// Key prefix for the total supply of each denomination
+var (
+	SupplyKeyPrefix = []byte{0x00}
+)
+
+// GetSupplyKey returns the key for getting the total supply of a denomination
+// from the store
+func GetSupplyKey(denom string) []byte {
+	return append(SupplyKeyPrefix, []byte(denom)...)
+}
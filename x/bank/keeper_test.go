@@ -15,26 +15,32 @@ import (
 	wire "github.com/cosmos/cosmos-sdk/wire"
 
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
-func setupMultiStore() (sdk.MultiStore, *sdk.KVStoreKey) {
+func setupMultiStore() (sdk.MultiStore, *sdk.KVStoreKey, *sdk.KVStoreKey, *sdk.KVStoreKey) {
 	db := dbm.NewMemDB()
 	authKey := sdk.NewKVStoreKey("authkey")
+	bankKey := sdk.NewKVStoreKey("bankkey")
+	paramsKey := sdk.NewKVStoreKey("paramskey")
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(authKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(bankKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
 	ms.LoadLatestVersion()
-	return ms, authKey
+	return ms, authKey, bankKey, paramsKey
 }
 
 func TestKeeper(t *testing.T) {
-	ms, authKey := setupMultiStore()
+	ms, authKey, bankKey, paramsKey := setupMultiStore()
 
 	cdc := wire.NewCodec()
 	auth.RegisterBaseAccount(cdc)
 
 	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
 	accountMapper := auth.NewAccountMapper(cdc, authKey, auth.ProtoBaseAccount)
-	coinKeeper := NewKeeper(accountMapper)
+	paramsKeeper := params.NewKeeper(cdc, paramsKey)
+	coinKeeper := NewKeeper(cdc, bankKey, accountMapper, paramsKeeper.Setter(), DefaultCodespace)
 
 	addr := sdk.AccAddress([]byte("addr1"))
 	addr2 := sdk.AccAddress([]byte("addr2"))
@@ -112,14 +118,15 @@ func TestKeeper(t *testing.T) {
 }
 
 func TestSendKeeper(t *testing.T) {
-	ms, authKey := setupMultiStore()
+	ms, authKey, bankKey, paramsKey := setupMultiStore()
 
 	cdc := wire.NewCodec()
 	auth.RegisterBaseAccount(cdc)
 
 	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
 	accountMapper := auth.NewAccountMapper(cdc, authKey, auth.ProtoBaseAccount)
-	coinKeeper := NewKeeper(accountMapper)
+	paramsKeeper := params.NewKeeper(cdc, paramsKey)
+	coinKeeper := NewKeeper(cdc, bankKey, accountMapper, paramsKeeper.Setter(), DefaultCodespace)
 	sendKeeper := NewSendKeeper(accountMapper)
 
 	addr := sdk.AccAddress([]byte("addr1"))
@@ -181,14 +188,15 @@ func TestSendKeeper(t *testing.T) {
 }
 
 func TestViewKeeper(t *testing.T) {
-	ms, authKey := setupMultiStore()
+	ms, authKey, bankKey, paramsKey := setupMultiStore()
 
 	cdc := wire.NewCodec()
 	auth.RegisterBaseAccount(cdc)
 
 	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
 	accountMapper := auth.NewAccountMapper(cdc, authKey, auth.ProtoBaseAccount)
-	coinKeeper := NewKeeper(accountMapper)
+	paramsKeeper := params.NewKeeper(cdc, paramsKey)
+	coinKeeper := NewKeeper(cdc, bankKey, accountMapper, paramsKeeper.Setter(), DefaultCodespace)
 	viewKeeper := NewViewKeeper(accountMapper)
 
 	addr := sdk.AccAddress([]byte("addr1"))
@@ -207,3 +215,114 @@ func TestViewKeeper(t *testing.T) {
 	require.False(t, viewKeeper.HasCoins(ctx, addr, sdk.Coins{sdk.NewInt64Coin("foocoin", 15)}))
 	require.False(t, viewKeeper.HasCoins(ctx, addr, sdk.Coins{sdk.NewInt64Coin("barcoin", 5)}))
 }
+
+func TestMintBurnCoins(t *testing.T) {
+	ms, authKey, bankKey, paramsKey := setupMultiStore()
+
+	cdc := wire.NewCodec()
+	auth.RegisterBaseAccount(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+	accountMapper := auth.NewAccountMapper(cdc, authKey, auth.ProtoBaseAccount)
+	paramsKeeper := params.NewKeeper(cdc, paramsKey)
+	coinKeeper := NewKeeper(cdc, bankKey, accountMapper, paramsKeeper.Setter(), DefaultCodespace)
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	coinKeeper.SetIssuances(ctx, []Issuance{
+		NewIssuance("foocoin", sdk.NewInt(100), addr),
+		NewIssuance("barcoin", sdk.ZeroInt(), addr),
+	})
+
+	// Test MintCoins
+	_, err := coinKeeper.MintCoins(ctx, addr, sdk.Coins{sdk.NewInt64Coin("foocoin", 60)})
+	require.Nil(t, err)
+	_, err = coinKeeper.MintCoins(ctx, addr2, sdk.Coins{sdk.NewInt64Coin("barcoin", 1000), sdk.NewInt64Coin("foocoin", 40)})
+	require.Nil(t, err)
+	require.True(t, coinKeeper.GetCoins(ctx, addr).IsEqual(sdk.Coins{sdk.NewInt64Coin("foocoin", 60)}))
+	require.True(t, coinKeeper.GetCoins(ctx, addr2).IsEqual(sdk.Coins{sdk.NewInt64Coin("barcoin", 1000), sdk.NewInt64Coin("foocoin", 40)}))
+	require.Equal(t, sdk.NewInt(100), coinKeeper.GetSupply(ctx, "foocoin"))
+	require.Equal(t, sdk.NewInt(1000), coinKeeper.GetSupply(ctx, "barcoin"))
+
+	// foocoin is capped at 100, barcoin is uncapped
+	_, err = coinKeeper.MintCoins(ctx, addr, sdk.Coins{sdk.NewInt64Coin("foocoin", 1)})
+	require.NotNil(t, err)
+	require.Equal(t, CodeSupplyCapExceeded, err.Code())
+	require.Equal(t, sdk.NewInt(100), coinKeeper.GetSupply(ctx, "foocoin"))
+	_, err = coinKeeper.MintCoins(ctx, addr, sdk.Coins{sdk.NewInt64Coin("barcoin", 1000)})
+	require.Nil(t, err)
+
+	// Test BurnCoins
+	_, err = coinKeeper.BurnCoins(ctx, addr2, sdk.Coins{sdk.NewInt64Coin("foocoin", 50)})
+	require.NotNil(t, err)
+	require.Equal(t, sdk.NewInt(100), coinKeeper.GetSupply(ctx, "foocoin"))
+	_, err = coinKeeper.BurnCoins(ctx, addr2, sdk.Coins{sdk.NewInt64Coin("foocoin", 40)})
+	require.Nil(t, err)
+	require.True(t, coinKeeper.GetCoins(ctx, addr2).IsEqual(sdk.Coins{sdk.NewInt64Coin("barcoin", 1000)}))
+	require.Equal(t, sdk.NewInt(60), coinKeeper.GetSupply(ctx, "foocoin"))
+
+	// Test GetTotalSupply
+	require.True(t, coinKeeper.GetTotalSupply(ctx).IsEqual(sdk.Coins{sdk.NewInt64Coin("barcoin", 2000), sdk.NewInt64Coin("foocoin", 60)}))
+	require.True(t, coinKeeper.GetSupply(ctx, "bazcoin").IsZero())
+}
+
+func TestModuleAccounts(t *testing.T) {
+	ms, authKey, bankKey, paramsKey := setupMultiStore()
+
+	cdc := wire.NewCodec()
+	auth.RegisterBaseAccount(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+	accountMapper := auth.NewAccountMapper(cdc, authKey, auth.ProtoBaseAccount)
+	paramsKeeper := params.NewKeeper(cdc, paramsKey)
+	coinKeeper := NewKeeper(cdc, bankKey, accountMapper, paramsKeeper.Setter(), DefaultCodespace)
+	coinKeeper.RegisterModuleAccount("holder")
+	coinKeeper.RegisterModuleAccount("minter", auth.Minter, auth.Burner, auth.Staking)
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	_, err := coinKeeper.MintCoins(ctx, addr, sdk.Coins{sdk.NewInt64Coin("foocoin", 100)})
+	require.Nil(t, err)
+
+	// Test GetModuleAccount
+	require.True(t, coinKeeper.IsModuleAddr(auth.NewModuleAddress("holder")))
+	require.False(t, coinKeeper.IsModuleAddr(addr))
+	require.Panics(t, func() { coinKeeper.GetModuleAccount(ctx, "unknown") })
+	holder := coinKeeper.GetModuleAccount(ctx, "holder")
+	require.Equal(t, auth.NewModuleAddress("holder"), holder.GetAddress())
+	require.NotNil(t, accountMapper.GetAccount(ctx, holder.GetAddress()))
+
+	// Test SendCoinsFromAccountToModule/SendCoinsFromModuleToAccount
+	_, err = coinKeeper.SendCoinsFromAccountToModule(ctx, addr, "holder", sdk.Coins{sdk.NewInt64Coin("foocoin", 30)})
+	require.Nil(t, err)
+	require.True(t, coinKeeper.GetCoins(ctx, holder.GetAddress()).IsEqual(sdk.Coins{sdk.NewInt64Coin("foocoin", 30)}))
+	_, err = coinKeeper.SendCoinsFromModuleToAccount(ctx, "holder", addr, sdk.Coins{sdk.NewInt64Coin("foocoin", 40)})
+	require.NotNil(t, err)
+	_, err = coinKeeper.SendCoinsFromModuleToAccount(ctx, "holder", addr, sdk.Coins{sdk.NewInt64Coin("foocoin", 10)})
+	require.Nil(t, err)
+	require.True(t, coinKeeper.GetCoins(ctx, addr).IsEqual(sdk.Coins{sdk.NewInt64Coin("foocoin", 80)}))
+
+	// Test permissions
+	require.Panics(t, func() { coinKeeper.MintModuleCoins(ctx, "holder", sdk.Coins{sdk.NewInt64Coin("foocoin", 10)}) })
+	require.Panics(t, func() { coinKeeper.BurnModuleCoins(ctx, "holder", sdk.Coins{sdk.NewInt64Coin("foocoin", 10)}) })
+	require.Panics(t, func() { coinKeeper.DelegateCoins(ctx, addr, "holder", sdk.Coins{sdk.NewInt64Coin("foocoin", 10)}) })
+
+	// Test DelegateCoins/UndelegateCoins
+	minter := auth.NewModuleAddress("minter")
+	_, err = coinKeeper.DelegateCoins(ctx, addr, "minter", sdk.Coins{sdk.NewInt64Coin("foocoin", 20)})
+	require.Nil(t, err)
+	_, err = coinKeeper.UndelegateCoins(ctx, "minter", addr, sdk.Coins{sdk.NewInt64Coin("foocoin", 5)})
+	require.Nil(t, err)
+	require.True(t, coinKeeper.GetCoins(ctx, minter).IsEqual(sdk.Coins{sdk.NewInt64Coin("foocoin", 15)}))
+
+	// Test MintModuleCoins/BurnModuleCoins
+	err = coinKeeper.MintModuleCoins(ctx, "minter", sdk.Coins{sdk.NewInt64Coin("foocoin", 10)})
+	require.Nil(t, err)
+	err = coinKeeper.BurnModuleCoins(ctx, "minter", sdk.Coins{sdk.NewInt64Coin("foocoin", 20)})
+	require.Nil(t, err)
+	require.True(t, coinKeeper.GetCoins(ctx, minter).IsEqual(sdk.Coins{sdk.NewInt64Coin("foocoin", 5)}))
+	require.Equal(t, sdk.NewInt(90), coinKeeper.GetSupply(ctx, "foocoin"))
+	require.Nil(t, SupplyInvariant(coinKeeper)(ctx))
+
+	// module accounts cannot receive sends
+	require.True(t, coinKeeper.IsBlockedAddr(ctx, minter))
+}
@@ -0,0 +1,100 @@
+package bank
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// RegisterModuleAccount registers the account of a module along with its
+// permissions. Keepers register their module account when they are created.
+func (keeper Keeper) RegisterModuleAccount(name string, permissions ...string) {
+	keeper.modulePerms[name] = permissions
+}
+
+// IsModuleAddr returns whether or not the address is the address of the
+// account of a registered module.
+func (keeper Keeper) IsModuleAddr(addr sdk.AccAddress) bool {
+	for name := range keeper.modulePerms {
+		if bytes.Equal(auth.NewModuleAddress(name), addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetModuleAccount returns the account of a registered module, creating it
+// if needed.
+func (keeper Keeper) GetModuleAccount(ctx sdk.Context, name string) *auth.ModuleAccount {
+	perms, registered := keeper.modulePerms[name]
+	if !registered {
+		panic(fmt.Sprintf("module account %s is not registered", name))
+	}
+
+	acc := keeper.am.GetAccount(ctx, auth.NewModuleAddress(name))
+	if acc == nil {
+		macc := auth.NewModuleAccount(name, perms...)
+		keeper.am.SetAccount(ctx, keeper.am.NewAccount(ctx, macc))
+		return macc
+	}
+
+	macc, ok := acc.(*auth.ModuleAccount)
+	if !ok {
+		panic(fmt.Sprintf("account %s of module %s is not a module account", acc.GetAddress(), name))
+	}
+	return macc
+}
+
+// SendCoinsFromModuleToAccount moves coins from the account of a module to
+// an account.
+func (keeper Keeper) SendCoinsFromModuleToAccount(ctx sdk.Context, name string, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error) {
+	macc := keeper.GetModuleAccount(ctx, name)
+	return sendCoins(ctx, keeper.am, macc.GetAddress(), addr, amt)
+}
+
+// SendCoinsFromAccountToModule moves coins from an account to the account of
+// a module.
+func (keeper Keeper) SendCoinsFromAccountToModule(ctx sdk.Context, addr sdk.AccAddress, name string, amt sdk.Coins) (sdk.Tags, sdk.Error) {
+	macc := keeper.GetModuleAccount(ctx, name)
+	return sendCoins(ctx, keeper.am, addr, macc.GetAddress(), amt)
+}
+
+// DelegateCoins moves coins delegated by an account to the account of a
+// module with the staking permission.
+func (keeper Keeper) DelegateCoins(ctx sdk.Context, addr sdk.AccAddress, name string, amt sdk.Coins) (sdk.Tags, sdk.Error) {
+	macc := keeper.getModuleAccountWithPermission(ctx, name, auth.Staking)
+	return sendCoins(ctx, keeper.am, addr, macc.GetAddress(), amt)
+}
+
+// UndelegateCoins returns coins delegated by an account from the account of
+// a module with the staking permission.
+func (keeper Keeper) UndelegateCoins(ctx sdk.Context, name string, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error) {
+	macc := keeper.getModuleAccountWithPermission(ctx, name, auth.Staking)
+	return sendCoins(ctx, keeper.am, macc.GetAddress(), addr, amt)
+}
+
+// MintModuleCoins creates new coins in the account of a module with the
+// minter permission, increasing the total supply accordingly.
+func (keeper Keeper) MintModuleCoins(ctx sdk.Context, name string, amt sdk.Coins) sdk.Error {
+	macc := keeper.getModuleAccountWithPermission(ctx, name, auth.Minter)
+	_, err := keeper.MintCoins(ctx, macc.GetAddress(), amt)
+	return err
+}
+
+// BurnModuleCoins destroys coins held by the account of a module with the
+// burner permission, decreasing the total supply accordingly.
+func (keeper Keeper) BurnModuleCoins(ctx sdk.Context, name string, amt sdk.Coins) sdk.Error {
+	macc := keeper.getModuleAccountWithPermission(ctx, name, auth.Burner)
+	_, err := keeper.BurnCoins(ctx, macc.GetAddress(), amt)
+	return err
+}
+
+func (keeper Keeper) getModuleAccountWithPermission(ctx sdk.Context, name string, permission string) *auth.ModuleAccount {
+	macc := keeper.GetModuleAccount(ctx, name)
+	if !macc.HasPermission(permission) {
+		panic(fmt.Sprintf("module account %s does not have the %s permission", name, permission))
+	}
+	return macc
+}
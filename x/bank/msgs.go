@@ -87,7 +87,8 @@ func (msg MsgSend) GetSigners() []sdk.AccAddress {
 //----------------------------------------
 // MsgIssue
 
-// MsgIssue - high level transaction of the coin module
+// MsgIssue - mint new coins to the outputs, signed by an issuer of every
+// issued denomination
 type MsgIssue struct {
 	Banker  sdk.AccAddress `json:"banker"`
 	Outputs []Output       `json:"outputs"`
@@ -95,7 +96,7 @@ type MsgIssue struct {
 
 var _ sdk.Msg = MsgIssue{}
 
-// NewMsgIssue - construct arbitrary multi-in, multi-out send msg.
+// NewMsgIssue - construct a multi-out issue msg.
 func NewMsgIssue(banker sdk.AccAddress, out []Output) MsgIssue {
 	return MsgIssue{Banker: banker, Outputs: out}
 }
@@ -105,7 +106,9 @@ func (msg MsgIssue) Type() string { return "bank" } // TODO: "bank/issue"
 
 // Implements Msg.
 func (msg MsgIssue) ValidateBasic() sdk.Error {
-	// XXX
+	if len(msg.Banker) == 0 {
+		return sdk.ErrInvalidAddress(msg.Banker.String())
+	}
 	if len(msg.Outputs) == 0 {
 		return ErrNoOutputs(DefaultCodespace).TraceSDK("")
 	}
@@ -141,6 +144,68 @@ func (msg MsgIssue) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Banker}
 }
 
+// TotalCoins returns the sum of the coins issued to all outputs.
+func (msg MsgIssue) TotalCoins() sdk.Coins {
+	var total sdk.Coins
+	for _, out := range msg.Outputs {
+		total = total.Plus(out.Coins)
+	}
+	return total
+}
+
+//----------------------------------------
+// MsgBurn
+
+// MsgBurn - destroy coins held by the owner, decreasing the total supply
+type MsgBurn struct {
+	Owner sdk.AccAddress `json:"owner"`
+	Coins sdk.Coins      `json:"coins"`
+}
+
+var _ sdk.Msg = MsgBurn{}
+
+// NewMsgBurn - construct a burn msg.
+func NewMsgBurn(owner sdk.AccAddress, coins sdk.Coins) MsgBurn {
+	return MsgBurn{Owner: owner, Coins: coins}
+}
+
+// Implements Msg.
+func (msg MsgBurn) Type() string { return "bank" } // TODO: "bank/burn"
+
+// Implements Msg.
+func (msg MsgBurn) ValidateBasic() sdk.Error {
+	if len(msg.Owner) == 0 {
+		return sdk.ErrInvalidAddress(msg.Owner.String())
+	}
+	if !msg.Coins.IsValid() {
+		return sdk.ErrInvalidCoins(msg.Coins.String())
+	}
+	if !msg.Coins.IsPositive() {
+		return sdk.ErrInvalidCoins(msg.Coins.String())
+	}
+	return nil
+}
+
+// Implements Msg.
+func (msg MsgBurn) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(struct {
+		Owner sdk.AccAddress `json:"owner"`
+		Coins sdk.Coins      `json:"coins"`
+	}{
+		Owner: msg.Owner,
+		Coins: msg.Coins,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// Implements Msg.
+func (msg MsgBurn) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
 //----------------------------------------
 // Input
 
@@ -245,7 +245,30 @@ func TestMsgIssueType(t *testing.T) {
 }
 
 func TestMsgIssueValidation(t *testing.T) {
-	// TODO
+	banker := sdk.AccAddress([]byte("input"))
+	addr := sdk.AccAddress([]byte("loan-from-bank"))
+	coins := sdk.Coins{sdk.NewInt64Coin("atom", 10)}
+	emptyCoins := sdk.Coins{}
+
+	cases := []struct {
+		valid bool
+		tx    MsgIssue
+	}{
+		{true, NewMsgIssue(banker, []Output{NewOutput(addr, coins)})},
+		{false, NewMsgIssue(nil, []Output{NewOutput(addr, coins)})},         // no banker
+		{false, NewMsgIssue(banker, nil)},                                   // no outputs
+		{false, NewMsgIssue(banker, []Output{NewOutput(addr, emptyCoins)})}, // empty coins
+		{false, NewMsgIssue(banker, []Output{NewOutput(nil, coins)})},       // no output address
+	}
+
+	for i, tc := range cases {
+		err := tc.tx.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err, "%d: %+v", i, err)
+		} else {
+			require.NotNil(t, err, "%d", i)
+		}
+	}
 }
 
 func TestMsgIssueGetSignBytes(t *testing.T) {
@@ -268,3 +291,45 @@ func TestMsgIssueGetSigners(t *testing.T) {
 	res := msg.GetSigners()
 	require.Equal(t, fmt.Sprintf("%v", res), "[6F6E6C796F6E65]")
 }
+
+// ----------------------------------------
+// MsgBurn Tests
+
+func TestMsgBurnValidation(t *testing.T) {
+	owner := sdk.AccAddress([]byte("owner"))
+	coins := sdk.Coins{sdk.NewInt64Coin("atom", 10)}
+
+	cases := []struct {
+		valid bool
+		tx    MsgBurn
+	}{
+		{true, NewMsgBurn(owner, coins)},
+		{false, NewMsgBurn(nil, coins)},                                      // no owner
+		{false, NewMsgBurn(owner, sdk.Coins{})},                              // empty coins
+		{false, NewMsgBurn(owner, sdk.Coins{sdk.NewInt64Coin("atom", -10)})}, // negative coins
+		{false, NewMsgBurn(owner, sdk.Coins{sdk.NewInt64Coin("atom", 0)})},   // zero coins
+	}
+
+	for i, tc := range cases {
+		err := tc.tx.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err, "%d: %+v", i, err)
+		} else {
+			require.NotNil(t, err, "%d", i)
+		}
+	}
+}
+
+func TestMsgBurnGetSignBytes(t *testing.T) {
+	msg := NewMsgBurn(sdk.AccAddress([]byte("input")), sdk.Coins{sdk.NewInt64Coin("atom", 10)})
+	res := msg.GetSignBytes()
+
+	expected := `{"coins":[{"amount":"10","denom":"atom"}],"owner":"cosmosaccaddr1d9h8qat5e4ehc5"}`
+	require.Equal(t, expected, string(res))
+}
+
+func TestMsgBurnGetSigners(t *testing.T) {
+	msg := NewMsgBurn(sdk.AccAddress([]byte("onlyone")), nil)
+	res := msg.GetSigners()
+	require.Equal(t, fmt.Sprintf("%v", res), "[6F6E6C796F6E65]")
+}
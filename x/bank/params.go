@@ -0,0 +1,115 @@
+package bank
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// nolint
+const (
+	ParamStoreKeyDefaultSendEnabled = "bank/defaultsendenabled"
+	ParamStoreKeySendEnabled        = "bank/sendenabled"
+	ParamStoreKeyBlockedAddrs       = "bank/blockedaddrs"
+)
+
+// by default, every denomination may be sent
+const defaultSendEnabled = true
+
+// SendEnabled overrides whether or not coins of a denomination may be sent
+// between accounts
+type SendEnabled struct {
+	Denom   string `json:"denom"`
+	Enabled bool   `json:"enabled"`
+}
+
+// NewSendEnabled returns a new SendEnabled
+func NewSendEnabled(denom string, enabled bool) SendEnabled {
+	return SendEnabled{
+		Denom:   denom,
+		Enabled: enabled,
+	}
+}
+
+//______________________________________________________________________________________________
+
+// GetDefaultSendEnabled returns whether or not the denominations which are
+// not listed in the send enabled parameter may be sent.
+func (keeper Keeper) GetDefaultSendEnabled(ctx sdk.Context) bool {
+	return keeper.ps.GetBoolWithDefault(ctx, ParamStoreKeyDefaultSendEnabled, defaultSendEnabled)
+}
+
+// SetDefaultSendEnabled sets whether or not the denominations which are not
+// listed in the send enabled parameter may be sent.
+func (keeper Keeper) SetDefaultSendEnabled(ctx sdk.Context, enabled bool) {
+	keeper.ps.SetBool(ctx, ParamStoreKeyDefaultSendEnabled, enabled)
+}
+
+// GetSendEnabled returns the denominations which may or may not be sent
+// regardless of the default.
+func (keeper Keeper) GetSendEnabled(ctx sdk.Context) []SendEnabled {
+	var sendEnabled []SendEnabled
+	if keeper.ps.GetRaw(ctx, ParamStoreKeySendEnabled) == nil {
+		return sendEnabled
+	}
+	err := keeper.ps.Get(ctx, ParamStoreKeySendEnabled, &sendEnabled)
+	if err != nil {
+		panic(err)
+	}
+	return sendEnabled
+}
+
+// SetSendEnabled sets the denominations which may or may not be sent
+// regardless of the default, replacing any previously set.
+func (keeper Keeper) SetSendEnabled(ctx sdk.Context, sendEnabled []SendEnabled) {
+	err := keeper.ps.Set(ctx, ParamStoreKeySendEnabled, sendEnabled)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// IsSendEnabled returns whether or not coins of the denomination may be sent.
+func (keeper Keeper) IsSendEnabled(ctx sdk.Context, denom string) bool {
+	for _, sendEnabled := range keeper.GetSendEnabled(ctx) {
+		if sendEnabled.Denom == denom {
+			return sendEnabled.Enabled
+		}
+	}
+	return keeper.GetDefaultSendEnabled(ctx)
+}
+
+// GetBlockedAddrs returns the addresses which may not receive coins.
+func (keeper Keeper) GetBlockedAddrs(ctx sdk.Context) []sdk.AccAddress {
+	var addrs []sdk.AccAddress
+	if keeper.ps.GetRaw(ctx, ParamStoreKeyBlockedAddrs) == nil {
+		return addrs
+	}
+	err := keeper.ps.Get(ctx, ParamStoreKeyBlockedAddrs, &addrs)
+	if err != nil {
+		panic(err)
+	}
+	return addrs
+}
+
+// SetBlockedAddrs sets the addresses which may not receive coins, replacing
+// any previously set.
+func (keeper Keeper) SetBlockedAddrs(ctx sdk.Context, addrs []sdk.AccAddress) {
+	err := keeper.ps.Set(ctx, ParamStoreKeyBlockedAddrs, addrs)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// IsBlockedAddr returns whether or not the address may not receive coins,
+// either as a blocked address or as the account of a module.
+func (keeper Keeper) IsBlockedAddr(ctx sdk.Context, addr sdk.AccAddress) bool {
+	if keeper.IsModuleAddr(addr) {
+		return true
+	}
+	for _, blocked := range keeper.GetBlockedAddrs(ctx) {
+		if bytes.Equal(blocked, addr) {
+			return true
+		}
+	}
+	return false
+}
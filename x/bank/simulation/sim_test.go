@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/mock"
 	"github.com/cosmos/cosmos-sdk/x/mock/simulation"
+	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
 func TestBankWithRandomMessages(t *testing.T) {
@@ -16,10 +17,13 @@ func TestBankWithRandomMessages(t *testing.T) {
 
 	bank.RegisterWire(mapp.Cdc)
 	mapper := mapp.AccountMapper
-	coinKeeper := bank.NewKeeper(mapper)
+	keyBank := sdk.NewKVStoreKey("bank")
+	keyParams := sdk.NewKVStoreKey("params")
+	paramsKeeper := params.NewKeeper(mapp.Cdc, keyParams)
+	coinKeeper := bank.NewKeeper(mapp.Cdc, keyBank, mapper, paramsKeeper.Setter(), mapp.RegisterCodespace(bank.DefaultCodespace))
 	mapp.Router().AddRoute("bank", bank.NewHandler(coinKeeper))
 
-	err := mapp.CompleteSetup([]*sdk.KVStoreKey{})
+	err := mapp.CompleteSetup([]*sdk.KVStoreKey{keyBank, keyParams})
 	if err != nil {
 		panic(err)
 	}
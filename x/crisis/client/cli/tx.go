@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	authctx "github.com/cosmos/cosmos-sdk/x/auth/client/context"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+
+	"github.com/spf13/cobra"
+)
+
+// GetCmdVerifyInvariant implements the verify invariant command.
+func GetCmdVerifyInvariant(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-invariant [module]/[route]",
+		Args:  cobra.ExactArgs(1),
+		Short: "check an invariant of the state, halting the chain if it is broken",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txCtx := authctx.NewTxContextFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			sender, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			msg := crisis.NewMsgVerifyInvariant(sender, args[0])
+
+			return utils.SendTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,22 @@
+package crisis
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker checks all the invariants every InvariantCheckPeriod blocks, and
+// halts the chain by panicking once an invariant has been found broken.
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	period := k.InvariantCheckPeriod(ctx)
+	if period > 0 && ctx.BlockHeight()%period == 0 {
+		k.AssertInvariants(ctx)
+	}
+
+	broken, found := k.GetBrokenInvariant(ctx)
+	if found {
+		panic(fmt.Sprintf("halting the chain: invariant %s broken at height %d: %s",
+			broken.Route, broken.Height, broken.Message))
+	}
+}
@@ -0,0 +1,26 @@
+//nolint
+package crisis
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Local code type
+type CodeType = sdk.CodeType
+
+const (
+	// Default crisis codespace
+	DefaultCodespace sdk.CodespaceType = 11
+
+	CodeInvalidInput     CodeType = 101
+	CodeUnknownInvariant CodeType = 102
+)
+
+func ErrNilSender(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "sender address is nil")
+}
+func ErrUnknownInvariant(codespace sdk.CodespaceType, route string) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownInvariant, fmt.Sprintf("unknown invariant %s", route))
+}
@@ -0,0 +1,41 @@
+package crisis
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		// NOTE msg already has validate basic run
+		switch msg := msg.(type) {
+		case MsgVerifyInvariant:
+			return handleMsgVerifyInvariant(ctx, msg, k)
+		default:
+			return sdk.ErrTxDecode("invalid message parse in crisis module").Result()
+		}
+	}
+}
+
+// Anyone may check an invariant. If it is broken, the check succeeds so that
+// the broken invariant is recorded, and the chain halts at the end of the block.
+func handleMsgVerifyInvariant(ctx sdk.Context, msg MsgVerifyInvariant, k Keeper) sdk.Result {
+	route, found := k.GetRoute(msg.InvariantRoute)
+	if !found {
+		return ErrUnknownInvariant(k.codespace, msg.InvariantRoute).Result()
+	}
+
+	holds := k.assertInvariant(ctx, route)
+
+	tags := sdk.NewTags(
+		"action", []byte("verify-invariant"),
+		"sender", []byte(msg.Sender.String()),
+		"invariant", []byte(msg.InvariantRoute),
+	)
+	if !holds {
+		tags = tags.AppendTag("broken", []byte(msg.InvariantRoute))
+	}
+
+	return sdk.Result{
+		Tags: tags,
+	}
+}
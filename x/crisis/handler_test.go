@@ -0,0 +1,93 @@
+package crisis
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+var sender = sdk.AccAddress([]byte("sender"))
+
+// returns a keeper with a "test/passing" invariant, and a "test/toggled"
+// invariant which breaks once broken is set
+func createTestInput(t *testing.T, broken *bool) (sdk.Context, params.Setter, Keeper) {
+	keyCrisis := sdk.NewKVStoreKey("crisis")
+	keyParams := sdk.NewKVStoreKey("params")
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyCrisis, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	err := ms.LoadLatestVersion()
+	require.Nil(t, err)
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+
+	cdc := wire.NewCodec()
+	pk := params.NewKeeper(cdc, keyParams)
+	keeper := NewKeeper(cdc, keyCrisis, pk.Getter(), DefaultCodespace)
+	keeper.RegisterRoute("test", "passing", func(ctx sdk.Context) error { return nil })
+	keeper.RegisterRoute("test", "toggled", func(ctx sdk.Context) error {
+		if *broken {
+			return errors.New("broken")
+		}
+		return nil
+	})
+	return ctx, pk.Setter(), keeper
+}
+
+func TestHandleMsgVerifyInvariant(t *testing.T) {
+	broken := false
+	ctx, _, keeper := createTestInput(t, &broken)
+	handler := NewHandler(keeper)
+
+	// unknown invariant
+	res := handler(ctx, NewMsgVerifyInvariant(sender, "test/unknown"))
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeUnknownInvariant), res.Code)
+
+	// invariants holding
+	res = handler(ctx, NewMsgVerifyInvariant(sender, "test/passing"))
+	require.True(t, res.IsOK())
+	res = handler(ctx, NewMsgVerifyInvariant(sender, "test/toggled"))
+	require.True(t, res.IsOK())
+	_, found := keeper.GetBrokenInvariant(ctx)
+	require.False(t, found)
+	require.NotPanics(t, func() { EndBlocker(ctx, keeper) })
+
+	// broken invariant is recorded and halts the chain
+	broken = true
+	ctx = ctx.WithBlockHeight(2)
+	res = handler(ctx, NewMsgVerifyInvariant(sender, "test/toggled"))
+	require.True(t, res.IsOK())
+	record, found := keeper.GetBrokenInvariant(ctx)
+	require.True(t, found)
+	require.Equal(t, BrokenInvariant{"test/toggled", 2, "broken"}, record)
+	require.Panics(t, func() { EndBlocker(ctx, keeper) })
+}
+
+func TestEndBlockerInvariantCheckPeriod(t *testing.T) {
+	broken := true
+	ctx, ps, keeper := createTestInput(t, &broken)
+
+	// invariants are not checked by default
+	require.NotPanics(t, func() { EndBlocker(ctx, keeper) })
+
+	// invariants are checked every period blocks
+	ps.SetInt64(ctx, InvariantCheckPeriodKey, 5)
+	ctx = ctx.WithBlockHeight(4)
+	require.NotPanics(t, func() { EndBlocker(ctx, keeper) })
+	ctx = ctx.WithBlockHeight(5)
+	require.Panics(t, func() { EndBlocker(ctx, keeper) })
+
+	record, found := keeper.GetBrokenInvariant(ctx)
+	require.True(t, found)
+	require.Equal(t, "test/toggled", record.Route)
+}
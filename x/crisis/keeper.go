@@ -0,0 +1,119 @@
+package crisis
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// InvarRoute is an invariant registered with the crisis keeper
+type InvarRoute struct {
+	ModuleName string
+	Route      string
+	Invar      sdk.Invariant
+}
+
+// FullRoute returns the route of the invariant, prefixed by its module name
+func (i InvarRoute) FullRoute() string {
+	return fmt.Sprintf("%s/%s", i.ModuleName, i.Route)
+}
+
+// Keeper of the crisis store
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      *wire.Codec
+	params   params.Getter
+	routes   []InvarRoute
+	// codespace
+	codespace sdk.CodespaceType
+}
+
+// NewKeeper creates a crisis keeper
+func NewKeeper(cdc *wire.Codec, key sdk.StoreKey, params params.Getter, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		storeKey:  key,
+		cdc:       cdc,
+		params:    params,
+		routes:    []InvarRoute{},
+		codespace: codespace,
+	}
+}
+
+// RegisterRoute registers an invariant of a module under a route. All the
+// invariants must be registered before the handler of the keeper is created.
+func (k *Keeper) RegisterRoute(moduleName, route string, invar sdk.Invariant) {
+	k.routes = append(k.routes, InvarRoute{moduleName, route, invar})
+}
+
+// Routes returns the registered invariants
+func (k Keeper) Routes() []InvarRoute {
+	return k.routes
+}
+
+// GetRoute returns the invariant registered under the full route
+// ("<module>/<route>")
+func (k Keeper) GetRoute(fullRoute string) (route InvarRoute, found bool) {
+	for _, route := range k.routes {
+		if route.FullRoute() == fullRoute {
+			return route, true
+		}
+	}
+	return InvarRoute{}, false
+}
+
+// AssertInvariants checks every registered invariant, recording the first
+// broken one found. It returns whether or not all invariants hold.
+func (k Keeper) AssertInvariants(ctx sdk.Context) bool {
+	for _, route := range k.routes {
+		if !k.assertInvariant(ctx, route) {
+			return false
+		}
+	}
+	return true
+}
+
+// assertInvariant checks an invariant, recording it as broken if it does not
+// hold. It returns whether or not the invariant holds.
+func (k Keeper) assertInvariant(ctx sdk.Context, route InvarRoute) bool {
+	err := route.Invar(ctx)
+	if err == nil {
+		return true
+	}
+
+	logger := ctx.Logger().With("module", "x/crisis")
+	logger.Error(fmt.Sprintf("Invariant %s broken at height %d: %v", route.FullRoute(), ctx.BlockHeight(), err))
+	k.setBrokenInvariant(ctx, BrokenInvariant{
+		Route:   route.FullRoute(),
+		Height:  ctx.BlockHeight(),
+		Message: err.Error(),
+	})
+	return false
+}
+
+//______________________________________________________________________
+
+// BrokenInvariant records an invariant found broken, which halts the chain
+type BrokenInvariant struct {
+	Route   string `json:"route"`
+	Height  int64  `json:"height"`
+	Message string `json:"message"`
+}
+
+// GetBrokenInvariant returns the invariant found broken, if any
+func (k Keeper) GetBrokenInvariant(ctx sdk.Context) (broken BrokenInvariant, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(BrokenInvariantKey)
+	if bz == nil {
+		return broken, false
+	}
+	k.cdc.MustUnmarshalBinary(bz, &broken)
+	return broken, true
+}
+
+func (k Keeper) setBrokenInvariant(ctx sdk.Context, broken BrokenInvariant) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinary(broken)
+	store.Set(BrokenInvariantKey, bz)
+}
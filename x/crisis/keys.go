@@ -0,0 +1,4 @@
+package crisis
+
+// key for the invariant found broken, if any
+var BrokenInvariantKey = []byte{0x00}
@@ -0,0 +1,52 @@
+package crisis
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+)
+
+var cdc = wire.NewCodec()
+
+// name to identify transaction types
+const MsgType = "crisis"
+
+// verify interface at compile time
+var _ sdk.Msg = &MsgVerifyInvariant{}
+
+// MsgVerifyInvariant - message to check an invariant, halting the chain if
+// it is broken
+type MsgVerifyInvariant struct {
+	Sender         sdk.AccAddress `json:"sender"`
+	InvariantRoute string         `json:"invariant_route"`
+}
+
+func NewMsgVerifyInvariant(sender sdk.AccAddress, invariantRoute string) MsgVerifyInvariant {
+	return MsgVerifyInvariant{
+		Sender:         sender,
+		InvariantRoute: invariantRoute,
+	}
+}
+
+//nolint
+func (msg MsgVerifyInvariant) Type() string                 { return MsgType }
+func (msg MsgVerifyInvariant) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Sender} }
+
+// get the bytes for the message signer to sign on
+func (msg MsgVerifyInvariant) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgVerifyInvariant) ValidateBasic() sdk.Error {
+	if msg.Sender == nil {
+		return ErrNilSender(DefaultCodespace)
+	}
+	if len(msg.InvariantRoute) == 0 {
+		return ErrUnknownInvariant(DefaultCodespace, msg.InvariantRoute)
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package crisis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgVerifyInvariantValidation(t *testing.T) {
+	cases := []struct {
+		valid bool
+		msg   MsgVerifyInvariant
+	}{
+		{true, NewMsgVerifyInvariant(sender, "bank/supply")},
+		{false, NewMsgVerifyInvariant(nil, "bank/supply")},
+		{false, NewMsgVerifyInvariant(sender, "")},
+	}
+
+	for i, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err, "%d: %v", i, err)
+		} else {
+			require.NotNil(t, err, "%d", i)
+		}
+	}
+}
+
+func TestMsgVerifyInvariantGetSigners(t *testing.T) {
+	msg := NewMsgVerifyInvariant(sender, "bank/supply")
+	require.Equal(t, []sdk.AccAddress{sender}, msg.GetSigners())
+}
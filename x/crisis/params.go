@@ -0,0 +1,19 @@
+package crisis
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// nolint
+const (
+	InvariantCheckPeriodKey = "crisis/InvariantCheckPeriod"
+)
+
+// InvariantCheckPeriod - number of blocks between two checks of all the
+// invariants in EndBlock, zero disables the checks
+func (k Keeper) InvariantCheckPeriod(ctx sdk.Context) int64 {
+	return k.params.GetInt64WithDefault(ctx, InvariantCheckPeriodKey, defaultInvariantCheckPeriod)
+}
+
+// by default, the invariants are only checked through MsgVerifyInvariant
+const defaultInvariantCheckPeriod int64 = 0
@@ -0,0 +1,36 @@
+package evidence
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// BeginBlocker routes the evidence of misbehaviour found by Tendermint.
+// Equivocations are routed to the handler registered under
+// RouteEquivocation, any other type of evidence is ignored.
+func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) (tags sdk.Tags) {
+	logger := ctx.Logger().With("module", "x/evidence")
+
+	for _, evidence := range req.ByzantineValidators {
+		switch evidence.Type {
+		case tmtypes.ABCIEvidenceTypeDuplicateVote:
+			pk, err := tmtypes.PB2TM.PubKey(evidence.Validator.PubKey)
+			if err != nil {
+				panic(err)
+			}
+			equivocation := NewEquivocation(evidence.Height, evidence.Time, evidence.Validator.Power, pk)
+			if sdkErr := k.SubmitEvidence(ctx, equivocation); sdkErr != nil {
+				logger.Error(fmt.Sprintf("ignored equivocation %s: %s", equivocation.Hash(), sdkErr.Result().Log))
+				continue
+			}
+			tags = tags.AppendTag("evidence", []byte(equivocation.Hash().String()))
+		default:
+			logger.Error(fmt.Sprintf("ignored unknown evidence type: %s", evidence.Type))
+		}
+	}
+
+	return
+}
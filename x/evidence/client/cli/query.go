@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/evidence"
+)
+
+// GetCmdQueryEvidence implements the command to query evidence by its hash.
+func GetCmdQueryEvidence(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "evidence [hash]",
+		Short: "Query submitted evidence by its hash",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			hash, err := hex.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, err := cliCtx.QueryStore(evidence.GetEvidenceKey(hash), storeName)
+			if err != nil {
+				return err
+			}
+			if len(res) == 0 {
+				return fmt.Errorf("no evidence found with hash %s", args[0])
+			}
+
+			var ev evidence.Evidence
+			cdc.MustUnmarshalBinary(res, &ev)
+
+			output, err := wire.MarshalJSONIndent(cdc, ev)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQueryAllEvidence implements the command to query all submitted
+// evidence.
+func GetCmdQueryAllEvidence(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-evidence",
+		Short: "Query all submitted evidence",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			resKVs, err := cliCtx.QuerySubspace(evidence.EvidenceKeyPrefix, storeName)
+			if err != nil {
+				return err
+			}
+
+			var all []evidence.Evidence
+			for _, kv := range resKVs {
+				var ev evidence.Evidence
+				cdc.MustUnmarshalBinary(kv.Value, &ev)
+				all = append(all, ev)
+			}
+
+			output, err := wire.MarshalJSONIndent(cdc, all)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+
+			return nil
+		},
+	}
+
+	return cmd
+}
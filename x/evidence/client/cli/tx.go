@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"io/ioutil"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	authctx "github.com/cosmos/cosmos-sdk/x/auth/client/context"
+	"github.com/cosmos/cosmos-sdk/x/evidence"
+
+	"github.com/spf13/cobra"
+)
+
+// GetCmdSubmitEvidence implements the submit evidence command. The evidence
+// is read as JSON from a file, and must be of a type registered on the codec.
+func GetCmdSubmitEvidence(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-evidence [evidence-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "submit evidence of misbehaviour",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txCtx := authctx.NewTxContextFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var ev evidence.Evidence
+			err = cdc.UnmarshalJSON(bz, &ev)
+			if err != nil {
+				return err
+			}
+
+			submitter, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			msg := evidence.NewMsgSubmitEvidence(submitter, ev)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.SendTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,67 @@
+package rest
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/routes"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/evidence"
+	"github.com/gorilla/mux"
+)
+
+// RegisterRoutes registers evidence-related REST handlers to a router
+func RegisterRoutes(cliCtx context.CLIContext, r *routes.Router, cdc *wire.Codec) {
+	r.Register(routes.Route{
+		Name:     "GetEvidence",
+		Method:   "GET",
+		Path:     "/evidence/{hash}",
+		Summary:  "Get a piece of evidence by its hex encoded hash",
+		Response: new(evidence.Evidence),
+		Handler:  evidenceHandlerFn(cliCtx, "evidence", cdc),
+	})
+}
+
+// http request handler to query evidence by its hash
+func evidenceHandlerFn(cliCtx context.CLIContext, storeName string, cdc *wire.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		hash, err := hex.DecodeString(vars["hash"])
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		res, err := cliCtx.QueryStore(evidence.GetEvidenceKey(hash), storeName)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(fmt.Sprintf("couldn't query evidence. Error: %s", err.Error())))
+			return
+		}
+		if len(res) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		var ev evidence.Evidence
+		err = cdc.UnmarshalBinary(res, &ev)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(fmt.Sprintf("couldn't decode evidence. Error: %s", err.Error())))
+			return
+		}
+
+		output, err := cdc.MarshalJSON(ev)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		w.Write(output)
+	}
+}
@@ -0,0 +1,37 @@
+//nolint
+package evidence
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Local code type
+type CodeType = sdk.CodeType
+
+const (
+	// Default evidence codespace
+	DefaultCodespace sdk.CodespaceType = 12
+
+	CodeInvalidInput      CodeType = 101
+	CodeNoEvidenceHandler CodeType = 102
+	CodeInvalidEvidence   CodeType = 103
+	CodeEvidenceExists    CodeType = 104
+)
+
+func ErrNilSubmitter(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "submitter address is nil")
+}
+func ErrNilEvidence(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "evidence is nil")
+}
+func ErrNoEvidenceHandler(codespace sdk.CodespaceType, route string) sdk.Error {
+	return sdk.NewError(codespace, CodeNoEvidenceHandler, fmt.Sprintf("no handler for evidence route %s", route))
+}
+func ErrInvalidEvidence(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidEvidence, msg)
+}
+func ErrEvidenceExists(codespace sdk.CodespaceType, hash string) sdk.Error {
+	return sdk.NewError(codespace, CodeEvidenceExists, fmt.Sprintf("evidence %s was already submitted", hash))
+}
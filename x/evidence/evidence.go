@@ -0,0 +1,81 @@
+package evidence
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+// Evidence of misbehaviour, handled by the handler registered under its
+// route. Modules define their own evidence types and register them on the
+// codec of the application.
+type Evidence interface {
+	Route() string
+	Type() string
+	String() string
+	Hash() cmn.HexBytes
+	ValidateBasic() sdk.Error
+
+	// height at which the misbehaviour occurred
+	GetHeight() int64
+}
+
+// Handler handles evidence routed to it, returning an error if the evidence
+// is invalid. Evidence is only stored if its handler succeeds.
+type Handler func(ctx sdk.Context, evidence Evidence) sdk.Error
+
+//______________________________________________________________________
+
+// route of the equivocation evidence
+const RouteEquivocation = "equivocation"
+
+var _ Evidence = Equivocation{}
+
+// Equivocation - a validator signing conflicting blocks at the same height,
+// as reported by Tendermint
+type Equivocation struct {
+	Height int64         `json:"height"`
+	Time   time.Time     `json:"time"`
+	Power  int64         `json:"power"`
+	PubKey crypto.PubKey `json:"pub_key"`
+}
+
+func NewEquivocation(height int64, time time.Time, power int64, pubKey crypto.PubKey) Equivocation {
+	return Equivocation{
+		Height: height,
+		Time:   time,
+		Power:  power,
+		PubKey: pubKey,
+	}
+}
+
+//nolint
+func (e Equivocation) Route() string    { return RouteEquivocation }
+func (e Equivocation) Type() string     { return "equivocation" }
+func (e Equivocation) GetHeight() int64 { return e.Height }
+
+// Hash returns the hash of the equivocation
+func (e Equivocation) Hash() cmn.HexBytes {
+	return tmhash.Sum(cdc.MustMarshalBinaryBare(e))
+}
+
+// String returns a human readable string of the equivocation
+func (e Equivocation) String() string {
+	return fmt.Sprintf("Equivocation of %s at height %d (power %d, time %v)",
+		e.PubKey.Address(), e.Height, e.Power, e.Time)
+}
+
+// ValidateBasic performs a stateless check of the equivocation
+func (e Equivocation) ValidateBasic() sdk.Error {
+	if e.PubKey == nil {
+		return ErrInvalidEvidence(DefaultCodespace, "equivocation has no public key")
+	}
+	if e.Height < 0 || e.Power <= 0 {
+		return ErrInvalidEvidence(DefaultCodespace, "equivocation has an invalid height or power")
+	}
+	return nil
+}
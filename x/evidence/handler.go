@@ -0,0 +1,34 @@
+package evidence
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		// NOTE msg already has validate basic run
+		switch msg := msg.(type) {
+		case MsgSubmitEvidence:
+			return handleMsgSubmitEvidence(ctx, msg, k)
+		default:
+			return sdk.ErrTxDecode("invalid message parse in evidence module").Result()
+		}
+	}
+}
+
+func handleMsgSubmitEvidence(ctx sdk.Context, msg MsgSubmitEvidence, k Keeper) sdk.Result {
+	err := k.SubmitEvidence(ctx, msg.Evidence)
+	if err != nil {
+		return err.Result()
+	}
+
+	tags := sdk.NewTags(
+		"action", []byte("submit-evidence"),
+		"submitter", []byte(msg.Submitter.String()),
+		"evidence", []byte(msg.Evidence.Hash().String()),
+	)
+
+	return sdk.Result{
+		Tags: tags,
+	}
+}
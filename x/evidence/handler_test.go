@@ -0,0 +1,138 @@
+package evidence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+)
+
+var submitter = sdk.AccAddress([]byte("submitter"))
+
+// evidence handled by the "test" route, which is rejected unless valid
+type testEvidence struct {
+	Height  int64  `json:"height"`
+	Content string `json:"content"`
+	Valid   bool   `json:"valid"`
+}
+
+var _ Evidence = testEvidence{}
+
+//nolint
+func (e testEvidence) Route() string    { return "test" }
+func (e testEvidence) Type() string     { return "test" }
+func (e testEvidence) String() string   { return e.Content }
+func (e testEvidence) GetHeight() int64 { return e.Height }
+func (e testEvidence) Hash() cmn.HexBytes {
+	return tmhash.Sum(cdc.MustMarshalBinaryBare(e))
+}
+func (e testEvidence) ValidateBasic() sdk.Error {
+	if len(e.Content) == 0 {
+		return ErrInvalidEvidence(DefaultCodespace, "empty content")
+	}
+	return nil
+}
+
+// returns a keeper with a "test" route accepting valid test evidence, and
+// an equivocation route recording the handled equivocations
+func createTestInput(t *testing.T, equivocations *[]Equivocation) (sdk.Context, Keeper) {
+	keyEvidence := sdk.NewKVStoreKey("evidence")
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyEvidence, sdk.StoreTypeIAVL, db)
+	err := ms.LoadLatestVersion()
+	require.Nil(t, err)
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+
+	cdc := wire.NewCodec()
+	wire.RegisterCrypto(cdc)
+	RegisterWire(cdc)
+	cdc.RegisterConcrete(testEvidence{}, "test/testEvidence", nil)
+
+	keeper := NewKeeper(cdc, keyEvidence, DefaultCodespace)
+	keeper.AddRoute("test", func(ctx sdk.Context, evidence Evidence) sdk.Error {
+		if !evidence.(testEvidence).Valid {
+			return ErrInvalidEvidence(DefaultCodespace, "invalid")
+		}
+		return nil
+	})
+	keeper.AddRoute(RouteEquivocation, func(ctx sdk.Context, evidence Evidence) sdk.Error {
+		*equivocations = append(*equivocations, evidence.(Equivocation))
+		return nil
+	})
+	return ctx, keeper
+}
+
+func TestHandleMsgSubmitEvidence(t *testing.T) {
+	ctx, keeper := createTestInput(t, &[]Equivocation{})
+	handler := NewHandler(keeper)
+	require.Panics(t, func() { keeper.AddRoute("test", nil) })
+
+	// evidence without a handler
+	res := handler(ctx, NewMsgSubmitEvidence(submitter, unroutedEvidence{}))
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeNoEvidenceHandler), res.Code)
+
+	// evidence rejected by its handler is not stored
+	invalid := testEvidence{1, "invalid", false}
+	res = handler(ctx, NewMsgSubmitEvidence(submitter, invalid))
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeInvalidEvidence), res.Code)
+	_, found := keeper.GetEvidence(ctx, invalid.Hash())
+	require.False(t, found)
+
+	// valid evidence is stored under its hash
+	valid := testEvidence{1, "valid", true}
+	res = handler(ctx, NewMsgSubmitEvidence(submitter, valid))
+	require.True(t, res.IsOK())
+	stored, found := keeper.GetEvidence(ctx, valid.Hash())
+	require.True(t, found)
+	require.Equal(t, valid, stored)
+	require.Equal(t, []Evidence{valid}, keeper.GetAllEvidence(ctx))
+
+	// evidence may only be submitted once
+	res = handler(ctx, NewMsgSubmitEvidence(submitter, valid))
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeEvidenceExists), res.Code)
+}
+
+func TestBeginBlockerEquivocation(t *testing.T) {
+	equivocations := []Equivocation{}
+	ctx, keeper := createTestInput(t, &equivocations)
+
+	pk := ed25519.GenPrivKey().PubKey()
+	evidence := abci.Evidence{
+		Type:      tmtypes.ABCIEvidenceTypeDuplicateVote,
+		Validator: abci.Validator{Address: pk.Address(), PubKey: tmtypes.TM2PB.PubKey(pk), Power: 10},
+		Height:    3,
+		Time:      time.Unix(0, 0).UTC(),
+	}
+	unknown := abci.Evidence{Type: "unknown"}
+	req := abci.RequestBeginBlock{ByzantineValidators: []abci.Evidence{evidence, unknown}}
+
+	// the equivocation is routed and stored, the unknown evidence ignored
+	BeginBlocker(ctx, req, keeper)
+	expected := NewEquivocation(3, time.Unix(0, 0).UTC(), 10, pk)
+	require.Equal(t, []Equivocation{expected}, equivocations)
+	stored, found := keeper.GetEvidence(ctx, expected.Hash())
+	require.True(t, found)
+	require.Equal(t, expected, stored)
+
+	// the same equivocation is only handled once
+	BeginBlocker(ctx, req, keeper)
+	require.Len(t, equivocations, 1)
+}
+
+// evidence for which no handler is registered
+type unroutedEvidence struct{ testEvidence }
+
+func (e unroutedEvidence) Route() string { return "unrouted" }
@@ -0,0 +1,105 @@
+package evidence
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+// Keeper of the evidence store
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      *wire.Codec
+	router   map[string]Handler
+	// codespace
+	codespace sdk.CodespaceType
+}
+
+// NewKeeper creates an evidence keeper
+func NewKeeper(cdc *wire.Codec, key sdk.StoreKey, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		storeKey:  key,
+		cdc:       cdc,
+		router:    make(map[string]Handler),
+		codespace: codespace,
+	}
+}
+
+// AddRoute registers the handler of the evidence routed under the route. All
+// the handlers must be registered before the keeper is used.
+func (k *Keeper) AddRoute(route string, handler Handler) {
+	if _, found := k.router[route]; found {
+		panic(fmt.Sprintf("evidence route %s has already been registered", route))
+	}
+	k.router[route] = handler
+}
+
+// HasRoute returns whether or not a handler is registered under the route
+func (k Keeper) HasRoute(route string) bool {
+	_, found := k.router[route]
+	return found
+}
+
+// SubmitEvidence routes the evidence to its handler, storing it if the
+// handler succeeds. Evidence may only be submitted once.
+func (k Keeper) SubmitEvidence(ctx sdk.Context, evidence Evidence) sdk.Error {
+	if _, found := k.GetEvidence(ctx, evidence.Hash()); found {
+		return ErrEvidenceExists(k.codespace, evidence.Hash().String())
+	}
+
+	handler, found := k.router[evidence.Route()]
+	if !found {
+		return ErrNoEvidenceHandler(k.codespace, evidence.Route())
+	}
+	err := handler(ctx, evidence)
+	if err != nil {
+		return err
+	}
+
+	k.SetEvidence(ctx, evidence)
+	return nil
+}
+
+// GetEvidence returns the evidence stored under the hash
+func (k Keeper) GetEvidence(ctx sdk.Context, hash cmn.HexBytes) (evidence Evidence, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetEvidenceKey(hash))
+	if bz == nil {
+		return nil, false
+	}
+	k.cdc.MustUnmarshalBinary(bz, &evidence)
+	return evidence, true
+}
+
+// SetEvidence stores the evidence under its hash
+func (k Keeper) SetEvidence(ctx sdk.Context, evidence Evidence) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinary(evidence)
+	store.Set(GetEvidenceKey(evidence.Hash()), bz)
+}
+
+// IterateEvidence iterates through the stored evidence, stopping when the
+// function returns true
+func (k Keeper) IterateEvidence(ctx sdk.Context, fn func(evidence Evidence) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, EvidenceKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var evidence Evidence
+		k.cdc.MustUnmarshalBinary(iterator.Value(), &evidence)
+		if fn(evidence) {
+			break
+		}
+	}
+}
+
+// GetAllEvidence returns all the stored evidence
+func (k Keeper) GetAllEvidence(ctx sdk.Context) (evidence []Evidence) {
+	k.IterateEvidence(ctx, func(e Evidence) (stop bool) {
+		evidence = append(evidence, e)
+		return false
+	})
+	return evidence
+}
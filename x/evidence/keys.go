@@ -0,0 +1,13 @@
+package evidence
+
+import (
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+// key prefix for the stored evidence
+var EvidenceKeyPrefix = []byte{0x00}
+
+// get the key for the evidence with the hash
+func GetEvidenceKey(hash cmn.HexBytes) []byte {
+	return append(EvidenceKeyPrefix, hash...)
+}
@@ -0,0 +1,66 @@
+package evidence
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// name to identify transaction types
+const MsgType = "evidence"
+
+// verify interface at compile time
+var _ sdk.Msg = &MsgSubmitEvidence{}
+
+// MsgSubmitEvidence - message to submit evidence of misbehaviour detected by
+// the application, such as an oracle or light client equivocation
+type MsgSubmitEvidence struct {
+	Submitter sdk.AccAddress `json:"submitter"`
+	Evidence  Evidence       `json:"evidence"`
+}
+
+func NewMsgSubmitEvidence(submitter sdk.AccAddress, evidence Evidence) MsgSubmitEvidence {
+	return MsgSubmitEvidence{
+		Submitter: submitter,
+		Evidence:  evidence,
+	}
+}
+
+//nolint
+func (msg MsgSubmitEvidence) Type() string                 { return MsgType }
+func (msg MsgSubmitEvidence) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Submitter} }
+
+// get the bytes for the message signer to sign on. The evidence is committed
+// to by its hash, as its concrete type is only registered by the application.
+func (msg MsgSubmitEvidence) GetSignBytes() []byte {
+	b, err := json.Marshal(struct {
+		Submitter    sdk.AccAddress `json:"submitter"`
+		Route        string         `json:"route"`
+		EvidenceType string         `json:"evidence_type"`
+		EvidenceHash string         `json:"evidence_hash"`
+	}{
+		Submitter:    msg.Submitter,
+		Route:        msg.Evidence.Route(),
+		EvidenceType: msg.Evidence.Type(),
+		EvidenceHash: msg.Evidence.Hash().String(),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgSubmitEvidence) ValidateBasic() sdk.Error {
+	if msg.Submitter == nil {
+		return ErrNilSubmitter(DefaultCodespace)
+	}
+	if msg.Evidence == nil {
+		return ErrNilEvidence(DefaultCodespace)
+	}
+	// equivocations are only reported by Tendermint, which verifies them
+	if _, ok := msg.Evidence.(Equivocation); ok {
+		return ErrInvalidEvidence(DefaultCodespace, "equivocation evidence cannot be submitted")
+	}
+	return msg.Evidence.ValidateBasic()
+}
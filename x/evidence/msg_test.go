@@ -0,0 +1,43 @@
+package evidence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgSubmitEvidence(t *testing.T) {
+	pk := ed25519.GenPrivKey().PubKey()
+	tests := []struct {
+		submitter  sdk.AccAddress
+		evidence   Evidence
+		expectPass bool
+	}{
+		{submitter, testEvidence{1, "content", true}, true},
+		{nil, testEvidence{1, "content", true}, false},
+		{submitter, nil, false},
+		{submitter, testEvidence{1, "", true}, false},
+		{submitter, NewEquivocation(1, time.Unix(0, 0), 10, pk), false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgSubmitEvidence(tc.submitter, tc.evidence)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgSubmitEvidenceGetSignBytes(t *testing.T) {
+	evidence := testEvidence{1, "content", true}
+	msg := NewMsgSubmitEvidence(submitter, evidence)
+	expected := `{"evidence_hash":"` + evidence.Hash().String() +
+		`","evidence_type":"test","route":"test","submitter":"` + submitter.String() + `"}`
+	require.Equal(t, expected, string(msg.GetSignBytes()))
+}
@@ -0,0 +1,19 @@
+package evidence
+
+import (
+	"github.com/cosmos/cosmos-sdk/wire"
+)
+
+// Register concrete types on wire codec
+func RegisterWire(cdc *wire.Codec) {
+	cdc.RegisterInterface((*Evidence)(nil), nil)
+	cdc.RegisterConcrete(Equivocation{}, "cosmos-sdk/Equivocation", nil)
+	cdc.RegisterConcrete(MsgSubmitEvidence{}, "cosmos-sdk/MsgSubmitEvidence", nil)
+}
+
+var cdc = wire.NewCodec()
+
+func init() {
+	wire.RegisterCrypto(cdc)
+	RegisterWire(cdc)
+}
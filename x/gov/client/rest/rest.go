@@ -6,6 +6,9 @@ import (
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/routes"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/cosmos/cosmos-sdk/x/gov"
@@ -25,22 +28,85 @@ const (
 )
 
 // RegisterRoutes - Central function to define routes that get registered by the main application
-func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *wire.Codec) {
-	r.HandleFunc("/gov/proposals", postProposalHandlerFn(cdc, cliCtx)).Methods("POST")
-	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/deposits", RestProposalID), depositHandlerFn(cdc, cliCtx)).Methods("POST")
-	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/votes", RestProposalID), voteHandlerFn(cdc, cliCtx)).Methods("POST")
-
-	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}", RestProposalID), queryProposalHandlerFn(cdc)).Methods("GET")
-	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/deposits/{%s}", RestProposalID, RestDepositer), queryDepositHandlerFn(cdc)).Methods("GET")
-	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/votes/{%s}", RestProposalID, RestVoter), queryVoteHandlerFn(cdc)).Methods("GET")
-
-	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/votes", RestProposalID), queryVotesOnProposalHandlerFn(cdc)).Methods("GET")
-
-	r.HandleFunc("/gov/proposals", queryProposalsWithParameterFn(cdc)).Methods("GET")
+func RegisterRoutes(cliCtx context.CLIContext, r *routes.Router, cdc *wire.Codec, kb keys.Keybase) {
+	r.Register(
+		routes.Route{
+			Name:     "SubmitProposal",
+			Method:   "POST",
+			Path:     "/gov/proposals",
+			Summary:  "Submit a proposal along with an initial deposit",
+			Request:  &PostProposalReq{},
+			Response: utils.TxResponse(kb),
+			Handler:  postProposalHandlerFn(cdc, kb, cliCtx),
+		},
+		routes.Route{
+			Name:     "Deposit",
+			Method:   "POST",
+			Path:     fmt.Sprintf("/gov/proposals/{%s}/deposits", RestProposalID),
+			Summary:  "Deposit on a proposal",
+			Request:  &DepositReq{},
+			Response: utils.TxResponse(kb),
+			Handler:  depositHandlerFn(cdc, kb, cliCtx),
+		},
+		routes.Route{
+			Name:     "Vote",
+			Method:   "POST",
+			Path:     fmt.Sprintf("/gov/proposals/{%s}/votes", RestProposalID),
+			Summary:  "Vote on a proposal",
+			Request:  &VoteReq{},
+			Response: utils.TxResponse(kb),
+			Handler:  voteHandlerFn(cdc, kb, cliCtx),
+		},
+
+		routes.Route{
+			Name:     "GetProposal",
+			Method:   "GET",
+			Path:     fmt.Sprintf("/gov/proposals/{%s}", RestProposalID),
+			Summary:  "Get a proposal",
+			Response: new(gov.Proposal),
+			Handler:  queryProposalHandlerFn(cdc),
+		},
+		routes.Route{
+			Name:     "GetDeposit",
+			Method:   "GET",
+			Path:     fmt.Sprintf("/gov/proposals/{%s}/deposits/{%s}", RestProposalID, RestDepositer),
+			Summary:  "Get the deposit of a depositer on a proposal",
+			Response: new(gov.Deposit),
+			Handler:  queryDepositHandlerFn(cdc),
+		},
+		routes.Route{
+			Name:     "GetVote",
+			Method:   "GET",
+			Path:     fmt.Sprintf("/gov/proposals/{%s}/votes/{%s}", RestProposalID, RestVoter),
+			Summary:  "Get the vote of a voter on a proposal",
+			Response: new(gov.Vote),
+			Handler:  queryVoteHandlerFn(cdc),
+		},
+
+		routes.Route{
+			Name:     "GetVotes",
+			Method:   "GET",
+			Path:     fmt.Sprintf("/gov/proposals/{%s}/votes", RestProposalID),
+			Summary:  "Get the votes on a proposal",
+			Response: new([]gov.Vote),
+			Handler:  queryVotesOnProposalHandlerFn(cdc),
+		},
+
+		routes.Route{
+			Name:     "GetProposals",
+			Method:   "GET",
+			Path:     "/gov/proposals",
+			Summary:  "Get the proposals, filtered by voter, depositer and status",
+			Query:    []string{RestVoter, RestDepositer, RestProposalStatus},
+			Response: new([]gov.Proposal),
+			Handler:  queryProposalsWithParameterFn(cdc),
+		},
+	)
 }
 
-type postProposalReq struct {
-	BaseReq        baseReq          `json:"base_req"`
+// PostProposalReq is the REST request body submitting a proposal
+type PostProposalReq struct {
+	BaseReq        BaseReq          `json:"base_req"`
 	Title          string           `json:"title"`           //  Title of the proposal
 	Description    string           `json:"description"`     //  Description of the proposal
 	ProposalType   gov.ProposalKind `json:"proposal_type"`   //  Type of proposal. Initial set {PlainTextProposal, SoftwareUpgradeProposal}
@@ -48,27 +114,29 @@ type postProposalReq struct {
 	InitialDeposit sdk.Coins        `json:"initial_deposit"` // Coins to add to the proposal's deposit
 }
 
-type depositReq struct {
-	BaseReq   baseReq        `json:"base_req"`
+// DepositReq is the REST request body depositing on a proposal
+type DepositReq struct {
+	BaseReq   BaseReq        `json:"base_req"`
 	Depositer sdk.AccAddress `json:"depositer"` // Address of the depositer
 	Amount    sdk.Coins      `json:"amount"`    // Coins to add to the proposal's deposit
 }
 
-type voteReq struct {
-	BaseReq baseReq        `json:"base_req"`
+// VoteReq is the REST request body voting on a proposal
+type VoteReq struct {
+	BaseReq BaseReq        `json:"base_req"`
 	Voter   sdk.AccAddress `json:"voter"`  //  address of the voter
 	Option  gov.VoteOption `json:"option"` //  option from OptionSet chosen by the voter
 }
 
-func postProposalHandlerFn(cdc *wire.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+func postProposalHandlerFn(cdc *wire.Codec, kb keys.Keybase, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		var req postProposalReq
+		var req PostProposalReq
 		err := buildReq(w, r, cdc, &req)
 		if err != nil {
 			return
 		}
 
-		if !req.BaseReq.baseReqValidate(w) {
+		if !req.BaseReq.baseReqValidate(w, kb) {
 			return
 		}
 
@@ -80,11 +148,11 @@ func postProposalHandlerFn(cdc *wire.Codec, cliCtx context.CLIContext) http.Hand
 			return
 		}
 
-		signAndBuild(w, cliCtx, req.BaseReq, msg, cdc)
+		signAndBuild(w, cliCtx, kb, req.BaseReq, msg, cdc)
 	}
 }
 
-func depositHandlerFn(cdc *wire.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+func depositHandlerFn(cdc *wire.Codec, kb keys.Keybase, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
 		strProposalID := vars[RestProposalID]
@@ -105,12 +173,12 @@ func depositHandlerFn(cdc *wire.Codec, cliCtx context.CLIContext) http.HandlerFu
 			return
 		}
 
-		var req depositReq
+		var req DepositReq
 		err = buildReq(w, r, cdc, &req)
 		if err != nil {
 			return
 		}
-		if !req.BaseReq.baseReqValidate(w) {
+		if !req.BaseReq.baseReqValidate(w, kb) {
 			return
 		}
 
@@ -122,11 +190,11 @@ func depositHandlerFn(cdc *wire.Codec, cliCtx context.CLIContext) http.HandlerFu
 			return
 		}
 
-		signAndBuild(w, cliCtx, req.BaseReq, msg, cdc)
+		signAndBuild(w, cliCtx, kb, req.BaseReq, msg, cdc)
 	}
 }
 
-func voteHandlerFn(cdc *wire.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+func voteHandlerFn(cdc *wire.Codec, kb keys.Keybase, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
 		strProposalID := vars[RestProposalID]
@@ -146,12 +214,12 @@ func voteHandlerFn(cdc *wire.Codec, cliCtx context.CLIContext) http.HandlerFunc
 			return
 		}
 
-		var req voteReq
+		var req VoteReq
 		err = buildReq(w, r, cdc, &req)
 		if err != nil {
 			return
 		}
-		if !req.BaseReq.baseReqValidate(w) {
+		if !req.BaseReq.baseReqValidate(w, kb) {
 			return
 		}
 
@@ -163,7 +231,7 @@ func voteHandlerFn(cdc *wire.Codec, cliCtx context.CLIContext) http.HandlerFunc
 			return
 		}
 
-		signAndBuild(w, cliCtx, req.BaseReq, msg, cdc)
+		signAndBuild(w, cliCtx, kb, req.BaseReq, msg, cdc)
 	}
 }
 
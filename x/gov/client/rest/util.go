@@ -5,6 +5,8 @@ import (
 	"net/http"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
 	authctx "github.com/cosmos/cosmos-sdk/x/auth/client/context"
@@ -12,13 +14,19 @@ import (
 	"github.com/pkg/errors"
 )
 
-type baseReq struct {
+// BaseReq is the part of the REST request bodies of the gov txs building and
+// signing the tx
+type BaseReq struct {
 	Name          string `json:"name"`
 	Password      string `json:"password"`
 	ChainID       string `json:"chain_id"`
 	AccountNumber int64  `json:"account_number"`
 	Sequence      int64  `json:"sequence"`
 	Gas           int64  `json:"gas"`
+	TimeoutHeight int64  `json:"timeout_height"`
+	GenerateOnly  bool   `json:"generate_only"`
+	Simulate      bool   `json:"simulate"`
+	GasAdjustment string `json:"gas_adjustment"`
 }
 
 func buildReq(w http.ResponseWriter, r *http.Request, cdc *wire.Codec, req interface{}) error {
@@ -35,13 +43,15 @@ func buildReq(w http.ResponseWriter, r *http.Request, cdc *wire.Codec, req inter
 	return nil
 }
 
-func (req baseReq) baseReqValidate(w http.ResponseWriter) bool {
-	if len(req.Name) == 0 {
+// the name and password of the key are only required if the server holds keys
+func (req BaseReq) baseReqValidate(w http.ResponseWriter, kb keys.Keybase) bool {
+	if len(req.Name) == 0 && kb != nil {
 		writeErr(&w, http.StatusUnauthorized, "Name required but not specified")
 		return false
 	}
 
-	if len(req.Password) == 0 {
+	// no key is unlocked to only generate the tx
+	if len(req.Password) == 0 && kb != nil && !req.GenerateOnly {
 		writeErr(&w, http.StatusUnauthorized, "Password required but not specified")
 		return false
 	}
@@ -60,6 +70,11 @@ func (req baseReq) baseReqValidate(w http.ResponseWriter) bool {
 		writeErr(&w, http.StatusUnauthorized, "Sequence required but not specified")
 		return false
 	}
+
+	if req.TimeoutHeight < 0 {
+		writeErr(&w, http.StatusBadRequest, "Timeout height cannot be negative")
+		return false
+	}
 	return true
 }
 
@@ -71,13 +86,30 @@ func writeErr(w *http.ResponseWriter, status int, msg string) {
 
 // TODO: Build this function out into a more generic base-request
 // (probably should live in client/lcd).
-func signAndBuild(w http.ResponseWriter, cliCtx context.CLIContext, baseReq baseReq, msg sdk.Msg, cdc *wire.Codec) {
+func signAndBuild(w http.ResponseWriter, cliCtx context.CLIContext, kb keys.Keybase, baseReq BaseReq, msg sdk.Msg, cdc *wire.Codec) {
 	txCtx := authctx.TxContext{
 		Codec:         cdc,
 		AccountNumber: baseReq.AccountNumber,
 		Sequence:      baseReq.Sequence,
 		ChainID:       baseReq.ChainID,
 		Gas:           baseReq.Gas,
+		TimeoutHeight: baseReq.TimeoutHeight,
+	}
+
+	// a server holding no keys only returns the tx to sign
+	if kb == nil {
+		utils.WriteUnsignedTxResponse(w, txCtx, []sdk.Msg{msg})
+		return
+	}
+
+	if baseReq.GenerateOnly {
+		utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{msg})
+		return
+	}
+
+	if baseReq.Simulate {
+		utils.WriteSimulationResponse(w, txCtx, cliCtx, baseReq.Name, baseReq.Password, baseReq.GasAdjustment, []sdk.Msg{msg})
+		return
 	}
 
 	txBytes, err := txCtx.BuildAndSign(baseReq.Name, baseReq.Password, []sdk.Msg{msg})
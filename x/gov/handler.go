@@ -142,7 +142,8 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) (resTags sdk.Tags) {
 				val.GetPubKey(),
 				ctx.BlockHeight(),
 				val.GetPower().RoundInt64(),
-				keeper.GetTallyingProcedure(ctx).GovernancePenalty)
+				keeper.GetTallyingProcedure(ctx).GovernancePenalty,
+				sdk.InfractionGovernance)
 		}
 
 		resTags.AppendTag(tags.Action, action)
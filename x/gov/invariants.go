@@ -0,0 +1,21 @@
+package gov
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// DepositsInvariant checks that the gov module account holds the deposits on
+// every proposal
+func DepositsInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		held := keeper.ck.GetCoins(ctx, auth.NewModuleAddress(ModuleName))
+		deposits := keeper.GetTotalDeposits(ctx)
+		if !held.IsEqual(deposits) {
+			return fmt.Errorf("gov module account holds %v, but the deposits are %v", held, deposits)
+		}
+		return nil
+	}
+}
@@ -3,6 +3,7 @@ package gov
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	wire "github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
@@ -40,6 +41,7 @@ type Keeper struct {
 
 // NewGovernanceMapper returns a mapper that uses go-wire to (binary) encode and decode gov types.
 func NewKeeper(cdc *wire.Codec, key sdk.StoreKey, ps params.Setter, ck bank.Keeper, ds sdk.DelegationSet, codespace sdk.CodespaceType) Keeper {
+	ck.RegisterModuleAccount(ModuleName, auth.Burner)
 	return Keeper{
 		storeKey:  key,
 		ps:        ps,
@@ -265,8 +267,8 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID int64, depositerAddr
 		return ErrAlreadyFinishedProposal(keeper.codespace, proposalID), false
 	}
 
-	// Subtract coins from depositer's account
-	_, _, err := keeper.ck.SubtractCoins(ctx, depositerAddr, depositAmount)
+	// Send coins from depositer's account to the gov module account
+	_, err := keeper.ck.SendCoinsFromAccountToModule(ctx, depositerAddr, ModuleName, depositAmount)
 	if err != nil {
 		return err, false
 	}
@@ -311,7 +313,7 @@ func (keeper Keeper) RefundDeposits(ctx sdk.Context, proposalID int64) {
 		deposit := &Deposit{}
 		keeper.cdc.MustUnmarshalBinary(depositsIterator.Value(), deposit)
 
-		_, _, err := keeper.ck.AddCoins(ctx, deposit.Depositer, deposit.Amount)
+		_, err := keeper.ck.SendCoinsFromModuleToAccount(ctx, ModuleName, deposit.Depositer, deposit.Amount)
 		if err != nil {
 			panic("should not happen")
 		}
@@ -322,18 +324,41 @@ func (keeper Keeper) RefundDeposits(ctx sdk.Context, proposalID int64) {
 	depositsIterator.Close()
 }
 
-// Deletes all the deposits on a specific proposal without refunding them
+// Deletes and burns all the deposits on a specific proposal without refunding them
 func (keeper Keeper) DeleteDeposits(ctx sdk.Context, proposalID int64) {
 	store := ctx.KVStore(keeper.storeKey)
 	depositsIterator := keeper.GetDeposits(ctx, proposalID)
 
 	for ; depositsIterator.Valid(); depositsIterator.Next() {
+		deposit := &Deposit{}
+		keeper.cdc.MustUnmarshalBinary(depositsIterator.Value(), deposit)
+
+		err := keeper.ck.BurnModuleCoins(ctx, ModuleName, deposit.Amount)
+		if err != nil {
+			panic("should not happen")
+		}
+
 		store.Delete(depositsIterator.Key())
 	}
 
 	depositsIterator.Close()
 }
 
+// Returns the sum of all the deposits held, on every proposal
+func (keeper Keeper) GetTotalDeposits(ctx sdk.Context) sdk.Coins {
+	store := ctx.KVStore(keeper.storeKey)
+	depositsIterator := sdk.KVStorePrefixIterator(store, KeyDepositsPrefix)
+	defer depositsIterator.Close()
+
+	total := sdk.Coins{}
+	for ; depositsIterator.Valid(); depositsIterator.Next() {
+		deposit := &Deposit{}
+		keeper.cdc.MustUnmarshalBinary(depositsIterator.Value(), deposit)
+		total = total.Plus(deposit.Amount)
+	}
+	return total
+}
+
 // =====================================================
 // ProposalQueues
 
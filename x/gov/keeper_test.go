@@ -8,6 +8,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 )
 
 func TestGetSetProposal(t *testing.T) {
@@ -131,6 +132,11 @@ func TestDeposits(t *testing.T) {
 	depositsIterator.Next()
 	require.False(t, depositsIterator.Valid())
 
+	// The deposits are held by the gov module account
+	moduleAddr := auth.NewModuleAddress(ModuleName)
+	require.Equal(t, fourSteak.Plus(fiveSteak).Plus(fourSteak), keeper.ck.GetCoins(ctx, moduleAddr))
+	require.Nil(t, DepositsInvariant(keeper)(ctx))
+
 	// Test Refund Deposits
 	deposit, found = keeper.GetDeposit(ctx, proposalID, addrs[1])
 	require.True(t, found)
@@ -140,7 +146,8 @@ func TestDeposits(t *testing.T) {
 	require.False(t, found)
 	require.Equal(t, addr0Initial, keeper.ck.GetCoins(ctx, addrs[0]))
 	require.Equal(t, addr1Initial, keeper.ck.GetCoins(ctx, addrs[1]))
-
+	require.True(t, keeper.ck.GetCoins(ctx, moduleAddr).IsZero())
+	require.Nil(t, DepositsInvariant(keeper)(ctx))
 }
 
 func TestVotes(t *testing.T) {
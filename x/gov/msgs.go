@@ -9,6 +9,9 @@ import (
 // name to idetify transaction types
 const MsgType = "gov"
 
+// name of the module account holding the deposits
+const ModuleName = "gov"
+
 //-----------------------------------------------------------
 // MsgSubmitProposal
 type MsgSubmitProposal struct {
@@ -29,17 +29,18 @@ func getMockApp(t *testing.T, numGenAccs int) (*mock.App, Keeper, stake.Keeper,
 	keyGlobalParams := sdk.NewKVStoreKey("params")
 	keyStake := sdk.NewKVStoreKey("stake")
 	keyGov := sdk.NewKVStoreKey("gov")
+	keyBank := sdk.NewKVStoreKey("bank")
 
 	pk := params.NewKeeper(mapp.Cdc, keyGlobalParams)
-	ck := bank.NewKeeper(mapp.AccountMapper)
+	ck := bank.NewKeeper(mapp.Cdc, keyBank, mapp.AccountMapper, pk.Setter(), mapp.RegisterCodespace(bank.DefaultCodespace))
 	sk := stake.NewKeeper(mapp.Cdc, keyStake, ck, mapp.RegisterCodespace(stake.DefaultCodespace))
 	keeper := NewKeeper(mapp.Cdc, keyGov, pk.Setter(), ck, sk, DefaultCodespace)
 	mapp.Router().AddRoute("gov", NewHandler(keeper))
 
 	mapp.SetEndBlocker(getEndBlocker(keeper))
-	mapp.SetInitChainer(getInitChainer(mapp, keeper, sk))
+	mapp.SetInitChainer(getInitChainer(mapp, keeper, ck, sk))
 
-	require.NoError(t, mapp.CompleteSetup([]*sdk.KVStoreKey{keyStake, keyGov, keyGlobalParams}))
+	require.NoError(t, mapp.CompleteSetup([]*sdk.KVStoreKey{keyStake, keyGov, keyBank, keyGlobalParams}))
 
 	genAccs, addrs, pubKeys, privKeys := mock.CreateGenAccounts(numGenAccs, sdk.Coins{sdk.NewInt64Coin("steak", 42)})
 
@@ -59,10 +60,15 @@ func getEndBlocker(keeper Keeper) sdk.EndBlocker {
 }
 
 // gov and stake initchainer
-func getInitChainer(mapp *mock.App, keeper Keeper, stakeKeeper stake.Keeper) sdk.InitChainer {
+func getInitChainer(mapp *mock.App, keeper Keeper, coinKeeper bank.Keeper, stakeKeeper stake.Keeper) sdk.InitChainer {
 	return func(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
 		mapp.InitChainer(ctx, req)
 
+		err := bank.InitGenesis(ctx, coinKeeper, bank.DefaultGenesisState())
+		if err != nil {
+			panic(err)
+		}
+
 		stakeGenesis := stake.DefaultGenesisState()
 		stakeGenesis.Pool.LooseTokens = sdk.NewDec(100000)
 
@@ -9,33 +9,61 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/mock"
+	"github.com/cosmos/cosmos-sdk/x/params"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 )
 
-// initialize the mock application for this module
-func getMockApp(t *testing.T) *mock.App {
+// initialize the mock application for this module, allowing the given
+// addresses to create light clients
+func getMockApp(t *testing.T, clientCreators ...sdk.AccAddress) *mock.App {
 	mapp := mock.NewApp()
 
 	RegisterWire(mapp.Cdc)
 	keyIBC := sdk.NewKVStoreKey("ibc")
 	ibcMapper := NewMapper(mapp.Cdc, keyIBC, mapp.RegisterCodespace(DefaultCodespace))
-	coinKeeper := bank.NewKeeper(mapp.AccountMapper)
+	keyBank := sdk.NewKVStoreKey("bank")
+	keyParams := sdk.NewKVStoreKey("params")
+	paramsKeeper := params.NewKeeper(mapp.Cdc, keyParams)
+	coinKeeper := bank.NewKeeper(mapp.Cdc, keyBank, mapp.AccountMapper, paramsKeeper.Setter(), mapp.RegisterCodespace(bank.DefaultCodespace))
+	ibcMapper.AddRoute(PortTransfer, NewTransferHandler(coinKeeper))
 	mapp.Router().AddRoute("ibc", NewHandler(ibcMapper, coinKeeper))
 
-	require.NoError(t, mapp.CompleteSetup([]*sdk.KVStoreKey{keyIBC}))
+	mapp.SetInitChainer(getInitChainer(mapp, coinKeeper, ibcMapper, NewGenesisState(clientCreators)))
+
+	require.NoError(t, mapp.CompleteSetup([]*sdk.KVStoreKey{keyIBC, keyBank, keyParams}))
 	return mapp
 }
 
+// overwrite the mock init chainer to initialize the total supply and the
+// light client creators
+func getInitChainer(mapp *mock.App, coinKeeper bank.Keeper, ibcMapper Mapper, genesis GenesisState) sdk.InitChainer {
+	return func(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
+		mapp.InitChainer(ctx, req)
+		err := bank.InitGenesis(ctx, coinKeeper, bank.DefaultGenesisState())
+		if err != nil {
+			panic(err)
+		}
+		err = InitGenesis(ctx, ibcMapper, genesis)
+		if err != nil {
+			panic(err)
+		}
+
+		return abci.ResponseInitChain{}
+	}
+}
+
 func TestIBCMsgs(t *testing.T) {
-	mapp := getMockApp(t)
+	priv1 := ed25519.GenPrivKey()
+	addr1 := sdk.AccAddress(priv1.PubKey().Address())
+	mapp := getMockApp(t, addr1)
 
+	// the mock app runs with an empty chain ID
+	mockChain := ""
 	sourceChain := "source-chain"
 	destChain := "dest-chain"
 
-	priv1 := ed25519.GenPrivKey()
-	addr1 := sdk.AccAddress(priv1.PubKey().Address())
 	coins := sdk.Coins{sdk.NewInt64Coin("foocoin", 10)}
 	var emptyCoins sdk.Coins
 
@@ -52,28 +80,80 @@ func TestIBCMsgs(t *testing.T) {
 	res1 := mapp.AccountMapper.GetAccount(ctxCheck, addr1)
 	require.Equal(t, acc, res1)
 
-	packet := IBCPacket{
-		SrcAddr:   addr1,
-		DestAddr:  addr1,
-		Coins:     coins,
-		SrcChain:  sourceChain,
-		DestChain: destChain,
-	}
+	transferMsg := NewIBCTransferMsg(addr1, addr1, coins, destChain, 0)
 
-	transferMsg := IBCTransferMsg{
-		IBCPacket: packet,
-	}
+	// the packet is committed on the source chain, tracked by a light client
+	packet := transferPacket(addr1, addr1, coins, sourceChain, mockChain, 0)
+	srcChain := newTestChain(t, mapp.Cdc, sourceChain)
+	header, _, proof := srcChain.postPacket(t, packet, 0)
+	createClientMsg := NewMsgCreateClient(addr1, header, srcChain.valset)
 
 	receiveMsg := IBCReceiveMsg{
-		IBCPacket: packet,
-		Relayer:   addr1,
-		Sequence:  0,
+		IBCPacket:   packet,
+		Relayer:     addr1,
+		Sequence:    0,
+		Proof:       proof,
+		ProofHeight: header.Height,
 	}
 
 	mock.SignCheckDeliver(t, mapp.BaseApp, []sdk.Msg{transferMsg}, []int64{0}, []int64{0}, true, priv1)
 	mock.CheckBalance(t, mapp, addr1, emptyCoins)
+	mock.CheckBalance(t, mapp, EscrowAddress(destChain), coins)
 	mock.SignCheckDeliver(t, mapp.BaseApp, []sdk.Msg{transferMsg}, []int64{0}, []int64{1}, false, priv1)
-	mock.SignCheckDeliver(t, mapp.BaseApp, []sdk.Msg{receiveMsg}, []int64{0}, []int64{2}, true, priv1)
-	mock.CheckBalance(t, mapp, addr1, coins)
-	mock.SignCheckDeliver(t, mapp.BaseApp, []sdk.Msg{receiveMsg}, []int64{0}, []int64{2}, false, priv1)
+	mock.SignCheckDeliver(t, mapp.BaseApp, []sdk.Msg{createClientMsg}, []int64{0}, []int64{2}, true, priv1)
+	mock.SignCheckDeliver(t, mapp.BaseApp, []sdk.Msg{receiveMsg}, []int64{0}, []int64{3}, true, priv1)
+	mock.CheckBalance(t, mapp, addr1, sdk.Coins{sdk.NewInt64Coin(VoucherPrefix(sourceChain)+"foocoin", 10)})
+	mock.SignCheckDeliver(t, mapp.BaseApp, []sdk.Msg{receiveMsg}, []int64{0}, []int64{3}, false, priv1)
+}
+
+func TestIBCForgedClient(t *testing.T) {
+	priv1 := ed25519.GenPrivKey()
+	addr1 := sdk.AccAddress(priv1.PubKey().Address())
+	priv2 := ed25519.GenPrivKey()
+	addr2 := sdk.AccAddress(priv2.PubKey().Address())
+	mapp := getMockApp(t, addr1)
+
+	// the mock app runs with an empty chain ID
+	mockChain := ""
+	sourceChain := "source-chain"
+	coins := sdk.Coins{sdk.NewInt64Coin("foocoin", 10)}
+	var emptyCoins sdk.Coins
+
+	accs := []auth.Account{
+		&auth.BaseAccount{Address: addr1},
+		&auth.BaseAccount{Address: addr2},
+	}
+	mock.SetGenesis(mapp, accs)
+
+	// addr2 runs its own chain with the chain ID of the source chain, and
+	// commits a packet sending itself coins
+	packet := transferPacket(addr2, addr2, coins, sourceChain, mockChain, 0)
+	forgedChain := newTestChain(t, mapp.Cdc, sourceChain)
+	forgedHeader, _, forgedProof := forgedChain.postPacket(t, packet, 0)
+	forgedReceiveMsg := IBCReceiveMsg{
+		IBCPacket:   packet,
+		Relayer:     addr2,
+		Sequence:    0,
+		Proof:       forgedProof,
+		ProofHeight: forgedHeader.Height,
+	}
+
+	// addr2 is not a client creator, so cannot create the client of its chain
+	forgedClientMsg := NewMsgCreateClient(addr2, forgedHeader, forgedChain.valset)
+	res := mock.SignCheckDeliver(t, mapp.BaseApp, []sdk.Msg{forgedClientMsg}, []int64{1}, []int64{0}, false, priv2)
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeUnauthorized), res.Code)
+	mock.SignCheckDeliver(t, mapp.BaseApp, []sdk.Msg{forgedReceiveMsg}, []int64{1}, []int64{1}, false, priv2)
+	mock.CheckBalance(t, mapp, addr2, emptyCoins)
+
+	// once addr1 created the client of the source chain, the packets of the
+	// forged chain don't verify against it
+	srcChain := newTestChain(t, mapp.Cdc, sourceChain)
+	header, _, _ := srcChain.postPacket(t, transferPacket(addr1, addr1, coins, sourceChain, mockChain, 0), 0)
+	createClientMsg := NewMsgCreateClient(addr1, header, srcChain.valset)
+	mock.SignCheckDeliver(t, mapp.BaseApp, []sdk.Msg{createClientMsg}, []int64{0}, []int64{0}, true, priv1)
+	require.Equal(t, header.Height, forgedHeader.Height)
+
+	mock.SignCheckDeliver(t, mapp.BaseApp, []sdk.Msg{forgedReceiveMsg}, []int64{1}, []int64{2}, false, priv2)
+	mock.SignCheckDeliver(t, mapp.BaseApp, []sdk.Msg{forgedClientMsg}, []int64{1}, []int64{3}, false, priv2)
+	mock.CheckBalance(t, mapp, addr2, emptyCoins)
 }
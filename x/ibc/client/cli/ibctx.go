@@ -4,7 +4,6 @@ import (
 	"encoding/hex"
 	"os"
 
-	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/utils"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -21,6 +20,8 @@ const (
 	flagTo     = "to"
 	flagAmount = "amount"
 	flagChain  = "chain"
+
+	flagPacketTimeout = "packet-timeout-height"
 )
 
 // IBCTransferCmd implements the IBC transfer command.
@@ -51,6 +52,7 @@ func IBCTransferCmd(cdc *wire.Codec) *cobra.Command {
 	cmd.Flags().String(flagTo, "", "Address to send coins")
 	cmd.Flags().String(flagAmount, "", "Amount of coins to send")
 	cmd.Flags().String(flagChain, "", "Destination chain to send coins")
+	cmd.Flags().Int64(flagPacketTimeout, 0, "Height of the destination chain after which the coins are refunded if they weren't received, 0 for no timeout")
 
 	return cmd
 }
@@ -69,12 +71,7 @@ func buildMsg(from sdk.AccAddress) (sdk.Msg, error) {
 	}
 	to := sdk.AccAddress(bz)
 
-	packet := ibc.NewIBCPacket(from, to, coins, viper.GetString(client.FlagChainID),
-		viper.GetString(flagChain))
-
-	msg := ibc.IBCTransferMsg{
-		IBCPacket: packet,
-	}
+	msg := ibc.NewIBCTransferMsg(from, to, coins, viper.GetString(flagChain), viper.GetInt64(flagPacketTimeout))
 
 	return msg, nil
 }
@@ -1,210 +1,107 @@
 package cli
 
 import (
+	"net/http"
 	"os"
-	"time"
+	"path/filepath"
 
-	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/keys"
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	wire "github.com/cosmos/cosmos-sdk/wire"
-	"github.com/cosmos/cosmos-sdk/x/auth"
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
-	authctx "github.com/cosmos/cosmos-sdk/x/auth/client/context"
-	"github.com/cosmos/cosmos-sdk/x/ibc"
+	"github.com/cosmos/cosmos-sdk/x/ibc/client/relayer"
 
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	cmn "github.com/tendermint/tendermint/libs/common"
+	dbm "github.com/tendermint/tendermint/libs/db"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
 // flags
 const (
-	FlagFromChainID   = "from-chain-id"
-	FlagFromChainNode = "from-chain-node"
-	FlagToChainID     = "to-chain-id"
-	FlagToChainNode   = "to-chain-node"
+	FlagRelayerConfig = "relayer-config"
 )
 
-type relayCommander struct {
-	cdc       *wire.Codec
-	address   sdk.AccAddress
-	decoder   auth.AccountDecoder
-	mainStore string
-	ibcStore  string
-	accStore  string
-
-	logger log.Logger
-}
-
 // IBCRelayCmd implements the IBC relay command.
 func IBCRelayCmd(cdc *wire.Codec) *cobra.Command {
-	cmdr := relayCommander{
-		cdc:       cdc,
-		decoder:   authcmd.GetAccountDecoder(cdc),
-		ibcStore:  "ibc",
-		mainStore: "main",
-		accStore:  "acc",
-
-		logger: log.NewTMLogger(log.NewSyncWriter(os.Stdout)),
-	}
-
 	cmd := &cobra.Command{
-		Use: "relay",
-		Run: cmdr.runIBCRelay,
-	}
-
-	cmd.Flags().String(FlagFromChainID, "", "Chain ID for ibc node to check outgoing packets")
-	cmd.Flags().String(FlagFromChainNode, "tcp://localhost:26657", "<host>:<port> to tendermint rpc interface for this chain")
-	cmd.Flags().String(FlagToChainID, "", "Chain ID for ibc node to broadcast incoming packets")
-	cmd.Flags().String(FlagToChainNode, "tcp://localhost:36657", "<host>:<port> to tendermint rpc interface for this chain")
-
-	cmd.MarkFlagRequired(FlagFromChainID)
-	cmd.MarkFlagRequired(FlagFromChainNode)
-	cmd.MarkFlagRequired(FlagToChainID)
-	cmd.MarkFlagRequired(FlagToChainNode)
-
-	viper.BindPFlag(FlagFromChainID, cmd.Flags().Lookup(FlagFromChainID))
-	viper.BindPFlag(FlagFromChainNode, cmd.Flags().Lookup(FlagFromChainNode))
-	viper.BindPFlag(FlagToChainID, cmd.Flags().Lookup(FlagToChainID))
-	viper.BindPFlag(FlagToChainNode, cmd.Flags().Lookup(FlagToChainNode))
-
-	return cmd
-}
-
-// nolint: unparam
-func (c relayCommander) runIBCRelay(cmd *cobra.Command, args []string) {
-	fromChainID := viper.GetString(FlagFromChainID)
-	fromChainNode := viper.GetString(FlagFromChainNode)
-	toChainID := viper.GetString(FlagToChainID)
-	toChainNode := viper.GetString(FlagToChainNode)
-
-	address, err := context.NewCLIContext().GetFromAddress()
-	if err != nil {
-		panic(err)
-	}
-
-	c.address = address
-
-	c.loop(fromChainID, fromChainNode, toChainID, toChainNode)
-}
-
-// This is nolinted as someone is in the process of refactoring this to remove the goto
-// nolint: gocyclo
-func (c relayCommander) loop(fromChainID, fromChainNode, toChainID, toChainNode string) {
-	cliCtx := context.NewCLIContext()
-
-	passphrase, err := keys.ReadPassphraseFromStdin(cliCtx.FromAddressName)
-	if err != nil {
-		panic(err)
-	}
-
-	ingressKey := ibc.IngressSequenceKey(fromChainID)
-
-OUTER:
-	for {
-		time.Sleep(5 * time.Second)
-
-		processedbz, err := query(toChainNode, ingressKey, c.ibcStore)
-		if err != nil {
-			panic(err)
-		}
-
-		var processed int64
-		if processedbz == nil {
-			processed = 0
-		} else if err = c.cdc.UnmarshalBinary(processedbz, &processed); err != nil {
-			panic(err)
-		}
-
-		lengthKey := ibc.EgressLengthKey(toChainID)
-		egressLengthbz, err := query(fromChainNode, lengthKey, c.ibcStore)
-		if err != nil {
-			c.logger.Error("error querying outgoing packet list length", "err", err)
-			continue OUTER //TODO replace with continue (I think it should just to the correct place where OUTER is now)
-		}
-
-		var egressLength int64
-		if egressLengthbz == nil {
-			egressLength = 0
-		} else if err = c.cdc.UnmarshalBinary(egressLengthbz, &egressLength); err != nil {
-			panic(err)
-		}
-
-		if egressLength > processed {
-			c.logger.Info("Detected IBC packet", "number", egressLength-1)
-		}
-
-		seq := c.getSequence(toChainNode)
-
-		for i := processed; i < egressLength; i++ {
-			egressbz, err := query(fromChainNode, ibc.EgressKey(toChainID, i), c.ibcStore)
+		Use:   "relay",
+		Short: "Relay IBC packets along the chain pairs of the relayer configuration",
+		Long: `Relay IBC packets along the chain pairs of the relayer configuration, along
+with their acknowledgements and timeouts. The progress of the relayer is
+stored in db_dir, relative to the configuration file unless absolute.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			file := viper.GetString(FlagRelayerConfig)
+			cfg, err := relayer.LoadConfig(file)
 			if err != nil {
-				c.logger.Error("error querying egress packet", "err", err)
-				continue OUTER // TODO replace to break, will break first loop then send back to the beginning (aka OUTER)
+				return err
 			}
 
-			err = c.broadcastTx(seq, toChainNode, c.refine(egressbz, i, passphrase))
-
-			seq++
-
+			kb, err := keys.GetKeyBase()
 			if err != nil {
-				c.logger.Error("error broadcasting ingress packet", "err", err)
-				continue OUTER // TODO replace to break, will break first loop then send back to the beginning (aka OUTER)
+				return err
 			}
 
-			c.logger.Info("Relayed IBC packet", "number", i)
-		}
-	}
-}
+			// the passphrase of each key is read once
+			chains := make([]relayer.Chain, len(cfg.Chains))
+			chainKeys := make(map[string]string)
+			passphrases := make(map[string]string)
+			for i, chain := range cfg.Chains {
+				chains[i] = relayer.NewRPCChain(chain.ChainID, chain.Node)
+				chainKeys[chain.ChainID] = chain.Key
+
+				if _, ok := passphrases[chain.Key]; ok {
+					continue
+				}
+				passphrases[chain.Key], err = keys.GetPassphrase(chain.Key)
+				if err != nil {
+					return err
+				}
+			}
 
-func query(node string, key []byte, storeName string) (res []byte, err error) {
-	return context.NewCLIContext().WithNodeURI(node).QueryStore(key, storeName)
-}
+			dbDir := cfg.DBDir
+			if !filepath.IsAbs(dbDir) {
+				dbDir = filepath.Join(filepath.Dir(file), dbDir)
+			}
+			db, err := dbm.NewGoLevelDB("relayer", dbDir)
+			if err != nil {
+				return err
+			}
 
-func (c relayCommander) broadcastTx(seq int64, node string, tx []byte) error {
-	_, err := context.NewCLIContext().WithNodeURI(node).BroadcastTx(tx)
-	return err
-}
+			logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", "relayer")
 
-func (c relayCommander) getSequence(node string) int64 {
-	res, err := query(node, c.address, c.accStore)
-	if err != nil {
-		panic(err)
-	}
-
-	if nil != res {
-		account, err := c.decoder(res)
-		if err != nil {
-			panic(err)
-		}
+			metrics := relayer.NopMetrics()
+			if cfg.MetricsListenAddr != "" {
+				metrics = relayer.PrometheusMetrics()
+				go func() {
+					err := http.ListenAndServe(cfg.MetricsListenAddr, promhttp.Handler())
+					logger.Error("error serving metrics", "err", err)
+				}()
+			}
 
-		return account.GetSequence()
-	}
+			r, err := relayer.NewRelayer(cdc, cfg, chains, relayer.NewKeybaseSigner(kb, chainKeys, passphrases),
+				authcmd.GetAccountDecoder(cdc), db, metrics, logger)
+			if err != nil {
+				return err
+			}
 
-	return 0
-}
+			quit := make(chan struct{})
+			go r.Run(quit)
 
-func (c relayCommander) refine(bz []byte, sequence int64, passphrase string) []byte {
-	var packet ibc.IBCPacket
-	if err := c.cdc.UnmarshalBinary(bz, &packet); err != nil {
-		panic(err)
-	}
+			// wait forever and cleanup
+			cmn.TrapSignal(func() {
+				close(quit)
+				db.Close()
+			})
 
-	msg := ibc.IBCReceiveMsg{
-		IBCPacket: packet,
-		Relayer:   c.address,
-		Sequence:  sequence,
+			return nil
+		},
 	}
 
-	txCtx := authctx.NewTxContextFromCLI().WithSequence(sequence).WithCodec(c.cdc)
-	cliCtx := context.NewCLIContext()
+	cmd.Flags().String(FlagRelayerConfig, "relayer.toml", "Path of the TOML configuration of the relayer")
+	viper.BindPFlag(FlagRelayerConfig, cmd.Flags().Lookup(FlagRelayerConfig))
 
-	res, err := txCtx.BuildAndSign(cliCtx.FromAddressName, passphrase, []sdk.Msg{msg})
-	if err != nil {
-		panic(err)
-	}
-
-	return res
+	return cmd
 }
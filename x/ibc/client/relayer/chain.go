@@ -0,0 +1,144 @@
+package relayer
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// Chain is the connection of the relayer to a chain
+type Chain interface {
+	ChainID() string
+
+	// height of the latest block of the chain, whose header commits to the
+	// state of the previous height
+	LatestHeight() (int64, error)
+
+	// header of the block at the given height, along with the commit and the
+	// validator set signing it
+	SignedHeader(height int64) (tmtypes.Header, tmtypes.Commit, *tmtypes.ValidatorSet, error)
+
+	// query a key of a store, at the latest height without proof if height is
+	// zero, otherwise at the given height along with the proof of the value
+	QueryStore(storeName string, key []byte, height int64) (value []byte, proof []byte, err error)
+
+	// simulate a tx against the latest state of the chain
+	Simulate(tx []byte) (sdk.Result, error)
+
+	// broadcast a tx and wait for it to be committed
+	BroadcastTx(tx []byte) error
+}
+
+// chain reached through the rpc interface of a tendermint node
+type rpcChain struct {
+	chainID string
+	node    rpcclient.Client
+}
+
+// NewRPCChain returns the connection to a chain through the rpc interface of
+// one of its nodes
+func NewRPCChain(chainID, node string) Chain {
+	return rpcChain{
+		chainID: chainID,
+		node:    rpcclient.NewHTTP(node, "/websocket"),
+	}
+}
+
+func (c rpcChain) ChainID() string {
+	return c.chainID
+}
+
+func (c rpcChain) LatestHeight() (int64, error) {
+	status, err := c.node.Status()
+	if err != nil {
+		return 0, err
+	}
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+func (c rpcChain) SignedHeader(height int64) (tmtypes.Header, tmtypes.Commit, *tmtypes.ValidatorSet, error) {
+	commit, err := c.node.Commit(&height)
+	if err != nil {
+		return tmtypes.Header{}, tmtypes.Commit{}, nil, err
+	}
+	if commit.Header.ChainID != c.chainID {
+		return tmtypes.Header{}, tmtypes.Commit{}, nil, fmt.Errorf("node is running chain %s, not %s", commit.Header.ChainID, c.chainID)
+	}
+
+	validators, err := c.node.Validators(&height)
+	if err != nil {
+		return tmtypes.Header{}, tmtypes.Commit{}, nil, err
+	}
+
+	return *commit.Header, *commit.Commit, tmtypes.NewValidatorSet(validators.Validators), nil
+}
+
+func (c rpcChain) QueryStore(storeName string, key []byte, height int64) ([]byte, []byte, error) {
+	opts := rpcclient.ABCIQueryOptions{
+		Height:  height,
+		Trusted: false,
+	}
+
+	// the node defaults to the state of the previous height, which lags behind
+	// the txs of the latest block
+	if height == 0 {
+		latest, err := c.LatestHeight()
+		if err != nil {
+			return nil, nil, err
+		}
+		opts = rpcclient.ABCIQueryOptions{
+			Height:  latest,
+			Trusted: true,
+		}
+	}
+
+	result, err := c.node.ABCIQueryWithOptions(fmt.Sprintf("/store/%s/key", storeName), key, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := result.Response
+	if !resp.IsOK() {
+		return nil, nil, fmt.Errorf("query failed: (%d) %s", resp.Code, resp.Log)
+	}
+
+	return resp.Value, resp.Proof, nil
+}
+
+func (c rpcChain) Simulate(tx []byte) (sdk.Result, error) {
+	result, err := c.node.ABCIQuery("/app/simulate", tx)
+	if err != nil {
+		return sdk.Result{}, err
+	}
+
+	resp := result.Response
+	if !resp.IsOK() {
+		return sdk.Result{}, fmt.Errorf("simulation failed: (%d) %s", resp.Code, resp.Log)
+	}
+
+	var res sdk.Result
+	if err = wire.Cdc.UnmarshalBinary(resp.Value, &res); err != nil {
+		return sdk.Result{}, err
+	}
+	return res, nil
+}
+
+func (c rpcChain) BroadcastTx(tx []byte) error {
+	res, err := c.node.BroadcastTxCommit(tx)
+	if err != nil {
+		return err
+	}
+
+	if !res.CheckTx.IsOK() {
+		return fmt.Errorf("checkTx failed: (%d) %s", res.CheckTx.Code, res.CheckTx.Log)
+	}
+	if !res.DeliverTx.IsOK() {
+		return fmt.Errorf("deliverTx failed: (%d) %s", res.DeliverTx.Code, res.DeliverTx.Log)
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package relayer
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/spf13/viper"
+)
+
+// Config of the relayer, usually read from a TOML file
+type Config struct {
+	// directory of the database tracking the progress of the relayer
+	DBDir string `mapstructure:"db_dir"`
+	// address the Prometheus metrics are served on, disabled if empty
+	MetricsListenAddr string `mapstructure:"metrics_listen_addr"`
+
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	// the backoff after a failure on a path doubles up to the maximum
+	MinBackoff time.Duration `mapstructure:"min_backoff"`
+	MaxBackoff time.Duration `mapstructure:"max_backoff"`
+
+	// maximum number of msgs batched in a single tx
+	MaxMsgsPerTx int `mapstructure:"max_msgs_per_tx"`
+	// factor applied to the gas estimated by simulating the txs
+	GasAdjustment float64 `mapstructure:"gas_adjustment"`
+
+	Chains []ChainConfig `mapstructure:"chains"`
+	Paths  []Path        `mapstructure:"paths"`
+}
+
+// ChainConfig is the configuration of a chain the relayer connects to
+type ChainConfig struct {
+	ChainID string `mapstructure:"chain_id"`
+	// <host>:<port> of the tendermint rpc interface of the chain
+	Node string `mapstructure:"node"`
+	// name of the key signing the txs of the relayer on the chain
+	Key string `mapstructure:"key"`
+	// fee paid by each tx of the relayer, free if empty
+	Fee string `mapstructure:"fee"`
+}
+
+// Path along which the packets are relayed from the source chain to the
+// destination chain, and their acknowledgements relayed back
+type Path struct {
+	Src  string `mapstructure:"src"`
+	Dest string `mapstructure:"dest"`
+}
+
+func (path Path) String() string {
+	return fmt.Sprintf("%s->%s", path.Src, path.Dest)
+}
+
+// DefaultConfig returns the default configuration of the relayer
+func DefaultConfig() Config {
+	return Config{
+		DBDir:         "relayer",
+		PollInterval:  5 * time.Second,
+		MinBackoff:    5 * time.Second,
+		MaxBackoff:    5 * time.Minute,
+		MaxMsgsPerTx:  20,
+		GasAdjustment: 1.2,
+	}
+}
+
+// LoadConfig reads the configuration of the relayer from a file, filling the
+// missing fields with their default value
+func LoadConfig(file string) (Config, error) {
+	v := viper.New()
+	v.SetConfigFile(file)
+	if err := v.ReadInConfig(); err != nil {
+		return Config{}, err
+	}
+
+	cfg := DefaultConfig()
+	if err := v.Unmarshal(&cfg); err != nil {
+		return Config{}, err
+	}
+
+	return cfg, cfg.ValidateBasic()
+}
+
+// ValidateBasic checks the configuration is consistent
+func (cfg Config) ValidateBasic() error {
+	if cfg.PollInterval <= 0 {
+		return fmt.Errorf("poll interval must be positive")
+	}
+	if cfg.MinBackoff <= 0 || cfg.MaxBackoff < cfg.MinBackoff {
+		return fmt.Errorf("invalid backoff range [%v, %v]", cfg.MinBackoff, cfg.MaxBackoff)
+	}
+	if cfg.MaxMsgsPerTx <= 0 {
+		return fmt.Errorf("max msgs per tx must be positive")
+	}
+	if cfg.GasAdjustment < 1 {
+		return fmt.Errorf("gas adjustment must be at least 1")
+	}
+
+	chains := make(map[string]bool)
+	for _, chain := range cfg.Chains {
+		if chain.ChainID == "" || chain.Node == "" || chain.Key == "" {
+			return fmt.Errorf("chain %q requires a chain ID, a node and a key", chain.ChainID)
+		}
+		if chains[chain.ChainID] {
+			return fmt.Errorf("chain %s is configured twice", chain.ChainID)
+		}
+		if chain.Fee != "" {
+			if _, err := sdk.ParseCoin(chain.Fee); err != nil {
+				return fmt.Errorf("invalid fee of chain %s: %v", chain.ChainID, err)
+			}
+		}
+		chains[chain.ChainID] = true
+	}
+
+	if len(cfg.Paths) == 0 {
+		return fmt.Errorf("no path to relay")
+	}
+	paths := make(map[Path]bool)
+	for _, path := range cfg.Paths {
+		if !chains[path.Src] || !chains[path.Dest] {
+			return fmt.Errorf("path %v references an unknown chain", path)
+		}
+		if path.Src == path.Dest {
+			return fmt.Errorf("path %v relays a chain to itself", path)
+		}
+		if paths[path] {
+			return fmt.Errorf("path %v is configured twice", path)
+		}
+		paths[path] = true
+	}
+
+	return nil
+}
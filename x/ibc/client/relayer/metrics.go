@@ -0,0 +1,88 @@
+package relayer
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const metricsSubsystem = "relayer"
+
+// Metrics exposed by the relayer, labelled by the chains of the path
+type Metrics struct {
+	// packets relayed to the destination chain
+	PacketsRelayed metrics.Counter
+	// acknowledgements relayed back to the source chain
+	AcknowledgementsRelayed metrics.Counter
+	// timeouts relayed back to the source chain
+	TimeoutsRelayed metrics.Counter
+	// packets posted on the source chain not yet processed by the destination
+	// chain
+	PendingPackets metrics.Gauge
+	// failed attempts to relay along the path
+	Errors metrics.Counter
+
+	// txs broadcasted to the chain, labelled by chain
+	TxsBroadcasted metrics.Counter
+	// gas used by the txs broadcasted to the chain, labelled by chain
+	GasUsed metrics.Counter
+}
+
+// PrometheusMetrics returns Metrics built using the Prometheus client library
+func PrometheusMetrics() *Metrics {
+	pathLabels := []string{"src_chain", "dest_chain"}
+	chainLabels := []string{"chain_id"}
+
+	return &Metrics{
+		PacketsRelayed: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Subsystem: metricsSubsystem,
+			Name:      "packets_relayed",
+			Help:      "Number of packets relayed to the destination chain.",
+		}, pathLabels),
+		AcknowledgementsRelayed: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Subsystem: metricsSubsystem,
+			Name:      "acknowledgements_relayed",
+			Help:      "Number of acknowledgements relayed back to the source chain.",
+		}, pathLabels),
+		TimeoutsRelayed: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Subsystem: metricsSubsystem,
+			Name:      "timeouts_relayed",
+			Help:      "Number of packet timeouts relayed back to the source chain.",
+		}, pathLabels),
+		PendingPackets: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Subsystem: metricsSubsystem,
+			Name:      "pending_packets",
+			Help:      "Number of packets not yet processed by the destination chain.",
+		}, pathLabels),
+		Errors: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Subsystem: metricsSubsystem,
+			Name:      "errors",
+			Help:      "Number of failed attempts to relay along the path.",
+		}, pathLabels),
+		TxsBroadcasted: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Subsystem: metricsSubsystem,
+			Name:      "txs_broadcasted",
+			Help:      "Number of txs broadcasted to the chain.",
+		}, chainLabels),
+		GasUsed: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Subsystem: metricsSubsystem,
+			Name:      "gas_used",
+			Help:      "Gas used by the txs broadcasted to the chain.",
+		}, chainLabels),
+	}
+}
+
+// NopMetrics returns no-op Metrics
+func NopMetrics() *Metrics {
+	return &Metrics{
+		PacketsRelayed:          discard.NewCounter(),
+		AcknowledgementsRelayed: discard.NewCounter(),
+		TimeoutsRelayed:         discard.NewCounter(),
+		PendingPackets:          discard.NewGauge(),
+		Errors:                  discard.NewCounter(),
+		TxsBroadcasted:          discard.NewCounter(),
+		GasUsed:                 discard.NewCounter(),
+	}
+}
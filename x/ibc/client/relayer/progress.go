@@ -0,0 +1,69 @@
+package relayer
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/wire"
+
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+// Progress of the relayer on each path, persisted in a database so that it
+// resumes where it stopped after a restart
+type Progress struct {
+	cdc *wire.Codec
+	db  dbm.DB
+}
+
+// NewProgress returns the progress stored in the database
+func NewProgress(cdc *wire.Codec, db dbm.DB) Progress {
+	return Progress{
+		cdc: cdc,
+		db:  db,
+	}
+}
+
+// GetRelayed returns the number of packets relayed along the path
+func (p Progress) GetRelayed(path Path) int64 {
+	return p.get(relayedKey(path))
+}
+
+// SetRelayed sets the number of packets relayed along the path
+func (p Progress) SetRelayed(path Path, relayed int64) {
+	p.set(relayedKey(path), relayed)
+}
+
+// GetAcknowledged returns the sequence of the first packet sent along the
+// path which is neither acknowledged nor timed out on the source chain
+func (p Progress) GetAcknowledged(path Path) int64 {
+	return p.get(acknowledgedKey(path))
+}
+
+// SetAcknowledged sets the sequence of the first packet sent along the path
+// which is neither acknowledged nor timed out on the source chain
+func (p Progress) SetAcknowledged(path Path, acknowledged int64) {
+	p.set(acknowledgedKey(path), acknowledged)
+}
+
+func (p Progress) get(key []byte) int64 {
+	bz := p.db.Get(key)
+	if bz == nil {
+		return 0
+	}
+
+	var value int64
+	p.cdc.MustUnmarshalBinary(bz, &value)
+	return value
+}
+
+func (p Progress) set(key []byte, value int64) {
+	p.db.SetSync(key, p.cdc.MustMarshalBinary(value))
+}
+
+func relayedKey(path Path) []byte {
+	return []byte(fmt.Sprintf("relayed/%s/%s", path.Src, path.Dest))
+}
+
+func acknowledgedKey(path Path) []byte {
+	return []byte(fmt.Sprintf("acknowledged/%s/%s", path.Src, path.Dest))
+}
@@ -0,0 +1,517 @@
+package relayer
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/ibc"
+
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// store names of the modules the relayer queries
+const (
+	ibcStore = "ibc"
+	accStore = "acc"
+)
+
+// gas limit of the txs simulated to estimate their gas
+const simulationGas = 10000000
+
+// Relayer relays the packets along the configured paths, and their
+// acknowledgements or timeouts back to the source chains
+type Relayer struct {
+	cdc      *wire.Codec
+	chains   map[string]Chain
+	fees     map[string]sdk.Coins
+	paths    []Path
+	signer   Signer
+	decoder  auth.AccountDecoder
+	progress Progress
+	metrics  *Metrics
+	logger   log.Logger
+
+	pollInterval  time.Duration
+	minBackoff    time.Duration
+	maxBackoff    time.Duration
+	maxMsgsPerTx  int
+	gasAdjustment float64
+
+	// accounts of the relayer on each chain, loaded lazily and dropped after
+	// a failed tx to resynchronize the sequence
+	accounts map[string]*account
+	// retry state of each path
+	retries map[Path]*retry
+}
+
+type account struct {
+	number   int64
+	sequence int64
+}
+
+type retry struct {
+	failures int
+	next     time.Time
+}
+
+// NewRelayer returns a relayer along the paths of the configuration, tracking
+// its progress in the database
+func NewRelayer(cdc *wire.Codec, cfg Config, chains []Chain, signer Signer, decoder auth.AccountDecoder,
+	db dbm.DB, metrics *Metrics, logger log.Logger) (*Relayer, error) {
+
+	if err := cfg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	r := &Relayer{
+		cdc:      cdc,
+		chains:   make(map[string]Chain),
+		fees:     make(map[string]sdk.Coins),
+		paths:    cfg.Paths,
+		signer:   signer,
+		decoder:  decoder,
+		progress: NewProgress(cdc, db),
+		metrics:  metrics,
+		logger:   logger,
+
+		pollInterval:  cfg.PollInterval,
+		minBackoff:    cfg.MinBackoff,
+		maxBackoff:    cfg.MaxBackoff,
+		maxMsgsPerTx:  cfg.MaxMsgsPerTx,
+		gasAdjustment: cfg.GasAdjustment,
+
+		accounts: make(map[string]*account),
+		retries:  make(map[Path]*retry),
+	}
+
+	for _, chain := range chains {
+		r.chains[chain.ChainID()] = chain
+	}
+	for _, chain := range cfg.Chains {
+		if _, ok := r.chains[chain.ChainID]; !ok {
+			return nil, fmt.Errorf("no connection to chain %s", chain.ChainID)
+		}
+		if chain.Fee != "" {
+			fee, err := sdk.ParseCoin(chain.Fee)
+			if err != nil {
+				return nil, err
+			}
+			r.fees[chain.ChainID] = sdk.Coins{fee}
+		}
+	}
+	for _, path := range cfg.Paths {
+		r.retries[path] = &retry{}
+	}
+
+	return r, nil
+}
+
+// Run relays along the paths every poll interval until quit is closed,
+// backing off the paths which fail
+func (r *Relayer) Run(quit <-chan struct{}) {
+	ticker := time.NewTicker(r.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		r.relayPaths(time.Now())
+
+		select {
+		case <-quit:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// relay along the paths which are not backing off
+func (r *Relayer) relayPaths(now time.Time) {
+	for _, path := range r.paths {
+		retry := r.retries[path]
+		if now.Before(retry.next) {
+			continue
+		}
+
+		err := r.RelayPath(path)
+		if err == nil {
+			retry.failures = 0
+			continue
+		}
+
+		retry.failures++
+		backoff := r.backoff(retry.failures)
+		retry.next = now.Add(backoff)
+
+		r.metrics.Errors.With("src_chain", path.Src, "dest_chain", path.Dest).Add(1)
+		r.logger.Error("Error relaying", "path", path, "err", err, "retry_in", backoff)
+	}
+}
+
+// backoff after the given number of consecutive failures, doubling up to the
+// maximum backoff
+func (r *Relayer) backoff(failures int) time.Duration {
+	backoff := r.minBackoff
+	for i := 1; i < failures && backoff < r.maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > r.maxBackoff {
+		return r.maxBackoff
+	}
+	return backoff
+}
+
+// RelayPath relays once the pending packets along the path, then the
+// acknowledgements and the timeouts of the packets back to the source chain
+func (r *Relayer) RelayPath(path Path) error {
+	src, ok := r.chains[path.Src]
+	if !ok {
+		return fmt.Errorf("unknown chain %s", path.Src)
+	}
+	dest, ok := r.chains[path.Dest]
+	if !ok {
+		return fmt.Errorf("unknown chain %s", path.Dest)
+	}
+
+	if err := r.relayPackets(path, src, dest); err != nil {
+		return err
+	}
+	return r.relayAcknowledgements(path, src, dest)
+}
+
+// relay the packets posted on the source chain which the destination chain
+// did not process yet
+func (r *Relayer) relayPackets(path Path, src, dest Chain) error {
+	processed, err := r.queryInt64(dest, ibc.IngressSequenceKey(src.ChainID()), 0)
+	if err != nil {
+		return err
+	}
+
+	egressLength, err := r.queryInt64(src, ibc.EgressLengthKey(dest.ChainID()), 0)
+	if err != nil {
+		return err
+	}
+
+	r.metrics.PendingPackets.With("src_chain", path.Src, "dest_chain", path.Dest).Set(float64(egressLength - processed))
+	if egressLength <= processed {
+		return nil
+	}
+
+	// the packets are proven against a header of the source chain tracked by
+	// the light client on the destination chain
+	updateMsg, proofHeight, err := r.updateClient(src, dest)
+	if err != nil {
+		return err
+	}
+
+	// the state committed by the header is the state of the previous height
+	length, err := r.queryInt64(src, ibc.EgressLengthKey(dest.ChainID()), proofHeight-1)
+	if err != nil {
+		return err
+	}
+
+	relayer, err := r.address(dest)
+	if err != nil {
+		return err
+	}
+
+	var msgs []sdk.Msg
+	for i := processed; i < length; i++ {
+		packetbz, proof, err := src.QueryStore(ibcStore, ibc.EgressKey(dest.ChainID(), i), proofHeight-1)
+		if err != nil {
+			return err
+		}
+		if packetbz == nil {
+			return fmt.Errorf("packet %d to %s not found on %s", i, dest.ChainID(), src.ChainID())
+		}
+
+		var packet ibc.IBCPacket
+		if err = r.cdc.UnmarshalBinary(packetbz, &packet); err != nil {
+			return err
+		}
+
+		msgs = append(msgs, ibc.IBCReceiveMsg{
+			IBCPacket:   packet,
+			Relayer:     relayer,
+			Sequence:    i,
+			Proof:       proof,
+			ProofHeight: proofHeight,
+		})
+	}
+
+	if len(msgs) == 0 {
+		return nil
+	}
+	if err = r.sendMsgs(dest, prepend(updateMsg, msgs)); err != nil {
+		return err
+	}
+
+	r.progress.SetRelayed(path, length)
+	r.metrics.PacketsRelayed.With("src_chain", path.Src, "dest_chain", path.Dest).Add(float64(len(msgs)))
+	r.logger.Info("Relayed IBC packets", "path", path, "from", processed, "to", length-1)
+	return nil
+}
+
+// relay back to the source chain the acknowledgements of the packets written
+// by the destination chain, or the proofs they timed out, so that the source
+// chain refunds the packets which weren't processed
+func (r *Relayer) relayAcknowledgements(path Path, src, dest Chain) error {
+	acknowledged := r.progress.GetAcknowledged(path)
+
+	egressLength, err := r.queryInt64(src, ibc.EgressLengthKey(dest.ChainID()), 0)
+	if err != nil {
+		return err
+	}
+	if acknowledged >= egressLength {
+		return nil
+	}
+
+	// the acknowledgements are proven against a header of the destination
+	// chain tracked by the light client on the source chain
+	updateMsg, proofHeight, err := r.updateClient(dest, src)
+	if err != nil {
+		return err
+	}
+
+	relayer, err := r.address(src)
+	if err != nil {
+		return err
+	}
+
+	var msgs []sdk.Msg
+	var acks, timeouts int
+
+	next := acknowledged
+LOOP:
+	for ; next < egressLength; next++ {
+		packetbz, _, err := src.QueryStore(ibcStore, ibc.EgressKey(dest.ChainID(), next), 0)
+		if err != nil {
+			return err
+		}
+		if packetbz == nil {
+			// already acknowledged or timed out
+			continue
+		}
+
+		var packet ibc.IBCPacket
+		if err = r.cdc.UnmarshalBinary(packetbz, &packet); err != nil {
+			return err
+		}
+
+		ackbz, proof, err := dest.QueryStore(ibcStore, ibc.AcknowledgementKey(src.ChainID(), next), proofHeight-1)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case ackbz != nil:
+			var ack ibc.Acknowledgement
+			if err = r.cdc.UnmarshalBinary(ackbz, &ack); err != nil {
+				return err
+			}
+			msgs = append(msgs, ibc.NewMsgAcknowledgement(relayer, packet, next, ack, proof, proofHeight))
+			acks++
+		case packet.TimeoutHeight != 0 && proofHeight >= packet.TimeoutHeight:
+			msgs = append(msgs, ibc.NewMsgTimeout(relayer, packet, next, proof, proofHeight))
+			timeouts++
+		default:
+			// the packets are processed in order, so the next ones are not
+			// acknowledged either
+			break LOOP
+		}
+	}
+
+	if len(msgs) > 0 {
+		if err = r.sendMsgs(src, prepend(updateMsg, msgs)); err != nil {
+			return err
+		}
+	}
+
+	r.progress.SetAcknowledged(path, next)
+	r.metrics.AcknowledgementsRelayed.With("src_chain", path.Src, "dest_chain", path.Dest).Add(float64(acks))
+	r.metrics.TimeoutsRelayed.With("src_chain", path.Src, "dest_chain", path.Dest).Add(float64(timeouts))
+	if len(msgs) > 0 {
+		r.logger.Info("Relayed IBC acknowledgements", "path", path, "acknowledged", acks, "timed_out", timeouts)
+	}
+	return nil
+}
+
+// Returns the msg creating or updating the light client of the tracked chain
+// on the tracking chain with the latest header of the tracked chain, or nil
+// if the light client is already up to date, along with the height of the
+// header the light client tracks once the msg is delivered.
+func (r *Relayer) updateClient(tracked, tracking Chain) (sdk.Msg, int64, error) {
+	clientbz, _, err := tracking.QueryStore(ibcStore, ibc.ClientKey(tracked.ChainID()), 0)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var cs ibc.ConsensusState
+	if clientbz != nil {
+		if err = r.cdc.UnmarshalBinary(clientbz, &cs); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	height, err := tracked.LatestHeight()
+	if err != nil {
+		return nil, 0, err
+	}
+	if clientbz != nil && cs.Height >= height {
+		return nil, cs.Height, nil
+	}
+
+	header, commit, valset, err := tracked.SignedHeader(height)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	signer, err := r.address(tracking)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if clientbz == nil {
+		return ibc.NewMsgCreateClient(signer, header, valset), height, nil
+	}
+	return ibc.NewMsgUpdateClient(signer, header, commit, valset), height, nil
+}
+
+// send the msgs to the chain in batches of at most the maximum number of msgs
+// per tx
+func (r *Relayer) sendMsgs(chain Chain, msgs []sdk.Msg) error {
+	for start := 0; start < len(msgs); start += r.maxMsgsPerTx {
+		end := start + r.maxMsgsPerTx
+		if end > len(msgs) {
+			end = len(msgs)
+		}
+
+		if err := r.broadcast(chain, msgs[start:end]); err != nil {
+			// the sequence of the account is reloaded from the chain
+			delete(r.accounts, chain.ChainID())
+			return err
+		}
+	}
+	return nil
+}
+
+// sign and broadcast a tx of the msgs, with the gas estimated by simulating
+// the tx
+func (r *Relayer) broadcast(chain Chain, msgs []sdk.Msg) error {
+	acc, err := r.account(chain)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.sign(chain, acc, msgs, simulationGas)
+	if err != nil {
+		return err
+	}
+
+	res, err := chain.Simulate(tx)
+	if err != nil {
+		return err
+	}
+	if !res.IsOK() {
+		return fmt.Errorf("simulation failed: (%d) %s", res.Code, res.Log)
+	}
+
+	gas := int64(math.Ceil(float64(res.GasUsed) * r.gasAdjustment))
+	tx, err = r.sign(chain, acc, msgs, gas)
+	if err != nil {
+		return err
+	}
+
+	if err = chain.BroadcastTx(tx); err != nil {
+		return err
+	}
+	acc.sequence++
+
+	r.metrics.TxsBroadcasted.With("chain_id", chain.ChainID()).Add(1)
+	r.metrics.GasUsed.With("chain_id", chain.ChainID()).Add(float64(res.GasUsed))
+	return nil
+}
+
+// sign a tx of the msgs with the account of the relayer on the chain
+func (r *Relayer) sign(chain Chain, acc *account, msgs []sdk.Msg, gas int64) ([]byte, error) {
+	msg := auth.StdSignMsg{
+		ChainID:       chain.ChainID(),
+		AccountNumber: acc.number,
+		Sequence:      acc.sequence,
+		Fee:           auth.StdFee{Amount: r.fees[chain.ChainID()], Gas: gas},
+		Msgs:          msgs,
+	}
+
+	sig, err := r.signer.Sign(chain.ChainID(), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.cdc.MarshalBinary(auth.NewStdTx(msg.Msgs, msg.Fee, []auth.StdSignature{sig}, msg.Memo, msg.TimeoutHeight))
+}
+
+// address of the relayer on the chain
+func (r *Relayer) address(chain Chain) (sdk.AccAddress, error) {
+	return r.signer.GetAddress(chain.ChainID())
+}
+
+// account of the relayer on the chain, loaded from the chain if it isn't
+// tracked yet
+func (r *Relayer) account(chain Chain) (*account, error) {
+	if acc, ok := r.accounts[chain.ChainID()]; ok {
+		return acc, nil
+	}
+
+	address, err := r.address(chain)
+	if err != nil {
+		return nil, err
+	}
+
+	accbz, _, err := chain.QueryStore(accStore, auth.AddressStoreKey(address), 0)
+	if err != nil {
+		return nil, err
+	}
+	if accbz == nil {
+		return nil, fmt.Errorf("account %s of the relayer does not exist on %s", address, chain.ChainID())
+	}
+
+	decoded, err := r.decoder(accbz)
+	if err != nil {
+		return nil, err
+	}
+
+	acc := &account{
+		number:   decoded.GetAccountNumber(),
+		sequence: decoded.GetSequence(),
+	}
+	r.accounts[chain.ChainID()] = acc
+	return acc, nil
+}
+
+// query an int64 of the IBC store of the chain, zero if it isn't set
+func (r *Relayer) queryInt64(chain Chain, key []byte, height int64) (int64, error) {
+	bz, _, err := chain.QueryStore(ibcStore, key, height)
+	if err != nil {
+		return 0, err
+	}
+	if bz == nil {
+		return 0, nil
+	}
+
+	var value int64
+	if err = r.cdc.UnmarshalBinary(bz, &value); err != nil {
+		return 0, err
+	}
+	return value, nil
+}
+
+// prepend the msg to the msgs unless it is nil
+func prepend(msg sdk.Msg, msgs []sdk.Msg) []sdk.Msg {
+	if msg == nil {
+		return msgs
+	}
+	return append([]sdk.Msg{msg}, msgs...)
+}
@@ -0,0 +1,464 @@
+package relayer
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/ibc"
+	"github.com/cosmos/cosmos-sdk/x/mock"
+	"github.com/cosmos/cosmos-sdk/x/params"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+func makeCodec() *wire.Codec {
+	cdc := wire.NewCodec()
+	sdk.RegisterWire(cdc)
+	wire.RegisterCrypto(cdc)
+	auth.RegisterWire(cdc)
+	ibc.RegisterWire(cdc)
+	return cdc
+}
+
+// chain running an in-process mock app with the IBC module, whose blocks are
+// signed by a single validator
+type mockChain struct {
+	mtx sync.Mutex
+
+	chainID string
+	app     *mock.App
+	ibcm    ibc.Mapper
+	priv    ed25519.PrivKeyEd25519
+	valset  *tmtypes.ValidatorSet
+
+	height    int64
+	appHashes map[int64][]byte
+}
+
+var _ Chain = (*mockChain)(nil)
+
+func newMockChain(t *testing.T, chainID string, accs []auth.Account, clientCreators []sdk.AccAddress) *mockChain {
+	mapp := mock.NewApp()
+
+	ibc.RegisterWire(mapp.Cdc)
+	keyIBC := sdk.NewKVStoreKey("ibc")
+	keyBank := sdk.NewKVStoreKey("bank")
+	keyParams := sdk.NewKVStoreKey("params")
+	ibcm := ibc.NewMapper(mapp.Cdc, keyIBC, mapp.RegisterCodespace(ibc.DefaultCodespace))
+	paramsKeeper := params.NewKeeper(mapp.Cdc, keyParams)
+	coinKeeper := bank.NewKeeper(mapp.Cdc, keyBank, mapp.AccountMapper, paramsKeeper.Setter(), mapp.RegisterCodespace(bank.DefaultCodespace))
+	ibcm.AddRoute(ibc.PortTransfer, ibc.NewTransferHandler(coinKeeper))
+	mapp.Router().AddRoute("ibc", ibc.NewHandler(ibcm, coinKeeper))
+
+	mapp.SetInitChainer(func(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
+		mapp.InitChainer(ctx, req)
+		require.Nil(t, bank.InitGenesis(ctx, coinKeeper, bank.DefaultGenesisState()))
+		require.Nil(t, ibc.InitGenesis(ctx, ibcm, ibc.NewGenesisState(clientCreators)))
+		return abci.ResponseInitChain{}
+	})
+	require.Nil(t, mapp.CompleteSetup([]*sdk.KVStoreKey{keyIBC, keyBank, keyParams}))
+
+	mapp.GenesisAccounts = accs
+	mapp.InitChain(abci.RequestInitChain{ChainId: chainID})
+	res := mapp.Commit()
+
+	priv := ed25519.GenPrivKey()
+	return &mockChain{
+		chainID:   chainID,
+		app:       mapp,
+		ibcm:      ibcm,
+		priv:      priv,
+		valset:    tmtypes.NewValidatorSet([]*tmtypes.Validator{tmtypes.NewValidator(priv.PubKey(), 10)}),
+		height:    1,
+		appHashes: map[int64][]byte{1: res.Data},
+	}
+}
+
+func (c *mockChain) ChainID() string {
+	return c.chainID
+}
+
+// the chain produces an empty block each time it is polled
+func (c *mockChain) LatestHeight() (int64, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.commitBlock(nil)
+	return c.height, nil
+}
+
+func (c *mockChain) SignedHeader(height int64) (tmtypes.Header, tmtypes.Commit, *tmtypes.ValidatorSet, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if height < 2 || height > c.height {
+		return tmtypes.Header{}, tmtypes.Commit{}, nil, fmt.Errorf("no block at height %d", height)
+	}
+
+	header := tmtypes.Header{
+		ChainID:        c.chainID,
+		Height:         height,
+		Time:           time.Unix(height, 0).UTC(),
+		ValidatorsHash: c.valset.Hash(),
+		AppHash:        c.appHashes[height-1],
+	}
+	blockID := tmtypes.BlockID{Hash: header.Hash()}
+
+	vote := &tmtypes.Vote{
+		ValidatorAddress: c.priv.PubKey().Address(),
+		ValidatorIndex:   0,
+		Height:           height,
+		Round:            0,
+		Timestamp:        header.Time,
+		Type:             tmtypes.VoteTypePrecommit,
+		BlockID:          blockID,
+	}
+	sig, err := c.priv.Sign(vote.SignBytes(c.chainID))
+	if err != nil {
+		return tmtypes.Header{}, tmtypes.Commit{}, nil, err
+	}
+	vote.Signature = sig
+
+	return header, tmtypes.Commit{BlockID: blockID, Precommits: []*tmtypes.Vote{vote}}, c.valset, nil
+}
+
+func (c *mockChain) QueryStore(storeName string, key []byte, height int64) ([]byte, []byte, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	prove := height != 0
+	if height == 0 {
+		height = c.height
+	}
+
+	res := c.app.Query(abci.RequestQuery{
+		Path:   fmt.Sprintf("/store/%s/key", storeName),
+		Data:   key,
+		Height: height,
+		Prove:  prove,
+	})
+	if !res.IsOK() {
+		return nil, nil, fmt.Errorf("query failed: (%d) %s", res.Code, res.Log)
+	}
+	return res.Value, res.Proof, nil
+}
+
+func (c *mockChain) Simulate(tx []byte) (sdk.Result, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	res := c.app.Query(abci.RequestQuery{Path: "/app/simulate", Data: tx})
+	if !res.IsOK() {
+		return sdk.Result{}, fmt.Errorf("simulation failed: (%d) %s", res.Code, res.Log)
+	}
+
+	var result sdk.Result
+	if err := wire.Cdc.UnmarshalBinary(res.Value, &result); err != nil {
+		return sdk.Result{}, err
+	}
+	return result, nil
+}
+
+func (c *mockChain) BroadcastTx(tx []byte) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if res := c.app.CheckTx(tx); !res.IsOK() {
+		return fmt.Errorf("checkTx failed: (%d) %s", res.Code, res.Log)
+	}
+	if res := c.commitBlock(tx); !res.IsOK() {
+		return fmt.Errorf("deliverTx failed: (%d) %s", res.Code, res.Log)
+	}
+	return nil
+}
+
+// commit a block holding the tx, if any
+func (c *mockChain) commitBlock(tx []byte) (res abci.ResponseDeliverTx) {
+	c.app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{ChainID: c.chainID, Height: c.height + 1}})
+	if tx != nil {
+		res = c.app.DeliverTx(tx)
+	}
+	c.app.EndBlock(abci.RequestEndBlock{})
+	commit := c.app.Commit()
+
+	c.height++
+	c.appHashes[c.height] = commit.Data
+	return res
+}
+
+// sign and commit a tx of the msgs, failing the test if the tx fails
+func (c *mockChain) deliver(t *testing.T, cdc *wire.Codec, priv crypto.PrivKey, accnum, seq int64, msgs ...sdk.Msg) {
+	fee := auth.NewStdFee(100000)
+	sig, err := priv.Sign(auth.StdSignBytes(c.chainID, accnum, seq, fee, msgs, "", 0))
+	require.Nil(t, err)
+
+	sigs := []auth.StdSignature{{PubKey: priv.PubKey(), Signature: sig, AccountNumber: accnum, Sequence: seq}}
+	tx, err := cdc.MarshalBinary(auth.NewStdTx(msgs, fee, sigs, "", 0))
+	require.Nil(t, err)
+	require.Nil(t, c.BroadcastTx(tx))
+}
+
+func (c *mockChain) hasEgressPacket(destChain string, seq int64) bool {
+	ctx := c.app.BaseApp.NewContext(true, abci.Header{})
+	_, found := c.ibcm.GetEgressPacket(ctx, destChain, seq)
+	return found
+}
+
+// signer using the same key on every chain
+type privKeySigner struct {
+	priv crypto.PrivKey
+}
+
+func (s privKeySigner) GetAddress(chainID string) (sdk.AccAddress, error) {
+	return sdk.AccAddress(s.priv.PubKey().Address()), nil
+}
+
+func (s privKeySigner) Sign(chainID string, msg auth.StdSignMsg) (auth.StdSignature, error) {
+	sig, err := s.priv.Sign(msg.Bytes())
+	if err != nil {
+		return auth.StdSignature{}, err
+	}
+	return auth.StdSignature{
+		AccountNumber: msg.AccountNumber,
+		Sequence:      msg.Sequence,
+		PubKey:        s.priv.PubKey(),
+		Signature:     sig,
+	}, nil
+}
+
+func accountDecoder(cdc *wire.Codec) auth.AccountDecoder {
+	return func(bz []byte) (acc auth.Account, err error) {
+		err = cdc.UnmarshalBinaryBare(bz, &acc)
+		return acc, err
+	}
+}
+
+func testConfig(paths ...Path) Config {
+	cfg := DefaultConfig()
+	cfg.MaxMsgsPerTx = 2
+	cfg.MinBackoff = time.Second
+	cfg.MaxBackoff = 5 * time.Second
+	cfg.Chains = []ChainConfig{
+		{ChainID: "chain-a", Node: "tcp://localhost:26657", Key: "relayer"},
+		{ChainID: "chain-b", Node: "tcp://localhost:36657", Key: "relayer"},
+	}
+	cfg.Paths = paths
+	return cfg
+}
+
+func TestRelayer(t *testing.T) {
+	cdc := makeCodec()
+
+	relayerPriv := ed25519.GenPrivKey()
+	relayerAddr := sdk.AccAddress(relayerPriv.PubKey().Address())
+	userPriv := ed25519.GenPrivKey()
+	userAddr := sdk.AccAddress(userPriv.PubKey().Address())
+
+	atoms := func(amount int64) sdk.Coins { return sdk.Coins{sdk.NewInt64Coin("atom", amount)} }
+	vouchers := func(amount int64) sdk.Coins {
+		return sdk.Coins{sdk.NewInt64Coin(ibc.VoucherPrefix("chain-a")+"atom", amount)}
+	}
+
+	// the relayer and the user hold accounts on both chains, on which the
+	// relayer may create the light clients
+	chainA := newMockChain(t, "chain-a", []auth.Account{
+		&auth.BaseAccount{Address: relayerAddr, Coins: atoms(1)},
+		&auth.BaseAccount{Address: userAddr, Coins: atoms(100)},
+	}, []sdk.AccAddress{relayerAddr})
+	chainB := newMockChain(t, "chain-b", []auth.Account{
+		&auth.BaseAccount{Address: relayerAddr, Coins: atoms(1)},
+		&auth.BaseAccount{Address: userAddr, Coins: atoms(1)},
+	}, []sdk.AccAddress{relayerAddr})
+
+	pathAB := Path{Src: "chain-a", Dest: "chain-b"}
+	pathBA := Path{Src: "chain-b", Dest: "chain-a"}
+	cfg := testConfig(pathAB, pathBA)
+
+	db := dbm.NewMemDB()
+	newRelayer := func() *Relayer {
+		r, err := NewRelayer(cdc, cfg, []Chain{chainA, chainB}, privKeySigner{relayerPriv},
+			accountDecoder(cdc), db, NopMetrics(), log.NewNopLogger())
+		require.Nil(t, err)
+		return r
+	}
+	r := newRelayer()
+
+	// nothing to relay yet
+	require.Nil(t, r.RelayPath(pathAB))
+	require.Equal(t, int64(0), r.progress.GetRelayed(pathAB))
+
+	// the transfers are relayed in batches and acknowledged back
+	for seq := int64(0); seq < 3; seq++ {
+		chainA.deliver(t, cdc, userPriv, 1, seq, ibc.NewIBCTransferMsg(userAddr, userAddr, atoms(10), "chain-b", 0))
+	}
+	require.Nil(t, r.RelayPath(pathAB))
+
+	mock.CheckBalance(t, chainA.app, userAddr, atoms(70))
+	mock.CheckBalance(t, chainA.app, ibc.EscrowAddress("chain-b"), atoms(30))
+	mock.CheckBalance(t, chainB.app, userAddr, atoms(1).Plus(vouchers(30)))
+	for seq := int64(0); seq < 3; seq++ {
+		require.False(t, chainA.hasEgressPacket("chain-b", seq))
+	}
+	require.Equal(t, int64(3), r.progress.GetRelayed(pathAB))
+	require.Equal(t, int64(3), r.progress.GetAcknowledged(pathAB))
+
+	// a transfer which timed out on the destination chain is refunded
+	timeoutHeight := chainB.height + 1
+	chainA.deliver(t, cdc, userPriv, 1, 3, ibc.NewIBCTransferMsg(userAddr, userAddr, atoms(5), "chain-b", timeoutHeight))
+	mock.CheckBalance(t, chainA.app, userAddr, atoms(65))
+	_, err := chainB.LatestHeight()
+	require.Nil(t, err)
+	require.Nil(t, r.RelayPath(pathAB))
+
+	mock.CheckBalance(t, chainA.app, userAddr, atoms(70))
+	mock.CheckBalance(t, chainA.app, ibc.EscrowAddress("chain-b"), atoms(30))
+	mock.CheckBalance(t, chainB.app, userAddr, atoms(1).Plus(vouchers(30)))
+	require.False(t, chainA.hasEgressPacket("chain-b", 3))
+	require.Equal(t, int64(4), r.progress.GetAcknowledged(pathAB))
+
+	// the vouchers are sent back along the reverse path
+	chainB.deliver(t, cdc, userPriv, 1, 0, ibc.NewIBCTransferMsg(userAddr, userAddr, vouchers(10), "chain-a", 0))
+	require.Nil(t, r.RelayPath(pathBA))
+
+	mock.CheckBalance(t, chainA.app, userAddr, atoms(80))
+	mock.CheckBalance(t, chainA.app, ibc.EscrowAddress("chain-b"), atoms(20))
+	mock.CheckBalance(t, chainB.app, userAddr, atoms(1).Plus(vouchers(20)))
+	require.False(t, chainB.hasEgressPacket("chain-a", 0))
+	require.Equal(t, int64(1), r.progress.GetAcknowledged(pathBA))
+
+	// a restarted relayer resumes from its progress, loading the sequence of
+	// its account from the chains
+	r = newRelayer()
+	require.Equal(t, int64(4), r.progress.GetAcknowledged(pathAB))
+	require.Equal(t, int64(1), r.progress.GetAcknowledged(pathBA))
+
+	chainA.deliver(t, cdc, userPriv, 1, 4, ibc.NewIBCTransferMsg(userAddr, userAddr, atoms(10), "chain-b", 0))
+	require.Nil(t, r.RelayPath(pathAB))
+	mock.CheckBalance(t, chainB.app, userAddr, atoms(1).Plus(vouchers(30)))
+	require.Equal(t, int64(5), r.progress.GetAcknowledged(pathAB))
+}
+
+// chain whose node is unreachable
+type unreachableChain struct {
+	chainID string
+}
+
+func (c unreachableChain) ChainID() string { return c.chainID }
+func (c unreachableChain) LatestHeight() (int64, error) {
+	return 0, fmt.Errorf("unreachable")
+}
+func (c unreachableChain) SignedHeader(int64) (tmtypes.Header, tmtypes.Commit, *tmtypes.ValidatorSet, error) {
+	return tmtypes.Header{}, tmtypes.Commit{}, nil, fmt.Errorf("unreachable")
+}
+func (c unreachableChain) QueryStore(string, []byte, int64) ([]byte, []byte, error) {
+	return nil, nil, fmt.Errorf("unreachable")
+}
+func (c unreachableChain) Simulate([]byte) (sdk.Result, error) {
+	return sdk.Result{}, fmt.Errorf("unreachable")
+}
+func (c unreachableChain) BroadcastTx([]byte) error { return fmt.Errorf("unreachable") }
+
+func TestRelayerBackoff(t *testing.T) {
+	cdc := makeCodec()
+	path := Path{Src: "chain-a", Dest: "chain-b"}
+	chains := []Chain{unreachableChain{"chain-a"}, unreachableChain{"chain-b"}}
+
+	r, err := NewRelayer(cdc, testConfig(path), chains, privKeySigner{ed25519.GenPrivKey()},
+		accountDecoder(cdc), dbm.NewMemDB(), NopMetrics(), log.NewNopLogger())
+	require.Nil(t, err)
+
+	now := time.Unix(0, 0)
+	for _, backoff := range []time.Duration{1, 2, 4, 5, 5} {
+		r.relayPaths(now)
+		require.Equal(t, now.Add(backoff*time.Second), r.retries[path].next)
+
+		// the path is not retried before the end of the backoff
+		r.relayPaths(now.Add(backoff*time.Second - 1))
+		require.Equal(t, now.Add(backoff*time.Second), r.retries[path].next)
+
+		now = now.Add(backoff * time.Second)
+	}
+	require.Equal(t, 5, r.retries[path].failures)
+
+	// the connections to the chains of the configuration are required
+	_, err = NewRelayer(cdc, testConfig(path), chains[:1], privKeySigner{ed25519.GenPrivKey()},
+		accountDecoder(cdc), dbm.NewMemDB(), NopMetrics(), log.NewNopLogger())
+	require.NotNil(t, err)
+}
+
+func TestLoadConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "relayer")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "relayer.toml")
+	err = ioutil.WriteFile(file, []byte(`
+db_dir = "/tmp/relayer"
+poll_interval = "1s"
+max_msgs_per_tx = 10
+
+[[chains]]
+chain_id = "chain-a"
+node = "tcp://localhost:26657"
+key = "relayer-a"
+fee = "1atom"
+
+[[chains]]
+chain_id = "chain-b"
+node = "tcp://localhost:36657"
+key = "relayer-b"
+
+[[paths]]
+src = "chain-a"
+dest = "chain-b"
+`), 0600)
+	require.Nil(t, err)
+
+	cfg, err := LoadConfig(file)
+	require.Nil(t, err)
+	require.Equal(t, "/tmp/relayer", cfg.DBDir)
+	require.Equal(t, time.Second, cfg.PollInterval)
+	require.Equal(t, 10, cfg.MaxMsgsPerTx)
+	require.Equal(t, DefaultConfig().GasAdjustment, cfg.GasAdjustment)
+	require.Equal(t, []ChainConfig{
+		{ChainID: "chain-a", Node: "tcp://localhost:26657", Key: "relayer-a", Fee: "1atom"},
+		{ChainID: "chain-b", Node: "tcp://localhost:36657", Key: "relayer-b"},
+	}, cfg.Chains)
+	require.Equal(t, []Path{{Src: "chain-a", Dest: "chain-b"}}, cfg.Paths)
+
+	cases := []struct {
+		modify func(*Config)
+	}{
+		{func(cfg *Config) { cfg.Paths = nil }},
+		{func(cfg *Config) { cfg.Paths = append(cfg.Paths, Path{Src: "chain-a", Dest: "chain-c"}) }},
+		{func(cfg *Config) { cfg.Paths = append(cfg.Paths, Path{Src: "chain-a", Dest: "chain-a"}) }},
+		{func(cfg *Config) { cfg.Paths = append(cfg.Paths, cfg.Paths[0]) }},
+		{func(cfg *Config) { cfg.Chains = append(cfg.Chains, cfg.Chains[0]) }},
+		{func(cfg *Config) { cfg.Chains[1].Fee = "1" }},
+		{func(cfg *Config) { cfg.MaxMsgsPerTx = 0 }},
+		{func(cfg *Config) { cfg.GasAdjustment = 0.5 }},
+		{func(cfg *Config) { cfg.MaxBackoff = cfg.MinBackoff - 1 }},
+	}
+
+	for i, tc := range cases {
+		invalid := testConfig(Path{Src: "chain-a", Dest: "chain-b"})
+		tc.modify(&invalid)
+		require.NotNil(t, invalid.ValidateBasic(), "case %d", i)
+	}
+}
@@ -0,0 +1,67 @@
+package relayer
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// Signer signs the txs of the relayer on each chain
+type Signer interface {
+	// address of the account of the relayer on the chain
+	GetAddress(chainID string) (sdk.AccAddress, error)
+
+	// sign the msg with the account of the relayer on the chain
+	Sign(chainID string, msg auth.StdSignMsg) (auth.StdSignature, error)
+}
+
+// signer backed by the keys of a keybase, each chain being assigned a key
+type keybaseSigner struct {
+	kb          keys.Keybase
+	keys        map[string]string
+	passphrases map[string]string
+}
+
+// NewKeybaseSigner returns a signer using on each chain the key of the
+// keybase it is assigned, unlocked with the passphrase of the key
+func NewKeybaseSigner(kb keys.Keybase, chainKeys map[string]string, passphrases map[string]string) Signer {
+	return keybaseSigner{
+		kb:          kb,
+		keys:        chainKeys,
+		passphrases: passphrases,
+	}
+}
+
+func (s keybaseSigner) GetAddress(chainID string) (sdk.AccAddress, error) {
+	name, ok := s.keys[chainID]
+	if !ok {
+		return nil, fmt.Errorf("no key assigned to chain %s", chainID)
+	}
+
+	info, err := s.kb.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.AccAddress(info.GetPubKey().Address()), nil
+}
+
+func (s keybaseSigner) Sign(chainID string, msg auth.StdSignMsg) (auth.StdSignature, error) {
+	name, ok := s.keys[chainID]
+	if !ok {
+		return auth.StdSignature{}, fmt.Errorf("no key assigned to chain %s", chainID)
+	}
+
+	sig, pubkey, err := s.kb.Sign(name, s.passphrases[name], msg.Bytes())
+	if err != nil {
+		return auth.StdSignature{}, err
+	}
+
+	return auth.StdSignature{
+		AccountNumber: msg.AccountNumber,
+		Sequence:      msg.Sequence,
+		PubKey:        pubkey,
+		Signature:     sig,
+	}, nil
+}
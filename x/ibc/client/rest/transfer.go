@@ -5,6 +5,8 @@ import (
 	"net/http"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/routes"
+	"github.com/cosmos/cosmos-sdk/client/utils"
 	"github.com/cosmos/cosmos-sdk/crypto/keys"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
@@ -15,11 +17,20 @@ import (
 )
 
 // RegisterRoutes - Central function to define routes that get registered by the main application
-func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *wire.Codec, kb keys.Keybase) {
-	r.HandleFunc("/ibc/{destchain}/{address}/send", TransferRequestHandlerFn(cdc, kb, cliCtx)).Methods("POST")
+func RegisterRoutes(cliCtx context.CLIContext, r *routes.Router, cdc *wire.Codec, kb keys.Keybase) {
+	r.Register(routes.Route{
+		Name:     "Transfer",
+		Method:   "POST",
+		Path:     "/ibc/{destchain}/{address}/send",
+		Summary:  "Transfer coins to an address of another chain",
+		Request:  &TransferBody{},
+		Response: utils.TxResponse(kb),
+		Handler:  TransferRequestHandlerFn(cdc, kb, cliCtx),
+	})
 }
 
-type transferBody struct {
+// TransferBody is the REST request body transferring coins over IBC
+type TransferBody struct {
 	// Fees             sdk.Coin  `json="fees"`
 	Amount           sdk.Coins `json:"amount"`
 	LocalAccountName string    `json:"name"`
@@ -28,6 +39,16 @@ type transferBody struct {
 	AccountNumber    int64     `json:"account_number"`
 	Sequence         int64     `json:"sequence"`
 	Gas              int64     `json:"gas"`
+	TimeoutHeight    int64     `json:"timeout_height"`
+	// height of the destination chain after which the transfer is refunded
+	PacketTimeoutHeight int64 `json:"packet_timeout_height"`
+	// bech32 address of the signer, used when the server holds no keys
+	From string `json:"from"`
+	// return the unsigned tx instead of signing and broadcasting it
+	GenerateOnly bool `json:"generate_only"`
+	// return the gas estimated by simulating the tx instead of broadcasting it
+	Simulate      bool   `json:"simulate"`
+	GasAdjustment string `json:"gas_adjustment"`
 }
 
 // TransferRequestHandler - http request handler to transfer coins to a address
@@ -45,7 +66,7 @@ func TransferRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, cliCtx context.C
 			return
 		}
 
-		var m transferBody
+		var m TransferBody
 		body, err := ioutil.ReadAll(r.Body)
 		if err != nil {
 			w.WriteHeader(http.StatusBadRequest)
@@ -60,7 +81,7 @@ func TransferRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, cliCtx context.C
 			return
 		}
 
-		info, err := kb.Get(m.LocalAccountName)
+		from, err := utils.GetRESTFromAddress(kb, m.LocalAccountName, m.From)
 		if err != nil {
 			w.WriteHeader(http.StatusUnauthorized)
 			w.Write([]byte(err.Error()))
@@ -68,8 +89,7 @@ func TransferRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, cliCtx context.C
 		}
 
 		// build message
-		packet := ibc.NewIBCPacket(sdk.AccAddress(info.GetPubKey().Address()), to, m.Amount, m.SrcChainID, destChainID)
-		msg := ibc.IBCTransferMsg{packet}
+		msg := ibc.NewIBCTransferMsg(from, to, m.Amount, destChainID, m.PacketTimeoutHeight)
 
 		txCtx := authctx.TxContext{
 			Codec:         cdc,
@@ -77,6 +97,23 @@ func TransferRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, cliCtx context.C
 			AccountNumber: m.AccountNumber,
 			Sequence:      m.Sequence,
 			Gas:           m.Gas,
+			TimeoutHeight: m.TimeoutHeight,
+		}
+
+		// a server holding no keys only returns the tx to sign
+		if kb == nil {
+			utils.WriteUnsignedTxResponse(w, txCtx, []sdk.Msg{msg})
+			return
+		}
+
+		if m.GenerateOnly {
+			utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{msg})
+			return
+		}
+
+		if m.Simulate {
+			utils.WriteSimulationResponse(w, txCtx, cliCtx, m.LocalAccountName, m.Password, m.GasAdjustment, []sdk.Msg{msg})
+			return
 		}
 
 		txBytes, err := txCtx.BuildAndSign(m.LocalAccountName, m.Password, []sdk.Msg{msg})
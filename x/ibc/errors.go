@@ -1,6 +1,8 @@
 package ibc
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -9,9 +11,18 @@ const (
 	DefaultCodespace sdk.CodespaceType = 3
 
 	// IBC errors reserve 200 - 299.
-	CodeInvalidSequence sdk.CodeType = 200
-	CodeIdenticalChains sdk.CodeType = 201
-	CodeUnknownRequest  sdk.CodeType = sdk.CodeUnknownRequest
+	CodeInvalidSequence  sdk.CodeType = 200
+	CodeIdenticalChains  sdk.CodeType = 201
+	CodeInvalidProof     sdk.CodeType = 202
+	CodeUnknownClient    sdk.CodeType = 203
+	CodeClientExists     sdk.CodeType = 204
+	CodeInvalidHeader    sdk.CodeType = 205
+	CodeUnauthorized     sdk.CodeType = 206
+	CodeInvalidDestChain sdk.CodeType = 207
+	CodeUnknownPacket    sdk.CodeType = 208
+	CodeInvalidTimeout   sdk.CodeType = 209
+	CodeUnknownPort      sdk.CodeType = 210
+	CodeUnknownRequest   sdk.CodeType = sdk.CodeUnknownRequest
 )
 
 func codeToDefaultMsg(code sdk.CodeType) string {
@@ -20,6 +31,24 @@ func codeToDefaultMsg(code sdk.CodeType) string {
 		return "invalid IBC packet sequence"
 	case CodeIdenticalChains:
 		return "source and destination chain cannot be identical"
+	case CodeInvalidProof:
+		return "invalid IBC packet proof"
+	case CodeUnknownClient:
+		return "no light client for the chain"
+	case CodeClientExists:
+		return "light client for the chain already exists"
+	case CodeInvalidHeader:
+		return "invalid header"
+	case CodeUnauthorized:
+		return "not allowed to create light clients"
+	case CodeInvalidDestChain:
+		return "IBC packet is not destined to this chain"
+	case CodeUnknownPacket:
+		return "no pending IBC packet"
+	case CodeInvalidTimeout:
+		return "invalid IBC packet timeout"
+	case CodeUnknownPort:
+		return "unknown IBC port"
 	default:
 		return sdk.CodeToDefaultMsg(code)
 	}
@@ -32,6 +61,33 @@ func ErrInvalidSequence(codespace sdk.CodespaceType) sdk.Error {
 func ErrIdenticalChains(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeIdenticalChains, "")
 }
+func ErrInvalidProof(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return newError(codespace, CodeInvalidProof, msg)
+}
+func ErrUnknownClient(codespace sdk.CodespaceType, chainID string) sdk.Error {
+	return newError(codespace, CodeUnknownClient, fmt.Sprintf("no light client for chain %s", chainID))
+}
+func ErrClientExists(codespace sdk.CodespaceType, chainID string) sdk.Error {
+	return newError(codespace, CodeClientExists, fmt.Sprintf("light client for chain %s already exists", chainID))
+}
+func ErrInvalidHeader(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return newError(codespace, CodeInvalidHeader, msg)
+}
+func ErrUnauthorizedClientCreator(codespace sdk.CodespaceType, addr sdk.AccAddress) sdk.Error {
+	return newError(codespace, CodeUnauthorized, fmt.Sprintf("%s is not allowed to create light clients", addr))
+}
+func ErrInvalidDestChain(codespace sdk.CodespaceType, chainID string) sdk.Error {
+	return newError(codespace, CodeInvalidDestChain, fmt.Sprintf("IBC packet is destined to chain %s", chainID))
+}
+func ErrUnknownPacket(codespace sdk.CodespaceType, destChain string, sequence int64) sdk.Error {
+	return newError(codespace, CodeUnknownPacket, fmt.Sprintf("no pending IBC packet %d to chain %s", sequence, destChain))
+}
+func ErrInvalidTimeout(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return newError(codespace, CodeInvalidTimeout, msg)
+}
+func ErrUnknownPort(codespace sdk.CodespaceType, port string) sdk.Error {
+	return newError(codespace, CodeUnknownPort, fmt.Sprintf("unknown IBC port %s", port))
+}
 
 // -------------------------
 // Helpers
@@ -0,0 +1,176 @@
+package ibc
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState - all IBC state that must be provided at genesis
+type GenesisState struct {
+	// addresses allowed to create the light clients of counterparty chains
+	ClientCreators []sdk.AccAddress `json:"client_creators"`
+	// light clients of the counterparty chains and the app hashes they track
+	Clients         []ConsensusState `json:"clients"`
+	CommitmentRoots []CommitmentRoot `json:"commitment_roots"`
+	// outgoing IBC packets, by destination chain
+	EgressQueues []EgressQueue `json:"egress_queues"`
+	// incoming IBC packets, by source chain
+	IngressSequences []IngressSequence       `json:"ingress_sequences"`
+	Acknowledgements []PacketAcknowledgement `json:"acknowledgements"`
+}
+
+// CommitmentRoot is the app hash of a header of a counterparty chain tracked
+// by its light client
+type CommitmentRoot struct {
+	ChainID string `json:"chain_id"`
+	Height  int64  `json:"height"`
+	Root    []byte `json:"root"`
+}
+
+// EgressQueue holds the outgoing IBC packets to a destination chain: the
+// number of packets ever posted to it, and the packets still pending
+type EgressQueue struct {
+	DestChain string         `json:"dest_chain"`
+	Length    int64          `json:"length"`
+	Packets   []EgressPacket `json:"packets"`
+}
+
+// EgressPacket is a pending outgoing IBC packet with its sequence
+type EgressPacket struct {
+	Sequence int64     `json:"sequence"`
+	Packet   IBCPacket `json:"packet"`
+}
+
+// IngressSequence is the sequence of the next incoming IBC packet from a
+// source chain
+type IngressSequence struct {
+	SrcChain string `json:"src_chain"`
+	Sequence int64  `json:"sequence"`
+}
+
+// PacketAcknowledgement is the acknowledgement of a received IBC packet
+type PacketAcknowledgement struct {
+	SrcChain        string          `json:"src_chain"`
+	Sequence        int64           `json:"sequence"`
+	Acknowledgement Acknowledgement `json:"acknowledgement"`
+}
+
+// NewGenesisState creates a new genesis state
+func NewGenesisState(clientCreators []sdk.AccAddress) GenesisState {
+	return GenesisState{
+		ClientCreators: clientCreators,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state, in which no light
+// client can be created
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		ClientCreators: []sdk.AccAddress{},
+	}
+}
+
+// InitGenesis sets the addresses allowed to create light clients, the light
+// clients with their tracked app hashes, and the queues of the IBC packets
+func InitGenesis(ctx sdk.Context, ibcm Mapper, data GenesisState) error {
+	for _, addr := range data.ClientCreators {
+		if len(addr) == 0 {
+			return sdk.ErrInvalidAddress("client creator address cannot be empty")
+		}
+	}
+	ibcm.SetClientCreators(ctx, data.ClientCreators)
+
+	for _, cs := range data.Clients {
+		if cs.ValidatorSet == nil || cs.ValidatorSet.Size() == 0 {
+			return ErrInvalidHeader(ibcm.codespace, fmt.Sprintf("empty validator set for the light client of chain %s", cs.ChainID))
+		}
+		ibcm.setConsensusState(ctx, cs)
+	}
+	for _, root := range data.CommitmentRoots {
+		if _, found := ibcm.GetConsensusState(ctx, root.ChainID); !found {
+			return ErrUnknownClient(ibcm.codespace, root.ChainID)
+		}
+		ibcm.setCommitmentRoot(ctx, root.ChainID, root.Height, root.Root)
+	}
+
+	for _, queue := range data.EgressQueues {
+		for _, packet := range queue.Packets {
+			if packet.Packet.DestChain != queue.DestChain || packet.Sequence < 0 || packet.Sequence >= queue.Length {
+				return ErrUnknownPacket(ibcm.codespace, queue.DestChain, packet.Sequence)
+			}
+			err := packet.Packet.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			ibcm.setEgressPacket(ctx, packet.Sequence, packet.Packet)
+		}
+		ibcm.setEgressLength(ctx, queue.DestChain, queue.Length)
+	}
+	for _, ingress := range data.IngressSequences {
+		ibcm.SetIngressSequence(ctx, ingress.SrcChain, ingress.Sequence)
+	}
+	for _, ack := range data.Acknowledgements {
+		ibcm.SetAcknowledgement(ctx, ack.SrcChain, ack.Sequence, ack.Acknowledgement)
+	}
+	return nil
+}
+
+// WriteGenesis returns the IBC state: the addresses allowed to create light
+// clients, the light clients with their tracked app hashes, and the queues
+// of the IBC packets
+func WriteGenesis(ctx sdk.Context, ibcm Mapper) GenesisState {
+	var clients []ConsensusState
+	ibcm.IterateConsensusStates(ctx, func(cs ConsensusState) (stop bool) {
+		clients = append(clients, cs)
+		return false
+	})
+
+	var roots []CommitmentRoot
+	ibcm.IterateCommitmentRoots(ctx, func(chainID string, height int64, root []byte) (stop bool) {
+		roots = append(roots, CommitmentRoot{chainID, height, root})
+		return false
+	})
+
+	var queues []EgressQueue
+	queueIndex := make(map[string]int)
+	ibcm.IterateEgressLengths(ctx, func(destChain string, length int64) (stop bool) {
+		queueIndex[destChain] = len(queues)
+		queues = append(queues, EgressQueue{DestChain: destChain, Length: length})
+		return false
+	})
+	ibcm.IterateEgressPackets(ctx, func(sequence int64, packet IBCPacket) (stop bool) {
+		i, found := queueIndex[packet.DestChain]
+		if !found {
+			i = len(queues)
+			queueIndex[packet.DestChain] = i
+			queues = append(queues, EgressQueue{DestChain: packet.DestChain})
+		}
+		if sequence >= queues[i].Length {
+			queues[i].Length = sequence + 1
+		}
+		queues[i].Packets = append(queues[i].Packets, EgressPacket{sequence, packet})
+		return false
+	})
+
+	var ingressSequences []IngressSequence
+	ibcm.IterateIngressSequences(ctx, func(srcChain string, sequence int64) (stop bool) {
+		ingressSequences = append(ingressSequences, IngressSequence{srcChain, sequence})
+		return false
+	})
+
+	var acks []PacketAcknowledgement
+	ibcm.IterateAcknowledgements(ctx, func(srcChain string, sequence int64, ack Acknowledgement) (stop bool) {
+		acks = append(acks, PacketAcknowledgement{srcChain, sequence, ack})
+		return false
+	})
+
+	return GenesisState{
+		ClientCreators:   ibcm.GetClientCreators(ctx),
+		Clients:          clients,
+		CommitmentRoots:  roots,
+		EgressQueues:     queues,
+		IngressSequences: ingressSequences,
+		Acknowledgements: acks,
+	}
+}
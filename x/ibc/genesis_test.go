@@ -0,0 +1,98 @@
+package ibc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGenesisExportImport(t *testing.T) {
+	cdc := makeCodec()
+
+	key := sdk.NewKVStoreKey("ibc")
+	chainid := "ibcchain"
+	ctx := defaultContext(key).WithChainID(chainid)
+	ibcm := NewMapper(cdc, key, DefaultCodespace)
+	ibcm.AddRoute(PortTransfer, &testPortHandler{})
+	creator := newAddress()
+	require.Nil(t, InitGenesis(ctx, ibcm, NewGenesisState([]sdk.AccAddress{creator})))
+
+	// track two headers of the counterparty chain
+	otherChainID := "otherchain"
+	otherChain := newTestChain(t, cdc, otherChainID)
+	header, _ := otherChain.signHeader(t, 10, []byte("apphash10"))
+	require.Nil(t, ibcm.CreateClient(ctx, header, otherChain.valset))
+	header, commit := otherChain.signHeader(t, 12, []byte("apphash12"))
+	require.Nil(t, ibcm.UpdateClient(ctx, header, commit, otherChain.valset))
+
+	// post three packets, the first of which is no longer pending
+	packets := make([]IBCPacket, 3)
+	for i := range packets {
+		packets[i] = NewIBCPacket(PortTransfer, PortTransfer, chainid, otherChainID, []byte{byte(i)}, 0)
+		require.Nil(t, ibcm.PostIBCPacket(ctx, packets[i]))
+	}
+	ibcm.deleteEgressPacket(ctx, otherChainID, 0)
+
+	// receive two packets, the second of which failed
+	ibcm.SetAcknowledgement(ctx, otherChainID, 0, Acknowledgement{sdk.ABCICodeOK})
+	ibcm.SetAcknowledgement(ctx, otherChainID, 1, Acknowledgement{sdk.ToABCICode(DefaultCodespace, CodeUnknownPort)})
+	ibcm.SetIngressSequence(ctx, otherChainID, 2)
+
+	exported := WriteGenesis(ctx, ibcm)
+	require.Equal(t, []sdk.AccAddress{creator}, exported.ClientCreators)
+	require.Len(t, exported.Clients, 1)
+	require.Equal(t, []CommitmentRoot{
+		{otherChainID, 10, []byte("apphash10")},
+		{otherChainID, 12, []byte("apphash12")},
+	}, exported.CommitmentRoots)
+	require.Equal(t, []EgressQueue{{otherChainID, 3, []EgressPacket{{1, packets[1]}, {2, packets[2]}}}}, exported.EgressQueues)
+	require.Equal(t, []IngressSequence{{otherChainID, 2}}, exported.IngressSequences)
+	require.Len(t, exported.Acknowledgements, 2)
+
+	// import the state, through its JSON encoding, into a new chain
+	bz, err := cdc.MarshalJSON(exported)
+	require.Nil(t, err)
+	var data GenesisState
+	require.Nil(t, cdc.UnmarshalJSON(bz, &data))
+
+	key2 := sdk.NewKVStoreKey("ibc")
+	ctx2 := defaultContext(key2).WithChainID(chainid)
+	ibcm2 := NewMapper(cdc, key2, DefaultCodespace)
+	ibcm2.AddRoute(PortTransfer, &testPortHandler{})
+	require.Nil(t, InitGenesis(ctx2, ibcm2, data))
+
+	reexported, err := cdc.MarshalJSON(WriteGenesis(ctx2, ibcm2))
+	require.Nil(t, err)
+	require.Equal(t, string(bz), string(reexported))
+
+	require.True(t, ibcm2.IsClientCreator(ctx2, creator))
+	cs, found := ibcm2.GetConsensusState(ctx2, otherChainID)
+	require.True(t, found)
+	require.Equal(t, int64(12), cs.Height)
+	require.Equal(t, otherChain.valset.Hash(), cs.ValidatorSet.Hash())
+	root, found := ibcm2.GetCommitmentRoot(ctx2, otherChainID, 10)
+	require.True(t, found)
+	require.Equal(t, []byte("apphash10"), root)
+	_, found = ibcm2.GetEgressPacket(ctx2, otherChainID, 0)
+	require.False(t, found)
+	packet, found := ibcm2.GetEgressPacket(ctx2, otherChainID, 2)
+	require.True(t, found)
+	require.Equal(t, packets[2], packet)
+	require.Equal(t, int64(2), ibcm2.GetIngressSequence(ctx2, otherChainID))
+	ack, found := ibcm2.GetAcknowledgement(ctx2, otherChainID, 1)
+	require.True(t, found)
+	require.False(t, ack.IsOK())
+
+	// the egress queue continues after the imported packets
+	require.Nil(t, ibcm2.PostIBCPacket(ctx2, packets[0]))
+	packet, found = ibcm2.GetEgressPacket(ctx2, otherChainID, 3)
+	require.True(t, found)
+	require.Equal(t, packets[0], packet)
+
+	// light clients can't be imported without a validator set
+	ctx3 := defaultContext(key).WithChainID(chainid)
+	err = InitGenesis(ctx3, ibcm, GenesisState{Clients: []ConsensusState{{ChainID: otherChainID, Height: 1}}})
+	require.NotNil(t, err)
+}
@@ -1,6 +1,7 @@
 package ibc
 
 import (
+	"fmt"
 	"reflect"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,7 +14,15 @@ func NewHandler(ibcm Mapper, ck bank.Keeper) sdk.Handler {
 		case IBCTransferMsg:
 			return handleIBCTransferMsg(ctx, ibcm, ck, msg)
 		case IBCReceiveMsg:
-			return handleIBCReceiveMsg(ctx, ibcm, ck, msg)
+			return handleIBCReceiveMsg(ctx, ibcm, msg)
+		case MsgAcknowledgement:
+			return handleMsgAcknowledgement(ctx, ibcm, msg)
+		case MsgTimeout:
+			return handleMsgTimeout(ctx, ibcm, msg)
+		case MsgCreateClient:
+			return handleMsgCreateClient(ctx, ibcm, msg)
+		case MsgUpdateClient:
+			return handleMsgUpdateClient(ctx, ibcm, msg)
 		default:
 			errMsg := "Unrecognized IBC Msg type: " + reflect.TypeOf(msg).Name()
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -21,15 +30,21 @@ func NewHandler(ibcm Mapper, ck bank.Keeper) sdk.Handler {
 	}
 }
 
-// IBCTransferMsg deducts coins from the account and creates an egress IBC packet.
+// IBCTransferMsg escrows the coins of the account, or burns the vouchers
+// returning to their chain, and creates an egress IBC packet of the transfer
+// port.
 func handleIBCTransferMsg(ctx sdk.Context, ibcm Mapper, ck bank.Keeper, msg IBCTransferMsg) sdk.Result {
-	packet := msg.IBCPacket
+	if msg.DestChain == ctx.ChainID() {
+		return ErrIdenticalChains(ibcm.codespace).Result()
+	}
 
-	_, _, err := ck.SubtractCoins(ctx, packet.SrcAddr, packet.Coins)
+	err := sendTransfer(ctx, ck, msg.TransferPacketData, msg.DestChain)
 	if err != nil {
 		return err.Result()
 	}
 
+	packet := NewIBCPacket(PortTransfer, PortTransfer, ctx.ChainID(), msg.DestChain,
+		msg.TransferPacketData.GetBytes(), msg.TimeoutHeight)
 	err = ibcm.PostIBCPacket(ctx, packet)
 	if err != nil {
 		return err.Result()
@@ -38,21 +53,130 @@ func handleIBCTransferMsg(ctx sdk.Context, ibcm Mapper, ck bank.Keeper, msg IBCT
 	return sdk.Result{}
 }
 
-// IBCReceiveMsg adds coins to the destination address and creates an ingress IBC packet.
-func handleIBCReceiveMsg(ctx sdk.Context, ibcm Mapper, ck bank.Keeper, msg IBCReceiveMsg) sdk.Result {
+// IBCReceiveMsg verifies the packet was committed in the egress queue of the
+// source chain, routes it to the handler of its destination port and writes
+// the acknowledgement of the packet. A packet which timed
+// out is skipped without acknowledgement, to be refunded on the source chain.
+func handleIBCReceiveMsg(ctx sdk.Context, ibcm Mapper, msg IBCReceiveMsg) sdk.Result {
 	packet := msg.IBCPacket
 
+	if packet.DestChain != ctx.ChainID() {
+		return ErrInvalidDestChain(ibcm.codespace, packet.DestChain).Result()
+	}
+
 	seq := ibcm.GetIngressSequence(ctx, packet.SrcChain)
 	if msg.Sequence != seq {
 		return ErrInvalidSequence(ibcm.codespace).Result()
 	}
 
-	_, _, err := ck.AddCoins(ctx, packet.DestAddr, packet.Coins)
+	bz := marshalBinaryPanic(ibcm.cdc, packet)
+	err := ibcm.VerifyPacketProof(ctx, packet.SrcChain, msg.ProofHeight, msg.Proof, EgressKey(packet.DestChain, seq), bz)
 	if err != nil {
 		return err.Result()
 	}
 
 	ibcm.SetIngressSequence(ctx, packet.SrcChain, seq+1)
 
+	if packet.TimeoutHeight != 0 && ctx.BlockHeight() >= packet.TimeoutHeight {
+		return sdk.Result{Log: "packet timed out"}
+	}
+
+	// the packet is only received if it is processed successfully, otherwise
+	// the error is acknowledged for the source chain to refund it
+	var ack Acknowledgement
+	cacheCtx, write := ctx.CacheContext()
+	err = ibcm.receivePacket(cacheCtx, packet)
+	if err != nil {
+		ack.Code = err.ABCICode()
+	} else {
+		write()
+	}
+	ibcm.SetAcknowledgement(ctx, packet.SrcChain, seq, ack)
+
+	if err != nil {
+		return sdk.Result{Log: err.ABCILog()}
+	}
+	return sdk.Result{}
+}
+
+// MsgAcknowledgement verifies the acknowledgement of a pending packet was
+// written by the destination chain, refunds the packet to its source port if it
+// couldn't be processed and deletes the packet.
+func handleMsgAcknowledgement(ctx sdk.Context, ibcm Mapper, msg MsgAcknowledgement) sdk.Result {
+	packet := msg.IBCPacket
+
+	err := ibcm.verifyPendingPacket(ctx, packet, msg.Sequence)
+	if err != nil {
+		return err.Result()
+	}
+
+	bz := marshalBinaryPanic(ibcm.cdc, msg.Acknowledgement)
+	err = ibcm.VerifyPacketProof(ctx, packet.DestChain, msg.ProofHeight, msg.Proof, AcknowledgementKey(packet.SrcChain, msg.Sequence), bz)
+	if err != nil {
+		return err.Result()
+	}
+
+	if !msg.Acknowledgement.IsOK() {
+		err = ibcm.refundPacket(ctx, packet)
+		if err != nil {
+			return err.Result()
+		}
+	}
+
+	ibcm.deleteEgressPacket(ctx, packet.DestChain, msg.Sequence)
+	return sdk.Result{}
+}
+
+// MsgTimeout verifies a pending packet wasn't acknowledged by the destination
+// chain before its timeout height, refunds it to its source port and deletes it.
+func handleMsgTimeout(ctx sdk.Context, ibcm Mapper, msg MsgTimeout) sdk.Result {
+	packet := msg.IBCPacket
+
+	err := ibcm.verifyPendingPacket(ctx, packet, msg.Sequence)
+	if err != nil {
+		return err.Result()
+	}
+
+	// the header at the proof height commits the state of the previous height,
+	// after which the destination chain can't receive the packet anymore
+	if packet.TimeoutHeight == 0 || msg.ProofHeight < packet.TimeoutHeight {
+		return ErrInvalidTimeout(ibcm.codespace, fmt.Sprintf("packet can be received until height %d", packet.TimeoutHeight)).Result()
+	}
+
+	err = ibcm.VerifyPacketProof(ctx, packet.DestChain, msg.ProofHeight, msg.Proof, AcknowledgementKey(packet.SrcChain, msg.Sequence), nil)
+	if err != nil {
+		return err.Result()
+	}
+
+	err = ibcm.refundPacket(ctx, packet)
+	if err != nil {
+		return err.Result()
+	}
+
+	ibcm.deleteEgressPacket(ctx, packet.DestChain, msg.Sequence)
+	return sdk.Result{}
+}
+
+// MsgCreateClient creates the light client of a counterparty chain. Only the
+// client creators set at genesis may create light clients, as the first
+// client of a chain ID decides which packets are accepted from that chain.
+func handleMsgCreateClient(ctx sdk.Context, ibcm Mapper, msg MsgCreateClient) sdk.Result {
+	if !ibcm.IsClientCreator(ctx, msg.Signer) {
+		return ErrUnauthorizedClientCreator(ibcm.codespace, msg.Signer).Result()
+	}
+
+	err := ibcm.CreateClient(ctx, msg.Header, msg.ValidatorSet)
+	if err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+// MsgUpdateClient verifies a new header of a counterparty chain.
+func handleMsgUpdateClient(ctx sdk.Context, ibcm Mapper, msg MsgUpdateClient) sdk.Result {
+	err := ibcm.UpdateClient(ctx, msg.Header, msg.Commit, msg.ValidatorSet)
+	if err != nil {
+		return err.Result()
+	}
 	return sdk.Result{}
 }
@@ -2,6 +2,7 @@ package ibc
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -9,20 +10,24 @@ import (
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	dbm "github.com/tendermint/tendermint/libs/db"
 	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
 // AccountMapper(/Keeper) and IBCMapper should use different StoreKey later
 
-func defaultContext(key sdk.StoreKey) sdk.Context {
+func defaultContext(keys ...sdk.StoreKey) sdk.Context {
 	db := dbm.NewMemDB()
 	cms := store.NewCommitMultiStore(db)
-	cms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	for _, key := range keys {
+		cms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	}
 	cms.LoadLatestVersion()
 	ctx := sdk.NewContext(cms, abci.Header{}, false, log.NewNopLogger())
 	return ctx
@@ -47,6 +52,10 @@ func makeCodec() *wire.Codec {
 	cdc.RegisterConcrete(bank.MsgIssue{}, "test/ibc/Issue", nil)
 	cdc.RegisterConcrete(IBCTransferMsg{}, "test/ibc/IBCTransferMsg", nil)
 	cdc.RegisterConcrete(IBCReceiveMsg{}, "test/ibc/IBCReceiveMsg", nil)
+	cdc.RegisterConcrete(MsgCreateClient{}, "test/ibc/MsgCreateClient", nil)
+	cdc.RegisterConcrete(MsgUpdateClient{}, "test/ibc/MsgUpdateClient", nil)
+	cdc.RegisterConcrete(MsgAcknowledgement{}, "test/ibc/MsgAcknowledgement", nil)
+	cdc.RegisterConcrete(MsgTimeout{}, "test/ibc/MsgTimeout", nil)
 
 	// Register AppAccount
 	cdc.RegisterInterface((*auth.Account)(nil), nil)
@@ -58,34 +67,152 @@ func makeCodec() *wire.Codec {
 	return cdc
 }
 
+// mock counterparty chain, committing IBC packets to its own multistore and
+// signing its headers with a single validator
+type testChain struct {
+	chainID string
+	cms     sdk.CommitMultiStore
+	ibcm    Mapper
+	priv    ed25519.PrivKeyEd25519
+	valset  *tmtypes.ValidatorSet
+}
+
+// transfer packet between the transfer ports of the chains
+func transferPacket(srcAddr, destAddr sdk.AccAddress, coins sdk.Coins, srcChain, destChain string, timeoutHeight int64) IBCPacket {
+	data := TransferPacketData{srcAddr, destAddr, coins}
+	return NewIBCPacket(PortTransfer, PortTransfer, srcChain, destChain, data.GetBytes(), timeoutHeight)
+}
+
+// port handler recording the packets it processes, failing the packets with
+// an empty payload
+type testPortHandler struct {
+	received []IBCPacket
+	refunded []IBCPacket
+}
+
+func (h *testPortHandler) OnReceive(ctx sdk.Context, packet IBCPacket) sdk.Error {
+	if len(packet.Payload) == 0 {
+		return sdk.ErrUnknownRequest("empty payload")
+	}
+	h.received = append(h.received, packet)
+	return nil
+}
+
+func (h *testPortHandler) OnRefund(ctx sdk.Context, packet IBCPacket) sdk.Error {
+	h.refunded = append(h.refunded, packet)
+	return nil
+}
+
+func newTestChain(t *testing.T, cdc *wire.Codec, chainID string) *testChain {
+	key := sdk.NewKVStoreKey("ibc")
+	cms := store.NewCommitMultiStore(dbm.NewMemDB())
+	cms.MountStoreWithDB(key, sdk.StoreTypeIAVL, nil)
+	require.Nil(t, cms.LoadLatestVersion())
+
+	priv := ed25519.GenPrivKey()
+	valset := tmtypes.NewValidatorSet([]*tmtypes.Validator{tmtypes.NewValidator(priv.PubKey(), 10)})
+
+	ibcm := NewMapper(cdc, key, DefaultCodespace)
+	ibcm.AddRoute(PortTransfer, NewTransferHandler(bank.Keeper{}))
+	ibcm.AddRoute("oracle", &testPortHandler{})
+
+	return &testChain{
+		chainID: chainID,
+		cms:     cms,
+		ibcm:    ibcm,
+		priv:    priv,
+		valset:  valset,
+	}
+}
+
+// post a packet to the egress queue of the chain and commit it, returning the
+// signed header committing to the packet and the proof of the packet
+func (c *testChain) postPacket(t *testing.T, packet IBCPacket, seq int64) (tmtypes.Header, tmtypes.Commit, []byte) {
+	header, commit, proofs := c.commit(t, func(ctx sdk.Context) {
+		require.Nil(t, c.ibcm.PostIBCPacket(ctx, packet))
+	}, EgressKey(packet.DestChain, seq))
+	return header, commit, proofs[0]
+}
+
+// write to the IBC store of the chain and commit it, returning the signed
+// header committing to the state and the proofs of the given keys
+func (c *testChain) commit(t *testing.T, write func(ctx sdk.Context), keys ...[]byte) (tmtypes.Header, tmtypes.Commit, [][]byte) {
+	ctx := sdk.NewContext(c.cms, abci.Header{ChainID: c.chainID}, false, log.NewNopLogger())
+	write(ctx)
+	cid := c.cms.Commit()
+
+	proofs := make([][]byte, len(keys))
+	for i, key := range keys {
+		res := c.cms.(sdk.Queryable).Query(abci.RequestQuery{
+			Path:   "/ibc/key",
+			Data:   key,
+			Height: cid.Version,
+			Prove:  true,
+		})
+		require.True(t, res.IsOK(), res.Log)
+		proofs[i] = res.Proof
+	}
+
+	header, commit := c.signHeader(t, cid.Version+1, cid.Hash)
+	return header, commit, proofs
+}
+
+// sign a header of the chain with its validator
+func (c *testChain) signHeader(t *testing.T, height int64, appHash []byte) (tmtypes.Header, tmtypes.Commit) {
+	header := tmtypes.Header{
+		ChainID:        c.chainID,
+		Height:         height,
+		Time:           time.Unix(height, 0).UTC(),
+		ValidatorsHash: c.valset.Hash(),
+		AppHash:        appHash,
+	}
+	blockID := tmtypes.BlockID{Hash: header.Hash()}
+
+	vote := &tmtypes.Vote{
+		ValidatorAddress: c.priv.PubKey().Address(),
+		ValidatorIndex:   0,
+		Height:           height,
+		Round:            0,
+		Timestamp:        header.Time,
+		Type:             tmtypes.VoteTypePrecommit,
+		BlockID:          blockID,
+	}
+	sig, err := c.priv.Sign(vote.SignBytes(c.chainID))
+	require.Nil(t, err)
+	vote.Signature = sig
+
+	return header, tmtypes.Commit{BlockID: blockID, Precommits: []*tmtypes.Vote{vote}}
+}
+
 func TestIBC(t *testing.T) {
 	cdc := makeCodec()
 
 	key := sdk.NewKVStoreKey("ibc")
-	ctx := defaultContext(key)
+	keyBank := sdk.NewKVStoreKey("bank")
+	keyParams := sdk.NewKVStoreKey("params")
+	chainid := "ibcchain"
+	ctx := defaultContext(key, keyBank, keyParams).WithChainID(chainid)
 
 	am := auth.NewAccountMapper(cdc, key, auth.ProtoBaseAccount)
-	ck := bank.NewKeeper(am)
+	pk := params.NewKeeper(cdc, keyParams)
+	ck := bank.NewKeeper(cdc, keyBank, am, pk.Setter(), bank.DefaultCodespace)
 
 	src := newAddress()
 	dest := newAddress()
-	chainid := "ibcchain"
+	otherChainID := "otherchain"
+	escrow := EscrowAddress(otherChainID)
 	zero := sdk.Coins(nil)
 	mycoins := sdk.Coins{sdk.NewInt64Coin("mycoin", 10)}
 
-	coins, _, err := ck.AddCoins(ctx, src, mycoins)
+	_, err := ck.MintCoins(ctx, src, mycoins)
+	require.Nil(t, err)
+	coins, err := getCoins(ck, ctx, src)
 	require.Nil(t, err)
 	require.Equal(t, mycoins, coins)
 
 	ibcm := NewMapper(cdc, key, DefaultCodespace)
+	ibcm.AddRoute(PortTransfer, NewTransferHandler(ck))
 	h := NewHandler(ibcm, ck)
-	packet := IBCPacket{
-		SrcAddr:   src,
-		DestAddr:  dest,
-		Coins:     mycoins,
-		SrcChain:  chainid,
-		DestChain: chainid,
-	}
 
 	store := ctx.KVStore(key)
 
@@ -94,43 +221,440 @@ func TestIBC(t *testing.T) {
 	var egl int64
 	var igs int64
 
-	egl = ibcm.getEgressLength(store, chainid)
+	egl = ibcm.getEgressLength(store, otherChainID)
 	require.Equal(t, egl, int64(0))
 
-	msg = IBCTransferMsg{
-		IBCPacket: packet,
-	}
+	// native coins sent to the other chain are escrowed
+	msg = NewIBCTransferMsg(src, dest, mycoins, otherChainID, 0)
 	res = h(ctx, msg)
 	require.True(t, res.IsOK())
 
+	packet, found := ibcm.GetEgressPacket(ctx, otherChainID, 0)
+	require.True(t, found)
+	require.Equal(t, transferPacket(src, dest, mycoins, chainid, otherChainID, 0), packet)
+
 	coins, err = getCoins(ck, ctx, src)
 	require.Nil(t, err)
 	require.Equal(t, zero, coins)
+	coins, err = getCoins(ck, ctx, escrow)
+	require.Nil(t, err)
+	require.Equal(t, mycoins, coins)
+	require.Equal(t, int64(10), ck.GetSupply(ctx, "mycoin").Int64())
 
-	egl = ibcm.getEgressLength(store, chainid)
+	egl = ibcm.getEgressLength(store, otherChainID)
 	require.Equal(t, egl, int64(1))
 
-	igs = ibcm.GetIngressSequence(ctx, chainid)
+	igs = ibcm.GetIngressSequence(ctx, otherChainID)
 	require.Equal(t, igs, int64(0))
 
+	// the vouchers of the coins are sent back from the other chain, the packet
+	// must be proven against a header of the other chain
+	otherChain := newTestChain(t, cdc, otherChainID)
+	packet = transferPacket(src, dest, sdk.Coins{sdk.NewInt64Coin(VoucherPrefix(chainid)+"mycoin", 10)}, otherChainID, chainid, 0)
+	header, _, proof := otherChain.postPacket(t, packet, 0)
+
 	msg = IBCReceiveMsg{
-		IBCPacket: packet,
-		Relayer:   src,
-		Sequence:  0,
+		IBCPacket:   packet,
+		Relayer:     src,
+		Sequence:    0,
+		Proof:       proof,
+		ProofHeight: header.Height,
 	}
 	res = h(ctx, msg)
+	require.False(t, res.IsOK())
+
+	// only the client creators may create light clients
+	res = h(ctx, NewMsgCreateClient(src, header, otherChain.valset))
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeUnauthorized), res.Code)
+
+	ibcm.SetClientCreators(ctx, []sdk.AccAddress{src})
+	res = h(ctx, NewMsgCreateClient(src, header, otherChain.valset))
+	require.True(t, res.IsOK())
+
+	res = h(ctx, IBCReceiveMsg{IBCPacket: packet, Relayer: src, Sequence: 0, Proof: proof, ProofHeight: header.Height - 1})
+	require.False(t, res.IsOK())
+
+	// the packet is not destined to another chain
+	res = h(ctx.WithChainID("thirdchain"), msg)
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeInvalidDestChain), res.Code)
+
+	// the escrowed coins are released
+	res = h(ctx, msg)
 	require.True(t, res.IsOK())
 
 	coins, err = getCoins(ck, ctx, dest)
 	require.Nil(t, err)
 	require.Equal(t, mycoins, coins)
+	coins, err = getCoins(ck, ctx, escrow)
+	require.Nil(t, err)
+	require.Equal(t, zero, coins)
 
-	igs = ibcm.GetIngressSequence(ctx, chainid)
+	igs = ibcm.GetIngressSequence(ctx, otherChainID)
 	require.Equal(t, igs, int64(1))
 
 	res = h(ctx, msg)
 	require.False(t, res.IsOK())
 
-	igs = ibcm.GetIngressSequence(ctx, chainid)
+	igs = ibcm.GetIngressSequence(ctx, otherChainID)
 	require.Equal(t, igs, int64(1))
+
+	// the coins of the other chain are received as vouchers
+	packet = transferPacket(src, dest, sdk.Coins{sdk.NewInt64Coin("othercoin", 5)}, otherChainID, chainid, 0)
+	header, commit, proof := otherChain.postPacket(t, packet, 1)
+	res = h(ctx, NewMsgUpdateClient(src, header, commit, otherChain.valset))
+	require.True(t, res.IsOK(), res.Log)
+
+	msg = IBCReceiveMsg{IBCPacket: packet, Relayer: src, Sequence: 1, Proof: proof, ProofHeight: header.Height}
+	res = h(ctx, msg)
+	require.True(t, res.IsOK(), res.Log)
+
+	vouchers := sdk.Coins{sdk.NewInt64Coin(VoucherPrefix(otherChainID)+"othercoin", 5)}
+	coins, err = getCoins(ck, ctx, dest)
+	require.Nil(t, err)
+	require.Equal(t, vouchers.Plus(mycoins), coins)
+	require.Equal(t, int64(5), ck.GetSupply(ctx, vouchers[0].Denom).Int64())
+	require.Equal(t, int64(0), ck.GetSupply(ctx, "othercoin").Int64())
+
+	// the vouchers sent back to the other chain are burned
+	msg = NewIBCTransferMsg(dest, src, vouchers, otherChainID, 0)
+	res = h(ctx, msg)
+	require.True(t, res.IsOK(), res.Log)
+
+	coins, err = getCoins(ck, ctx, dest)
+	require.Nil(t, err)
+	require.Equal(t, mycoins, coins)
+	coins, err = getCoins(ck, ctx, escrow)
+	require.Nil(t, err)
+	require.Equal(t, zero, coins)
+	require.Equal(t, int64(0), ck.GetSupply(ctx, vouchers[0].Denom).Int64())
+}
+
+func TestIBCAcknowledgement(t *testing.T) {
+	cdc := makeCodec()
+
+	key := sdk.NewKVStoreKey("ibc")
+	keyBank := sdk.NewKVStoreKey("bank")
+	keyParams := sdk.NewKVStoreKey("params")
+	chainid := "ibcchain"
+	ctx := defaultContext(key, keyBank, keyParams).WithChainID(chainid).WithBlockHeight(10)
+
+	am := auth.NewAccountMapper(cdc, key, auth.ProtoBaseAccount)
+	pk := params.NewKeeper(cdc, keyParams)
+	ck := bank.NewKeeper(cdc, keyBank, am, pk.Setter(), bank.DefaultCodespace)
+	ibcm := NewMapper(cdc, key, DefaultCodespace)
+	ibcm.AddRoute(PortTransfer, NewTransferHandler(ck))
+	h := NewHandler(ibcm, ck)
+
+	src := newAddress()
+	dest := newAddress()
+	otherChainID := "otherchain"
+	otherChain := newTestChain(t, cdc, otherChainID)
+
+	// the first packet returns more coins than were escrowed, the second
+	// packet timed out and the third one is received
+	escrowed := sdk.NewInt64Coin(VoucherPrefix(chainid)+"mycoin", 10)
+	packets := []IBCPacket{
+		transferPacket(src, dest, sdk.Coins{escrowed}, otherChainID, chainid, 0),
+		transferPacket(src, dest, sdk.Coins{sdk.NewInt64Coin("othercoin", 10)}, otherChainID, chainid, 10),
+		transferPacket(src, dest, sdk.Coins{sdk.NewInt64Coin("othercoin", 10)}, otherChainID, chainid, 11),
+	}
+	header, _, proofs := otherChain.commit(t, func(ctx sdk.Context) {
+		for _, packet := range packets {
+			require.Nil(t, otherChain.ibcm.PostIBCPacket(ctx, packet))
+		}
+	}, EgressKey(chainid, 0), EgressKey(chainid, 1), EgressKey(chainid, 2))
+	require.Nil(t, ibcm.CreateClient(ctx, header, otherChain.valset))
+
+	for i, packet := range packets {
+		res := h(ctx, IBCReceiveMsg{packet, src, int64(i), proofs[i], header.Height})
+		require.True(t, res.IsOK(), res.Log)
+	}
+	require.Equal(t, int64(3), ibcm.GetIngressSequence(ctx, otherChainID))
+
+	ack, found := ibcm.GetAcknowledgement(ctx, otherChainID, 0)
+	require.True(t, found)
+	require.False(t, ack.IsOK())
+
+	_, found = ibcm.GetAcknowledgement(ctx, otherChainID, 1)
+	require.False(t, found)
+
+	ack, found = ibcm.GetAcknowledgement(ctx, otherChainID, 2)
+	require.True(t, found)
+	require.True(t, ack.IsOK())
+
+	coins, err := getCoins(ck, ctx, dest)
+	require.Nil(t, err)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(VoucherPrefix(otherChainID)+"othercoin", 10)}, coins)
+}
+
+func TestIBCRefund(t *testing.T) {
+	cdc := makeCodec()
+
+	key := sdk.NewKVStoreKey("ibc")
+	keyBank := sdk.NewKVStoreKey("bank")
+	keyParams := sdk.NewKVStoreKey("params")
+	chainid := "ibcchain"
+	ctx := defaultContext(key, keyBank, keyParams).WithChainID(chainid)
+
+	am := auth.NewAccountMapper(cdc, key, auth.ProtoBaseAccount)
+	pk := params.NewKeeper(cdc, keyParams)
+	ck := bank.NewKeeper(cdc, keyBank, am, pk.Setter(), bank.DefaultCodespace)
+	ibcm := NewMapper(cdc, key, DefaultCodespace)
+	ibcm.AddRoute(PortTransfer, NewTransferHandler(ck))
+	h := NewHandler(ibcm, ck)
+
+	src := newAddress()
+	dest := newAddress()
+	otherChainID := "otherchain"
+	escrow := EscrowAddress(otherChainID)
+	mycoins := sdk.Coins{sdk.NewInt64Coin("mycoin", 10)}
+
+	_, err := ck.MintCoins(ctx, src, sdk.Coins{sdk.NewInt64Coin("mycoin", 30)})
+	require.Nil(t, err)
+
+	// the first packet fails on the destination chain, the second one times
+	// out and the third one is received
+	packets := []IBCPacket{
+		transferPacket(src, dest, mycoins, chainid, otherChainID, 0),
+		transferPacket(src, dest, mycoins, chainid, otherChainID, 2),
+		transferPacket(src, dest, mycoins, chainid, otherChainID, 3),
+	}
+	for _, packet := range packets {
+		res := h(ctx, NewIBCTransferMsg(src, dest, mycoins, otherChainID, packet.TimeoutHeight))
+		require.True(t, res.IsOK(), res.Log)
+	}
+
+	coins, err := getCoins(ck, ctx, escrow)
+	require.Nil(t, err)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("mycoin", 30)}, coins)
+
+	errAck := Acknowledgement{Code: sdk.ErrInsufficientCoins("").ABCICode()}
+	okAck := Acknowledgement{}
+	otherChain := newTestChain(t, cdc, otherChainID)
+	header, _, proofs := otherChain.commit(t, func(ctx sdk.Context) {
+		otherChain.ibcm.SetAcknowledgement(ctx, chainid, 0, errAck)
+		otherChain.ibcm.SetAcknowledgement(ctx, chainid, 2, okAck)
+	}, AcknowledgementKey(chainid, 0), AcknowledgementKey(chainid, 1), AcknowledgementKey(chainid, 2))
+	require.Equal(t, int64(2), header.Height)
+	require.Nil(t, ibcm.CreateClient(ctx, header, otherChain.valset))
+
+	// the error acknowledgement refunds the coins
+	msg := NewMsgAcknowledgement(src, packets[0], 0, errAck, proofs[0], header.Height)
+	res := h(ctx, msg)
+	require.True(t, res.IsOK(), res.Log)
+
+	coins, err = getCoins(ck, ctx, src)
+	require.Nil(t, err)
+	require.Equal(t, mycoins, coins)
+
+	_, found := ibcm.GetEgressPacket(ctx, otherChainID, 0)
+	require.False(t, found)
+
+	res = h(ctx, msg)
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeUnknownPacket), res.Code)
+
+	// the packet which wasn't acknowledged before its timeout is refunded
+	res = h(ctx, NewMsgTimeout(src, packets[1], 2, proofs[1], header.Height))
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeUnknownPacket), res.Code)
+	res = h(ctx, NewMsgTimeout(src, packets[1], 1, proofs[1], header.Height+1))
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeInvalidProof), res.Code)
+
+	res = h(ctx, NewMsgTimeout(src, packets[1], 1, proofs[1], header.Height))
+	require.True(t, res.IsOK(), res.Log)
+
+	coins, err = getCoins(ck, ctx, src)
+	require.Nil(t, err)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("mycoin", 20)}, coins)
+
+	// the received packet can't time out nor be refunded by a forged acknowledgement
+	res = h(ctx, NewMsgTimeout(src, packets[2], 2, proofs[2], header.Height))
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeInvalidTimeout), res.Code)
+	res = h(ctx, NewMsgAcknowledgement(src, packets[2], 2, errAck, proofs[2], header.Height))
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeInvalidProof), res.Code)
+
+	res = h(ctx, NewMsgAcknowledgement(src, packets[2], 2, okAck, proofs[2], header.Height))
+	require.True(t, res.IsOK(), res.Log)
+
+	coins, err = getCoins(ck, ctx, src)
+	require.Nil(t, err)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("mycoin", 20)}, coins)
+	coins, err = getCoins(ck, ctx, escrow)
+	require.Nil(t, err)
+	require.Equal(t, mycoins, coins)
+}
+
+func TestIBCTransferRestrictions(t *testing.T) {
+	cdc := makeCodec()
+
+	key := sdk.NewKVStoreKey("ibc")
+	keyBank := sdk.NewKVStoreKey("bank")
+	keyParams := sdk.NewKVStoreKey("params")
+	chainid := "ibcchain"
+	ctx := defaultContext(key, keyBank, keyParams).WithChainID(chainid)
+
+	am := auth.NewAccountMapper(cdc, key, auth.ProtoBaseAccount)
+	pk := params.NewKeeper(cdc, keyParams)
+	ck := bank.NewKeeper(cdc, keyBank, am, pk.Setter(), bank.DefaultCodespace)
+	ck.RegisterModuleAccount("stake")
+	ibcm := NewMapper(cdc, key, DefaultCodespace)
+	ibcm.AddRoute(PortTransfer, NewTransferHandler(ck))
+	h := NewHandler(ibcm, ck)
+
+	src := newAddress()
+	dest := newAddress()
+	otherChainID := "otherchain"
+	lockedcoins := sdk.Coins{sdk.NewInt64Coin("lockedcoin", 10)}
+
+	// coins which may not be sent don't leave the chain
+	ck.SetSendEnabled(ctx, []bank.SendEnabled{bank.NewSendEnabled("lockedcoin", false)})
+	_, err := ck.MintCoins(ctx, src, lockedcoins)
+	require.Nil(t, err)
+
+	res := h(ctx, NewIBCTransferMsg(src, dest, lockedcoins, otherChainID, 0))
+	require.Equal(t, sdk.ToABCICode(bank.DefaultCodespace, bank.CodeSendDisabled), res.Code)
+
+	coins, err := getCoins(ck, ctx, src)
+	require.Nil(t, err)
+	require.Equal(t, lockedcoins, coins)
+	_, found := ibcm.GetEgressPacket(ctx, otherChainID, 0)
+	require.False(t, found)
+
+	// packets to a blocked address are acknowledged with an error, so they are
+	// refunded on the source chain
+	moduleAddr := auth.NewModuleAddress("stake")
+	otherChain := newTestChain(t, cdc, otherChainID)
+	packet := transferPacket(src, moduleAddr, sdk.Coins{sdk.NewInt64Coin("othercoin", 10)}, otherChainID, chainid, 0)
+	header, _, proof := otherChain.postPacket(t, packet, 0)
+	require.Nil(t, ibcm.CreateClient(ctx, header, otherChain.valset))
+
+	res = h(ctx, IBCReceiveMsg{packet, src, 0, proof, header.Height})
+	require.True(t, res.IsOK(), res.Log)
+
+	ack, found := ibcm.GetAcknowledgement(ctx, otherChainID, 0)
+	require.True(t, found)
+	require.Equal(t, sdk.ToABCICode(bank.DefaultCodespace, bank.CodeBlockedAddr), ack.Code)
+
+	coins, err = getCoins(ck, ctx, moduleAddr)
+	require.Nil(t, err)
+	require.True(t, coins.IsZero())
+}
+
+func TestIBCPorts(t *testing.T) {
+	cdc := makeCodec()
+
+	key := sdk.NewKVStoreKey("ibc")
+	chainid := "ibcchain"
+	ctx := defaultContext(key).WithChainID(chainid)
+	ibcm := NewMapper(cdc, key, DefaultCodespace)
+	oracle := &testPortHandler{}
+	ibcm.AddRoute("oracle", oracle)
+	h := NewHandler(ibcm, bank.Keeper{})
+
+	require.True(t, ibcm.HasRoute("oracle"))
+	require.False(t, ibcm.HasRoute(PortTransfer))
+	require.Panics(t, func() { ibcm.AddRoute("oracle", oracle) })
+	require.Panics(t, func() { ibcm.AddRoute("oracle/prices", oracle) })
+
+	// packets can only be sent from registered ports
+	otherChainID := "otherchain"
+	sent := NewIBCPacket("oracle", "oracle", chainid, otherChainID, []byte("price"), 0)
+	require.Nil(t, ibcm.PostIBCPacket(ctx, sent))
+	err := ibcm.PostIBCPacket(ctx, NewIBCPacket("unknown", "oracle", chainid, otherChainID, []byte("price"), 0))
+	require.Equal(t, CodeUnknownPort, err.Code())
+
+	// the packets are routed to their destination port, and the errors of the
+	// unknown ports and of the handlers are acknowledged
+	otherChain := newTestChain(t, cdc, otherChainID)
+	packets := []IBCPacket{
+		NewIBCPacket("oracle", "oracle", otherChainID, chainid, []byte("price"), 0),
+		NewIBCPacket("oracle", "unknown", otherChainID, chainid, []byte("price"), 0),
+		NewIBCPacket("oracle", "oracle", otherChainID, chainid, nil, 0),
+	}
+	header, _, proofs := otherChain.commit(t, func(ctx sdk.Context) {
+		for _, packet := range packets {
+			require.Nil(t, otherChain.ibcm.PostIBCPacket(ctx, packet))
+		}
+		otherChain.ibcm.SetAcknowledgement(ctx, chainid, 0, Acknowledgement{Code: sdk.ErrUnknownRequest("").ABCICode()})
+	}, EgressKey(chainid, 0), EgressKey(chainid, 1), EgressKey(chainid, 2), AcknowledgementKey(chainid, 0))
+	require.Nil(t, ibcm.CreateClient(ctx, header, otherChain.valset))
+
+	for i, packet := range packets {
+		res := h(ctx, IBCReceiveMsg{packet, newAddress(), int64(i), proofs[i], header.Height})
+		require.True(t, res.IsOK(), res.Log)
+	}
+	require.Equal(t, []IBCPacket{packets[0]}, oracle.received)
+
+	ack, _ := ibcm.GetAcknowledgement(ctx, otherChainID, 0)
+	require.True(t, ack.IsOK())
+	ack, _ = ibcm.GetAcknowledgement(ctx, otherChainID, 1)
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeUnknownPort), ack.Code)
+	ack, _ = ibcm.GetAcknowledgement(ctx, otherChainID, 2)
+	require.Equal(t, sdk.ToABCICode(sdk.CodespaceRoot, sdk.CodeUnknownRequest), ack.Code)
+
+	// the packet which failed on the destination chain is refunded to its source port
+	res := h(ctx, NewMsgAcknowledgement(newAddress(), sent, 0, Acknowledgement{Code: sdk.ErrUnknownRequest("").ABCICode()}, proofs[3], header.Height))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, []IBCPacket{sent}, oracle.refunded)
+}
+
+func TestUpdateClient(t *testing.T) {
+	cdc := makeCodec()
+
+	key := sdk.NewKVStoreKey("ibc")
+	ctx := defaultContext(key)
+	ibcm := NewMapper(cdc, key, DefaultCodespace)
+	signer := newAddress()
+
+	srcChain := newTestChain(t, cdc, "src-chain")
+	header, commit := srcChain.signHeader(t, 10, []byte("apphash10"))
+
+	err := ibcm.UpdateClient(ctx, header, commit, srcChain.valset)
+	require.NotNil(t, err)
+
+	// create the client at height 10
+	err = ibcm.CreateClient(ctx, header, srcChain.valset)
+	require.Nil(t, err)
+	err = ibcm.CreateClient(ctx, header, srcChain.valset)
+	require.NotNil(t, err)
+
+	root, found := ibcm.GetCommitmentRoot(ctx, "src-chain", 10)
+	require.True(t, found)
+	require.Equal(t, []byte("apphash10"), root)
+
+	// headers can't go backwards
+	err = ibcm.UpdateClient(ctx, header, commit, srcChain.valset)
+	require.NotNil(t, err)
+
+	// a header signed by the trusted validator set
+	header, commit = srcChain.signHeader(t, 12, []byte("apphash12"))
+	res := NewHandler(ibcm, bank.Keeper{})(ctx, NewMsgUpdateClient(signer, header, commit, srcChain.valset))
+	require.True(t, res.IsOK(), res.Log)
+
+	cs, found := ibcm.GetConsensusState(ctx, "src-chain")
+	require.True(t, found)
+	require.Equal(t, int64(12), cs.Height)
+	root, found = ibcm.GetCommitmentRoot(ctx, "src-chain", 12)
+	require.True(t, found)
+	require.Equal(t, []byte("apphash12"), root)
+
+	// a header of another chain
+	otherChain := newTestChain(t, cdc, "other-chain")
+	header, commit = otherChain.signHeader(t, 13, []byte("apphash13"))
+	err = ibcm.UpdateClient(ctx, header, commit, otherChain.valset)
+	require.NotNil(t, err)
+
+	// a header signed only by an untrusted validator set
+	forkChain := newTestChain(t, cdc, "src-chain")
+	header, commit = forkChain.signHeader(t, 13, []byte("apphash13"))
+	err = ibcm.UpdateClient(ctx, header, commit, forkChain.valset)
+	require.NotNil(t, err)
+
+	// a header with an invalid signature
+	header, commit = srcChain.signHeader(t, 13, []byte("apphash13"))
+	header.AppHash = []byte("forged")
+	err = ibcm.UpdateClient(ctx, header, commit, srcChain.valset)
+	require.NotNil(t, err)
+
+	_, found = ibcm.GetCommitmentRoot(ctx, "src-chain", 13)
+	require.False(t, found)
 }
@@ -0,0 +1,101 @@
+package ibc
+
+import (
+	"bytes"
+	"fmt"
+
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ConsensusState is the last verified state of a counterparty chain tracked
+// by the IBC light client: the height of its last verified header and the
+// validator set which signed it, which must sign the next headers.
+type ConsensusState struct {
+	ChainID      string                `json:"chain_id"`
+	Height       int64                 `json:"height"`
+	ValidatorSet *tmtypes.ValidatorSet `json:"validator_set"`
+}
+
+// verify the header is the one the validator set hashes to
+func verifyHeaderValidators(codespace sdk.CodespaceType, header tmtypes.Header, valset *tmtypes.ValidatorSet) sdk.Error {
+	if valset == nil || valset.Size() == 0 {
+		return ErrInvalidHeader(codespace, "empty validator set")
+	}
+	if !bytes.Equal(header.ValidatorsHash, valset.Hash()) {
+		return ErrInvalidHeader(codespace, "validator set doesn't match the validators hash of the header")
+	}
+	return nil
+}
+
+// Verify the signed header of a new height of the counterparty chain. The new
+// validator set must have committed the header, and if the validator set
+// changed, more than 2/3 of the trusted validator set must have committed it
+// too.
+func (cs ConsensusState) verify(codespace sdk.CodespaceType, header tmtypes.Header,
+	commit tmtypes.Commit, valset *tmtypes.ValidatorSet) sdk.Error {
+
+	if header.ChainID != cs.ChainID {
+		return ErrInvalidHeader(codespace, fmt.Sprintf("header of chain %s, expected chain %s", header.ChainID, cs.ChainID))
+	}
+	if header.Height <= cs.Height {
+		return ErrInvalidHeader(codespace, fmt.Sprintf("header height %d is not above the trusted height %d", header.Height, cs.Height))
+	}
+
+	err := verifyHeaderValidators(codespace, header, valset)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(commit.BlockID.Hash, header.Hash()) {
+		return ErrInvalidHeader(codespace, "commit is not for the header")
+	}
+
+	if errVerify := valset.VerifyCommit(header.ChainID, commit.BlockID, header.Height, &commit); errVerify != nil {
+		return ErrInvalidHeader(codespace, errVerify.Error())
+	}
+
+	if bytes.Equal(valset.Hash(), cs.ValidatorSet.Hash()) {
+		return nil
+	}
+	return verifyCommitTrusting(codespace, cs.ValidatorSet, header.ChainID, commit.BlockID, header.Height, commit)
+}
+
+// verify more than 2/3 of the voting power of the trusted validator set
+// signed the commit
+func verifyCommitTrusting(codespace sdk.CodespaceType, trusted *tmtypes.ValidatorSet, chainID string,
+	blockID tmtypes.BlockID, height int64, commit tmtypes.Commit) sdk.Error {
+
+	var signedPower int64
+	seen := make(map[string]bool)
+	for _, precommit := range commit.Precommits {
+		if precommit == nil {
+			continue
+		}
+		if precommit.Height != height || precommit.Type != tmtypes.VoteTypePrecommit || !blockID.Equals(precommit.BlockID) {
+			continue
+		}
+
+		addr := string(precommit.ValidatorAddress)
+		if seen[addr] {
+			continue
+		}
+
+		_, val := trusted.GetByAddress(precommit.ValidatorAddress)
+		if val == nil {
+			continue
+		}
+		if !val.PubKey.VerifyBytes(precommit.SignBytes(chainID), precommit.Signature) {
+			return ErrInvalidHeader(codespace, fmt.Sprintf("invalid signature of validator %X", precommit.ValidatorAddress))
+		}
+
+		seen[addr] = true
+		signedPower += val.VotingPower
+	}
+
+	if signedPower*3 <= trusted.TotalVotingPower()*2 {
+		return ErrInvalidHeader(codespace, "less than 2/3 of the trusted validator set signed the header")
+	}
+	return nil
+}
@@ -1,8 +1,14 @@
 package ibc
 
 import (
+	"bytes"
 	"fmt"
+	"strconv"
+	"strings"
 
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	wire "github.com/cosmos/cosmos-sdk/wire"
 )
@@ -11,6 +17,7 @@ import (
 type Mapper struct {
 	key       sdk.StoreKey
 	cdc       *wire.Codec
+	router    map[string]PortHandler
 	codespace sdk.CodespaceType
 }
 
@@ -21,39 +28,152 @@ func NewMapper(cdc *wire.Codec, key sdk.StoreKey, codespace sdk.CodespaceType) M
 	return Mapper{
 		key:       key,
 		cdc:       cdc,
+		router:    make(map[string]PortHandler),
 		codespace: codespace,
 	}
 }
 
-// XXX: This is not the public API. This will change in MVP2 and will henceforth
-// only be invoked from another module directly and not through a user
-// transaction.
-// TODO: Handle invalid IBC packets and return errors.
+// AddRoute registers the handler of the packets of the port. All the handlers
+// must be registered before the mapper is used.
+func (ibcm *Mapper) AddRoute(port string, handler PortHandler) {
+	if !isAlphaNumeric(port) {
+		panic("IBC ports can only contain alphanumeric characters")
+	}
+	if _, found := ibcm.router[port]; found {
+		panic(fmt.Sprintf("IBC port %s has already been registered", port))
+	}
+	ibcm.router[port] = handler
+}
+
+// HasRoute returns whether or not a handler is registered for the port
+func (ibcm Mapper) HasRoute(port string) bool {
+	_, found := ibcm.router[port]
+	return found
+}
+
+// PostIBCPacket is invoked by the module owning the source port of the packet
+// to send it to the destination chain, in its egress queue.
 func (ibcm Mapper) PostIBCPacket(ctx sdk.Context, packet IBCPacket) sdk.Error {
+	err := packet.ValidateBasic()
+	if err != nil {
+		return err
+	}
+	if !ibcm.HasRoute(packet.SrcPort) {
+		return ErrUnknownPort(ibcm.codespace, packet.SrcPort)
+	}
+
 	// write everything into the state
 	store := ctx.KVStore(ibcm.key)
 	index := ibcm.getEgressLength(store, packet.DestChain)
-	bz, err := ibcm.cdc.MarshalBinary(packet)
+	store.Set(EgressKey(packet.DestChain, index), marshalBinaryPanic(ibcm.cdc, packet))
+	store.Set(EgressLengthKey(packet.DestChain), marshalBinaryPanic(ibcm.cdc, index+1))
+
+	return nil
+}
+
+// GetEgressPacket returns the outgoing IBC packet to the destination chain
+// with the given sequence, if it is still pending: it is deleted once it is
+// acknowledged or timed out.
+func (ibcm Mapper) GetEgressPacket(ctx sdk.Context, destChain string, sequence int64) (packet IBCPacket, found bool) {
+	store := ctx.KVStore(ibcm.key)
+	bz := store.Get(EgressKey(destChain, sequence))
+	if bz == nil {
+		return packet, false
+	}
+
+	unmarshalBinaryPanic(ibcm.cdc, bz, &packet)
+	return packet, true
+}
+
+// verify the packet is the pending outgoing packet with the given sequence
+func (ibcm Mapper) verifyPendingPacket(ctx sdk.Context, packet IBCPacket, sequence int64) sdk.Error {
+	store := ctx.KVStore(ibcm.key)
+	bz := store.Get(EgressKey(packet.DestChain, sequence))
+	if bz == nil || !bytes.Equal(bz, marshalBinaryPanic(ibcm.cdc, packet)) {
+		return ErrUnknownPacket(ibcm.codespace, packet.DestChain, sequence)
+	}
+	return nil
+}
+
+func (ibcm Mapper) deleteEgressPacket(ctx sdk.Context, destChain string, sequence int64) {
+	store := ctx.KVStore(ibcm.key)
+	store.Delete(EgressKey(destChain, sequence))
+}
+
+// route a received packet to the handler of its destination port
+func (ibcm Mapper) receivePacket(ctx sdk.Context, packet IBCPacket) sdk.Error {
+	handler, found := ibcm.router[packet.DestPort]
+	if !found {
+		return ErrUnknownPort(ibcm.codespace, packet.DestPort)
+	}
+	return handler.OnReceive(ctx, packet)
+}
+
+// route a packet to refund to the handler of its source port
+func (ibcm Mapper) refundPacket(ctx sdk.Context, packet IBCPacket) sdk.Error {
+	handler, found := ibcm.router[packet.SrcPort]
+	if !found {
+		return ErrUnknownPort(ibcm.codespace, packet.SrcPort)
+	}
+	return handler.OnRefund(ctx, packet)
+}
+
+// CreateClient creates the light client of a counterparty chain, trusting
+// the given header and validator set. The light client of a chain can only
+// be created once, and is then updated with UpdateClient.
+func (ibcm Mapper) CreateClient(ctx sdk.Context, header tmtypes.Header, valset *tmtypes.ValidatorSet) sdk.Error {
+	if _, found := ibcm.GetConsensusState(ctx, header.ChainID); found {
+		return ErrClientExists(ibcm.codespace, header.ChainID)
+	}
+
+	err := verifyHeaderValidators(ibcm.codespace, header, valset)
 	if err != nil {
-		panic(err)
+		return err
 	}
 
-	store.Set(EgressKey(packet.DestChain, index), bz)
-	bz, err = ibcm.cdc.MarshalBinary(index + 1)
+	ibcm.setConsensusState(ctx, ConsensusState{
+		ChainID:      header.ChainID,
+		Height:       header.Height,
+		ValidatorSet: valset,
+	})
+	ibcm.setCommitmentRoot(ctx, header.ChainID, header.Height, header.AppHash)
+	return nil
+}
+
+// UpdateClient verifies a new header of a counterparty chain against its
+// light client, and tracks the app hash of the header to verify IBC packets.
+func (ibcm Mapper) UpdateClient(ctx sdk.Context, header tmtypes.Header, commit tmtypes.Commit, valset *tmtypes.ValidatorSet) sdk.Error {
+	cs, found := ibcm.GetConsensusState(ctx, header.ChainID)
+	if !found {
+		return ErrUnknownClient(ibcm.codespace, header.ChainID)
+	}
+
+	err := cs.verify(ibcm.codespace, header, commit, valset)
 	if err != nil {
-		panic(err)
+		return err
 	}
-	store.Set(EgressLengthKey(packet.DestChain), bz)
 
+	cs.Height = header.Height
+	cs.ValidatorSet = valset
+	ibcm.setConsensusState(ctx, cs)
+	ibcm.setCommitmentRoot(ctx, header.ChainID, header.Height, header.AppHash)
 	return nil
 }
 
-// XXX: In the future every module is able to register it's own handler for
-// handling it's own IBC packets. The "ibc" handler will only route the packets
-// to the appropriate callbacks.
-// XXX: For now this handles all interactions with the CoinKeeper.
-// XXX: This needs to do some authentication checking.
-func (ibcm Mapper) ReceiveIBCPacket(ctx sdk.Context, packet IBCPacket) sdk.Error {
+// VerifyPacketProof verifies the proof that a value is stored under a key of
+// the IBC store of a counterparty chain, against the app hash of the header
+// of the given height tracked by its light client. An empty value verifies the
+// absence of the key.
+func (ibcm Mapper) VerifyPacketProof(ctx sdk.Context, chainID string, height int64, proof, key, value []byte) sdk.Error {
+	root, found := ibcm.GetCommitmentRoot(ctx, chainID, height)
+	if !found {
+		return ErrInvalidProof(ibcm.codespace, fmt.Sprintf("no header of chain %s tracked at height %d", chainID, height))
+	}
+
+	err := store.VerifyMultiStoreProof(proof, ibcm.key.Name(), key, value, root)
+	if err != nil {
+		return ErrInvalidProof(ibcm.codespace, err.Error())
+	}
 	return nil
 }
 
@@ -101,6 +221,188 @@ func (ibcm Mapper) SetIngressSequence(ctx sdk.Context, srcChain string, sequence
 	store.Set(key, bz)
 }
 
+// GetAcknowledgement returns the acknowledgement written when receiving the
+// IBC packet from the source chain with the given sequence.
+func (ibcm Mapper) GetAcknowledgement(ctx sdk.Context, srcChain string, sequence int64) (ack Acknowledgement, found bool) {
+	store := ctx.KVStore(ibcm.key)
+	bz := store.Get(AcknowledgementKey(srcChain, sequence))
+	if bz == nil {
+		return ack, false
+	}
+
+	unmarshalBinaryPanic(ibcm.cdc, bz, &ack)
+	return ack, true
+}
+
+// SetAcknowledgement writes the acknowledgement of a received IBC packet.
+func (ibcm Mapper) SetAcknowledgement(ctx sdk.Context, srcChain string, sequence int64, ack Acknowledgement) {
+	store := ctx.KVStore(ibcm.key)
+	store.Set(AcknowledgementKey(srcChain, sequence), marshalBinaryPanic(ibcm.cdc, ack))
+}
+
+// GetConsensusState returns the state of the light client of a counterparty chain.
+func (ibcm Mapper) GetConsensusState(ctx sdk.Context, chainID string) (cs ConsensusState, found bool) {
+	store := ctx.KVStore(ibcm.key)
+	bz := store.Get(ClientKey(chainID))
+	if bz == nil {
+		return cs, false
+	}
+
+	unmarshalBinaryPanic(ibcm.cdc, bz, &cs)
+	return cs, true
+}
+
+func (ibcm Mapper) setConsensusState(ctx sdk.Context, cs ConsensusState) {
+	store := ctx.KVStore(ibcm.key)
+	store.Set(ClientKey(cs.ChainID), marshalBinaryPanic(ibcm.cdc, cs))
+}
+
+// GetCommitmentRoot returns the app hash of the header of a counterparty
+// chain at the given height, if it was tracked by its light client.
+func (ibcm Mapper) GetCommitmentRoot(ctx sdk.Context, chainID string, height int64) (root []byte, found bool) {
+	store := ctx.KVStore(ibcm.key)
+	root = store.Get(CommitmentRootKey(chainID, height))
+	return root, root != nil
+}
+
+func (ibcm Mapper) setCommitmentRoot(ctx sdk.Context, chainID string, height int64, root []byte) {
+	store := ctx.KVStore(ibcm.key)
+	store.Set(CommitmentRootKey(chainID, height), root)
+}
+
+// GetClientCreators returns the addresses allowed to create light clients.
+func (ibcm Mapper) GetClientCreators(ctx sdk.Context) (creators []sdk.AccAddress) {
+	store := ctx.KVStore(ibcm.key)
+	bz := store.Get(ClientCreatorsKey)
+	if bz == nil {
+		return []sdk.AccAddress{}
+	}
+
+	unmarshalBinaryPanic(ibcm.cdc, bz, &creators)
+	return creators
+}
+
+// SetClientCreators sets the addresses allowed to create light clients.
+func (ibcm Mapper) SetClientCreators(ctx sdk.Context, creators []sdk.AccAddress) {
+	store := ctx.KVStore(ibcm.key)
+	store.Set(ClientCreatorsKey, marshalBinaryPanic(ibcm.cdc, creators))
+}
+
+// IsClientCreator returns whether the address may create light clients.
+func (ibcm Mapper) IsClientCreator(ctx sdk.Context, addr sdk.AccAddress) bool {
+	for _, creator := range ibcm.GetClientCreators(ctx) {
+		if bytes.Equal(creator, addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// IterateConsensusStates iterates over the light clients of the
+// counterparty chains.
+func (ibcm Mapper) IterateConsensusStates(ctx sdk.Context, fn func(cs ConsensusState) (stop bool)) {
+	store := ctx.KVStore(ibcm.key)
+	iter := sdk.KVStorePrefixIterator(store, clientsPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if _, _, ok := splitCommitmentRootKey(iter.Key()); ok {
+			continue
+		}
+		var cs ConsensusState
+		unmarshalBinaryPanic(ibcm.cdc, iter.Value(), &cs)
+		if fn(cs) {
+			break
+		}
+	}
+}
+
+// IterateCommitmentRoots iterates over the app hashes of the headers tracked
+// by the light clients.
+func (ibcm Mapper) IterateCommitmentRoots(ctx sdk.Context, fn func(chainID string, height int64, root []byte) (stop bool)) {
+	store := ctx.KVStore(ibcm.key)
+	iter := sdk.KVStorePrefixIterator(store, clientsPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		chainID, height, ok := splitCommitmentRootKey(iter.Key())
+		if !ok {
+			continue
+		}
+		if fn(chainID, height, iter.Value()) {
+			break
+		}
+	}
+}
+
+// IterateEgressLengths iterates over the number of outgoing IBC packets ever
+// posted to each destination chain.
+func (ibcm Mapper) IterateEgressLengths(ctx sdk.Context, fn func(destChain string, length int64) (stop bool)) {
+	store := ctx.KVStore(ibcm.key)
+	iter := sdk.KVStorePrefixIterator(store, egressPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if _, _, ok := splitSequenceKey(iter.Key(), egressPrefix); ok {
+			continue
+		}
+		var length int64
+		unmarshalBinaryPanic(ibcm.cdc, iter.Value(), &length)
+		if fn(string(iter.Key()[len(egressPrefix):]), length) {
+			break
+		}
+	}
+}
+
+// IterateEgressPackets iterates over the pending outgoing IBC packets.
+func (ibcm Mapper) IterateEgressPackets(ctx sdk.Context, fn func(sequence int64, packet IBCPacket) (stop bool)) {
+	store := ctx.KVStore(ibcm.key)
+	iter := sdk.KVStorePrefixIterator(store, egressPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, sequence, ok := splitSequenceKey(iter.Key(), egressPrefix)
+		if !ok {
+			continue
+		}
+		var packet IBCPacket
+		unmarshalBinaryPanic(ibcm.cdc, iter.Value(), &packet)
+		if fn(sequence, packet) {
+			break
+		}
+	}
+}
+
+// IterateIngressSequences iterates over the sequence of the next incoming IBC
+// packet from each source chain.
+func (ibcm Mapper) IterateIngressSequences(ctx sdk.Context, fn func(srcChain string, sequence int64) (stop bool)) {
+	store := ctx.KVStore(ibcm.key)
+	iter := sdk.KVStorePrefixIterator(store, ingressPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var sequence int64
+		unmarshalBinaryPanic(ibcm.cdc, iter.Value(), &sequence)
+		if fn(string(iter.Key()[len(ingressPrefix):]), sequence) {
+			break
+		}
+	}
+}
+
+// IterateAcknowledgements iterates over the acknowledgements of the received
+// IBC packets.
+func (ibcm Mapper) IterateAcknowledgements(ctx sdk.Context, fn func(srcChain string, sequence int64, ack Acknowledgement) (stop bool)) {
+	store := ctx.KVStore(ibcm.key)
+	iter := sdk.KVStorePrefixIterator(store, acksPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		srcChain, sequence, ok := splitSequenceKey(iter.Key(), acksPrefix)
+		if !ok {
+			continue
+		}
+		var ack Acknowledgement
+		unmarshalBinaryPanic(ibcm.cdc, iter.Value(), &ack)
+		if fn(srcChain, sequence, ack) {
+			break
+		}
+	}
+}
+
 // Retrieves the index of the currently stored outgoing IBC packets.
 func (ibcm Mapper) getEgressLength(store sdk.KVStore, destChain string) int64 {
 	bz := store.Get(EgressLengthKey(destChain))
@@ -114,6 +416,49 @@ func (ibcm Mapper) getEgressLength(store sdk.KVStore, destChain string) int64 {
 	return res
 }
 
+func (ibcm Mapper) setEgressLength(ctx sdk.Context, destChain string, length int64) {
+	store := ctx.KVStore(ibcm.key)
+	store.Set(EgressLengthKey(destChain), marshalBinaryPanic(ibcm.cdc, length))
+}
+
+func (ibcm Mapper) setEgressPacket(ctx sdk.Context, sequence int64, packet IBCPacket) {
+	store := ctx.KVStore(ibcm.key)
+	store.Set(EgressKey(packet.DestChain, sequence), marshalBinaryPanic(ibcm.cdc, packet))
+}
+
+// prefixes of the keys of the IBC store
+var (
+	egressPrefix  = []byte("egress/")
+	ingressPrefix = []byte("ingress/")
+	acksPrefix    = []byte("acks/")
+	clientsPrefix = []byte("clients/")
+)
+
+// split a key of the form "prefix/chain_id/index" into the chain ID and the
+// index
+func splitSequenceKey(key []byte, prefix []byte) (chainID string, index int64, ok bool) {
+	rest := string(key[len(prefix):])
+	i := strings.LastIndex(rest, "/")
+	if i <= 0 {
+		return "", 0, false
+	}
+	index, err := strconv.ParseInt(rest[i+1:], 10, 64)
+	if err != nil {
+		return "", 0, false
+	}
+	return rest[:i], index, true
+}
+
+// split a key of the form "clients/chain_id/roots/height" into the chain ID
+// and the height
+func splitCommitmentRootKey(key []byte) (chainID string, height int64, ok bool) {
+	chainRoots, height, ok := splitSequenceKey(key, clientsPrefix)
+	if !ok || !strings.HasSuffix(chainRoots, "/roots") {
+		return "", 0, false
+	}
+	return strings.TrimSuffix(chainRoots, "/roots"), height, true
+}
+
 // Stores an outgoing IBC packet under "egress/chain_id/index".
 func EgressKey(destChain string, index int64) []byte {
 	return []byte(fmt.Sprintf("egress/%s/%d", destChain, index))
@@ -128,3 +473,21 @@ func EgressLengthKey(destChain string) []byte {
 func IngressSequenceKey(srcChain string) []byte {
 	return []byte(fmt.Sprintf("ingress/%s", srcChain))
 }
+
+// Stores the addresses allowed to create light clients under "clientcreators".
+var ClientCreatorsKey = []byte("clientcreators")
+
+// Stores the acknowledgement of an incoming IBC packet under "acks/chain_id/index".
+func AcknowledgementKey(srcChain string, index int64) []byte {
+	return []byte(fmt.Sprintf("acks/%s/%d", srcChain, index))
+}
+
+// Stores the light client of a counterparty chain under "clients/chain_id".
+func ClientKey(chainID string) []byte {
+	return []byte(fmt.Sprintf("clients/%s", chainID))
+}
+
+// Stores the app hash of a tracked header under "clients/chain_id/roots/height".
+func CommitmentRootKey(chainID string, height int64) []byte {
+	return []byte(fmt.Sprintf("clients/%s/roots/%d", chainID, height))
+}
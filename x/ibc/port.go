@@ -0,0 +1,22 @@
+package ibc
+
+import (
+	"regexp"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PortHandler processes the IBC packets of a port, registered on the IBC
+// mapper by the module owning the port.
+type PortHandler interface {
+	// OnReceive processes a packet received on the port. If it fails, its
+	// state changes are discarded and the error is acknowledged to the source
+	// chain.
+	OnReceive(ctx sdk.Context, packet IBCPacket) sdk.Error
+
+	// OnRefund reverts a packet sent from the port which timed out or failed
+	// on the destination chain.
+	OnRefund(ctx sdk.Context, packet IBCPacket) sdk.Error
+}
+
+var isAlphaNumeric = regexp.MustCompile(`^[a-zA-Z0-9]+$`).MatchString
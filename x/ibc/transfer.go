@@ -0,0 +1,164 @@
+package ibc
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+// PortTransfer is the port of the fungible token transfers between chains.
+const PortTransfer = "transfer"
+
+// EscrowAddress returns the address escrowing the tokens sent to the chain
+// which are not vouchers of tokens from that chain. They are released when
+// the vouchers minted on that chain are sent back.
+func EscrowAddress(chainID string) sdk.AccAddress {
+	return auth.NewModuleAddress(fmt.Sprintf("ibc/%s/%s", PortTransfer, chainID))
+}
+
+// VoucherPrefix returns the prefix of the denominations of the vouchers minted
+// for the tokens received from the chain (ex. transfer/<chain-id>/atom).
+func VoucherPrefix(chainID string) string {
+	return fmt.Sprintf("%s/%s/", PortTransfer, chainID)
+}
+
+// split the coins between the vouchers of tokens from the chain and the others
+func splitVouchers(coins sdk.Coins, chainID string) (vouchers, others sdk.Coins) {
+	prefix := VoucherPrefix(chainID)
+	for _, coin := range coins {
+		if strings.HasPrefix(coin.Denom, prefix) {
+			vouchers = append(vouchers, coin)
+		} else {
+			others = append(others, coin)
+		}
+	}
+	return
+}
+
+// TransferPacketData is the payload of the packets of the transfer port.
+type TransferPacketData struct {
+	SrcAddr  sdk.AccAddress
+	DestAddr sdk.AccAddress
+	Coins    sdk.Coins
+}
+
+// GetBytes returns the encoded payload of the transfer packet.
+func (data TransferPacketData) GetBytes() []byte {
+	return msgCdc.MustMarshalBinary(data)
+}
+
+// Send the coins from the source address: vouchers returning to the chain they
+// came from are burned, any other tokens are escrowed for the destination chain
+// unless they may not be sent.
+func sendTransfer(ctx sdk.Context, ck bank.Keeper, data TransferPacketData, destChain string) sdk.Error {
+	vouchers, escrowed := splitVouchers(data.Coins, destChain)
+
+	for _, coin := range escrowed {
+		if !ck.IsSendEnabled(ctx, coin.Denom) {
+			return bank.ErrSendDisabled(ck.Codespace(), coin.Denom)
+		}
+	}
+
+	if len(vouchers) > 0 {
+		_, err := ck.BurnCoins(ctx, data.SrcAddr, vouchers)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(escrowed) > 0 {
+		_, err := ck.SendCoins(ctx, data.SrcAddr, EscrowAddress(destChain), escrowed)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// transfer port handler
+type transferHandler struct {
+	ck bank.Keeper
+}
+
+// NewTransferHandler returns the handler of the transfer port, which receives
+// the coins sent with IBCTransferMsg.
+func NewTransferHandler(ck bank.Keeper) PortHandler {
+	return transferHandler{ck}
+}
+
+// Receive the coins of the packet at the destination address: tokens of this
+// chain coming back are released from the escrow of the source chain, any
+// other tokens are minted as vouchers prefixed with the source chain. Packets
+// to a blocked address fail, so their coins are refunded.
+func (h transferHandler) OnReceive(ctx sdk.Context, packet IBCPacket) sdk.Error {
+	var data TransferPacketData
+	if err := msgCdc.UnmarshalBinary(packet.Payload, &data); err != nil {
+		return sdk.ErrTxDecode(err.Error())
+	}
+
+	if h.ck.IsBlockedAddr(ctx, data.DestAddr) {
+		return bank.ErrBlockedAddr(h.ck.Codespace(), data.DestAddr)
+	}
+
+	returning, foreign := splitVouchers(data.Coins, packet.DestChain)
+
+	if len(returning) > 0 {
+		prefix := VoucherPrefix(packet.DestChain)
+		released := make(sdk.Coins, len(returning))
+		for i, coin := range returning {
+			released[i] = sdk.NewCoin(strings.TrimPrefix(coin.Denom, prefix), coin.Amount)
+		}
+
+		_, err := h.ck.SendCoins(ctx, EscrowAddress(packet.SrcChain), data.DestAddr, released.Sort())
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(foreign) > 0 {
+		prefix := VoucherPrefix(packet.SrcChain)
+		vouchers := make(sdk.Coins, len(foreign))
+		for i, coin := range foreign {
+			vouchers[i] = sdk.NewCoin(prefix+coin.Denom, coin.Amount)
+		}
+
+		_, err := h.ck.MintCoins(ctx, data.DestAddr, vouchers.Sort())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Refund the coins of a packet which wasn't received by the destination chain:
+// the escrowed tokens are released and the burned vouchers are minted back to
+// the source address.
+func (h transferHandler) OnRefund(ctx sdk.Context, packet IBCPacket) sdk.Error {
+	var data TransferPacketData
+	if err := msgCdc.UnmarshalBinary(packet.Payload, &data); err != nil {
+		return sdk.ErrTxDecode(err.Error())
+	}
+
+	vouchers, escrowed := splitVouchers(data.Coins, packet.DestChain)
+
+	if len(vouchers) > 0 {
+		_, err := h.ck.MintCoins(ctx, data.SrcAddr, vouchers)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(escrowed) > 0 {
+		_, err := h.ck.SendCoins(ctx, EscrowAddress(packet.DestChain), data.SrcAddr, escrowed)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -3,6 +3,8 @@ package ibc
 import (
 	"encoding/json"
 
+	tmtypes "github.com/tendermint/tendermint/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	wire "github.com/cosmos/cosmos-sdk/wire"
 )
@@ -13,6 +15,7 @@ var (
 
 func init() {
 	msgCdc = wire.NewCodec()
+	wire.RegisterCrypto(msgCdc)
 }
 
 // ------------------------------
@@ -20,24 +23,29 @@ func init() {
 
 // nolint - TODO rename to Packet as IBCPacket stutters (golint)
 // IBCPacket defines a piece of data that can be send between two separate
-// blockchains.
+// blockchains. The opaque payload is sent by the module of the source port and
+// processed by the module of the destination port. A packet with a
+// TimeoutHeight can only be received before that height of the destination
+// chain, and is refunded on the source chain after.
 type IBCPacket struct {
-	SrcAddr   sdk.AccAddress
-	DestAddr  sdk.AccAddress
-	Coins     sdk.Coins
-	SrcChain  string
-	DestChain string
+	SrcPort       string
+	DestPort      string
+	SrcChain      string
+	DestChain     string
+	Payload       []byte
+	TimeoutHeight int64
 }
 
-func NewIBCPacket(srcAddr sdk.AccAddress, destAddr sdk.AccAddress, coins sdk.Coins,
-	srcChain string, destChain string) IBCPacket {
+func NewIBCPacket(srcPort string, destPort string, srcChain string, destChain string,
+	payload []byte, timeoutHeight int64) IBCPacket {
 
 	return IBCPacket{
-		SrcAddr:   srcAddr,
-		DestAddr:  destAddr,
-		Coins:     coins,
-		SrcChain:  srcChain,
-		DestChain: destChain,
+		SrcPort:       srcPort,
+		DestPort:      destPort,
+		SrcChain:      srcChain,
+		DestChain:     destChain,
+		Payload:       payload,
+		TimeoutHeight: timeoutHeight,
 	}
 }
 
@@ -55,19 +63,56 @@ func (p IBCPacket) ValidateBasic() sdk.Error {
 	if p.SrcChain == p.DestChain {
 		return ErrIdenticalChains(DefaultCodespace).TraceSDK("")
 	}
-	if !p.Coins.IsValid() {
-		return sdk.ErrInvalidCoins("")
+	if !isAlphaNumeric(p.SrcPort) {
+		return ErrUnknownPort(DefaultCodespace, p.SrcPort)
+	}
+	if !isAlphaNumeric(p.DestPort) {
+		return ErrUnknownPort(DefaultCodespace, p.DestPort)
+	}
+	if p.TimeoutHeight < 0 {
+		return ErrInvalidTimeout(DefaultCodespace, "negative timeout height")
 	}
 	return nil
 }
 
+// ------------------------------
+// Acknowledgement
+
+// Acknowledgement is written by the destination chain when it receives an
+// IBC packet, with the code of the error if the packet couldn't be processed.
+type Acknowledgement struct {
+	Code sdk.ABCICodeType `json:"code"`
+}
+
+// IsOK returns whether the packet was processed successfully.
+func (ack Acknowledgement) IsOK() bool {
+	return ack.Code == sdk.ABCICodeOK
+}
+
 // ----------------------------------
 // IBCTransferMsg
 
 // nolint - TODO rename to TransferMsg as folks will reference with ibc.TransferMsg
-// IBCTransferMsg defines how another module can send an IBCPacket.
+// IBCTransferMsg sends coins to an address of the destination chain, in an
+// IBCPacket of the transfer port.
 type IBCTransferMsg struct {
-	IBCPacket
+	TransferPacketData
+	DestChain     string
+	TimeoutHeight int64
+}
+
+func NewIBCTransferMsg(srcAddr sdk.AccAddress, destAddr sdk.AccAddress, coins sdk.Coins,
+	destChain string, timeoutHeight int64) IBCTransferMsg {
+
+	return IBCTransferMsg{
+		TransferPacketData: TransferPacketData{
+			SrcAddr:  srcAddr,
+			DestAddr: destAddr,
+			Coins:    coins,
+		},
+		DestChain:     destChain,
+		TimeoutHeight: timeoutHeight,
+	}
 }
 
 // nolint
@@ -78,12 +123,28 @@ func (msg IBCTransferMsg) GetSigners() []sdk.AccAddress { return []sdk.AccAddres
 
 // get the sign bytes for ibc transfer message
 func (msg IBCTransferMsg) GetSignBytes() []byte {
-	return msg.IBCPacket.GetSignBytes()
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
 }
 
 // validate ibc transfer message
 func (msg IBCTransferMsg) ValidateBasic() sdk.Error {
-	return msg.IBCPacket.ValidateBasic()
+	if len(msg.SrcAddr) == 0 || len(msg.DestAddr) == 0 {
+		return sdk.ErrInvalidAddress("missing address")
+	}
+	if !msg.Coins.IsValid() || msg.Coins.IsZero() {
+		return sdk.ErrInvalidCoins(msg.Coins.String())
+	}
+	if len(msg.DestChain) == 0 {
+		return ErrInvalidDestChain(DefaultCodespace, msg.DestChain)
+	}
+	if msg.TimeoutHeight < 0 {
+		return ErrInvalidTimeout(DefaultCodespace, "negative timeout height")
+	}
+	return nil
 }
 
 // ----------------------------------
@@ -91,11 +152,15 @@ func (msg IBCTransferMsg) ValidateBasic() sdk.Error {
 
 // nolint - TODO rename to ReceiveMsg as folks will reference with ibc.ReceiveMsg
 // IBCReceiveMsg defines the message that a relayer uses to post an IBCPacket
-// to the destination chain.
+// to the destination chain. It carries the proof of the packet in the egress
+// queue of the source chain, against the app hash of the source chain header
+// at ProofHeight tracked by the light client.
 type IBCReceiveMsg struct {
 	IBCPacket
-	Relayer  sdk.AccAddress
-	Sequence int64
+	Relayer     sdk.AccAddress
+	Sequence    int64
+	Proof       []byte
+	ProofHeight int64
 }
 
 // nolint
@@ -108,16 +173,222 @@ func (msg IBCReceiveMsg) GetSigners() []sdk.AccAddress { return []sdk.AccAddress
 // get the sign bytes for ibc receive message
 func (msg IBCReceiveMsg) GetSignBytes() []byte {
 	b, err := msgCdc.MarshalJSON(struct {
-		IBCPacket json.RawMessage
-		Relayer   sdk.AccAddress
-		Sequence  int64
+		IBCPacket   json.RawMessage
+		Relayer     sdk.AccAddress
+		Sequence    int64
+		Proof       []byte
+		ProofHeight int64
 	}{
-		IBCPacket: json.RawMessage(msg.IBCPacket.GetSignBytes()),
-		Relayer:   msg.Relayer,
-		Sequence:  msg.Sequence,
+		IBCPacket:   json.RawMessage(msg.IBCPacket.GetSignBytes()),
+		Relayer:     msg.Relayer,
+		Sequence:    msg.Sequence,
+		Proof:       msg.Proof,
+		ProofHeight: msg.ProofHeight,
 	})
 	if err != nil {
 		panic(err)
 	}
 	return sdk.MustSortJSON(b)
 }
+
+// ----------------------------------
+// MsgCreateClient
+
+// MsgCreateClient creates the light client of a counterparty chain, trusting
+// the header and validator set of one of its blocks.
+type MsgCreateClient struct {
+	Signer       sdk.AccAddress        `json:"signer"`
+	Header       tmtypes.Header        `json:"header"`
+	ValidatorSet *tmtypes.ValidatorSet `json:"validator_set"`
+}
+
+func NewMsgCreateClient(signer sdk.AccAddress, header tmtypes.Header, valset *tmtypes.ValidatorSet) MsgCreateClient {
+	return MsgCreateClient{
+		Signer:       signer,
+		Header:       header,
+		ValidatorSet: valset,
+	}
+}
+
+// nolint
+func (msg MsgCreateClient) Type() string                 { return "ibc" }
+func (msg MsgCreateClient) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Signer} }
+
+// get the sign bytes for create client message
+func (msg MsgCreateClient) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgCreateClient) ValidateBasic() sdk.Error {
+	if len(msg.Signer) == 0 {
+		return sdk.ErrInvalidAddress("missing signer address")
+	}
+	if len(msg.Header.ChainID) == 0 {
+		return ErrInvalidHeader(DefaultCodespace, "missing chain ID")
+	}
+	if msg.ValidatorSet == nil || msg.ValidatorSet.Size() == 0 {
+		return ErrInvalidHeader(DefaultCodespace, "empty validator set")
+	}
+	return nil
+}
+
+// ----------------------------------
+// MsgUpdateClient
+
+// MsgUpdateClient updates the light client of a counterparty chain with a
+// new header, committed by the validator set of the header.
+type MsgUpdateClient struct {
+	Signer       sdk.AccAddress        `json:"signer"`
+	Header       tmtypes.Header        `json:"header"`
+	Commit       tmtypes.Commit        `json:"commit"`
+	ValidatorSet *tmtypes.ValidatorSet `json:"validator_set"`
+}
+
+func NewMsgUpdateClient(signer sdk.AccAddress, header tmtypes.Header, commit tmtypes.Commit, valset *tmtypes.ValidatorSet) MsgUpdateClient {
+	return MsgUpdateClient{
+		Signer:       signer,
+		Header:       header,
+		Commit:       commit,
+		ValidatorSet: valset,
+	}
+}
+
+// nolint
+func (msg MsgUpdateClient) Type() string                 { return "ibc" }
+func (msg MsgUpdateClient) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Signer} }
+
+// get the sign bytes for update client message
+func (msg MsgUpdateClient) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgUpdateClient) ValidateBasic() sdk.Error {
+	if len(msg.Signer) == 0 {
+		return sdk.ErrInvalidAddress("missing signer address")
+	}
+	if len(msg.Header.ChainID) == 0 {
+		return ErrInvalidHeader(DefaultCodespace, "missing chain ID")
+	}
+	if msg.ValidatorSet == nil || msg.ValidatorSet.Size() == 0 {
+		return ErrInvalidHeader(DefaultCodespace, "empty validator set")
+	}
+	if len(msg.Commit.Precommits) == 0 {
+		return ErrInvalidHeader(DefaultCodespace, "empty commit")
+	}
+	return nil
+}
+
+// ----------------------------------
+// MsgAcknowledgement
+
+// MsgAcknowledgement relays the acknowledgement of an IBC packet written by
+// the destination chain back to the source chain, with its proof against the
+// app hash of the destination chain header at ProofHeight. The source chain
+// refunds the packet if it couldn't be processed.
+type MsgAcknowledgement struct {
+	IBCPacket       `json:"packet"`
+	Relayer         sdk.AccAddress  `json:"relayer"`
+	Sequence        int64           `json:"sequence"`
+	Acknowledgement Acknowledgement `json:"acknowledgement"`
+	Proof           []byte          `json:"proof"`
+	ProofHeight     int64           `json:"proof_height"`
+}
+
+func NewMsgAcknowledgement(relayer sdk.AccAddress, packet IBCPacket, sequence int64, ack Acknowledgement,
+	proof []byte, proofHeight int64) MsgAcknowledgement {
+
+	return MsgAcknowledgement{
+		IBCPacket:       packet,
+		Relayer:         relayer,
+		Sequence:        sequence,
+		Acknowledgement: ack,
+		Proof:           proof,
+		ProofHeight:     proofHeight,
+	}
+}
+
+// nolint
+func (msg MsgAcknowledgement) Type() string                 { return "ibc" }
+func (msg MsgAcknowledgement) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Relayer} }
+
+// get the sign bytes for acknowledgement message
+func (msg MsgAcknowledgement) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgAcknowledgement) ValidateBasic() sdk.Error {
+	if len(msg.Relayer) == 0 {
+		return sdk.ErrInvalidAddress("missing relayer address")
+	}
+	if len(msg.Proof) == 0 {
+		return ErrInvalidProof(DefaultCodespace, "missing proof")
+	}
+	return msg.IBCPacket.ValidateBasic()
+}
+
+// ----------------------------------
+// MsgTimeout
+
+// MsgTimeout proves an IBC packet was not received by the destination chain
+// before its timeout height, so that the source chain refunds it. The
+// proof of the absence of the acknowledgement of the packet is against the app
+// hash of a destination chain header at ProofHeight, past the timeout height.
+type MsgTimeout struct {
+	IBCPacket   `json:"packet"`
+	Relayer     sdk.AccAddress `json:"relayer"`
+	Sequence    int64          `json:"sequence"`
+	Proof       []byte         `json:"proof"`
+	ProofHeight int64          `json:"proof_height"`
+}
+
+func NewMsgTimeout(relayer sdk.AccAddress, packet IBCPacket, sequence int64, proof []byte, proofHeight int64) MsgTimeout {
+	return MsgTimeout{
+		IBCPacket:   packet,
+		Relayer:     relayer,
+		Sequence:    sequence,
+		Proof:       proof,
+		ProofHeight: proofHeight,
+	}
+}
+
+// nolint
+func (msg MsgTimeout) Type() string                 { return "ibc" }
+func (msg MsgTimeout) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Relayer} }
+
+// get the sign bytes for timeout message
+func (msg MsgTimeout) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgTimeout) ValidateBasic() sdk.Error {
+	if len(msg.Relayer) == 0 {
+		return sdk.ErrInvalidAddress("missing relayer address")
+	}
+	if len(msg.Proof) == 0 {
+		return ErrInvalidProof(DefaultCodespace, "missing proof")
+	}
+	if msg.TimeoutHeight == 0 {
+		return ErrInvalidTimeout(DefaultCodespace, "packet has no timeout height")
+	}
+	return msg.IBCPacket.ValidateBasic()
+}
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -18,6 +20,9 @@ func TestIBCPacketValidation(t *testing.T) {
 	}{
 		{true, constructIBCPacket(true)},
 		{false, constructIBCPacket(false)},
+		{false, NewIBCPacket("", PortTransfer, "source-chain", "dest-chain", nil, 0)},
+		{false, NewIBCPacket(PortTransfer, "transfer/port", "source-chain", "dest-chain", nil, 0)},
+		{false, NewIBCPacket(PortTransfer, PortTransfer, "source-chain", "dest-chain", nil, -1)},
 	}
 
 	for i, tc := range cases {
@@ -34,22 +39,24 @@ func TestIBCPacketValidation(t *testing.T) {
 // IBCTransferMsg Tests
 
 func TestIBCTransferMsg(t *testing.T) {
-	packet := constructIBCPacket(true)
-	msg := IBCTransferMsg{packet}
+	msg := constructIBCTransferMsg(sdk.Coins{sdk.NewInt64Coin("atom", 10)}, "dest-chain", 0)
 
 	require.Equal(t, msg.Type(), "ibc")
 }
 
 func TestIBCTransferMsgValidation(t *testing.T) {
-	validPacket := constructIBCPacket(true)
-	invalidPacket := constructIBCPacket(false)
+	coins := sdk.Coins{sdk.NewInt64Coin("atom", 10)}
 
 	cases := []struct {
 		valid bool
 		msg   IBCTransferMsg
 	}{
-		{true, IBCTransferMsg{validPacket}},
-		{false, IBCTransferMsg{invalidPacket}},
+		{true, constructIBCTransferMsg(coins, "dest-chain", 0)},
+		{true, constructIBCTransferMsg(coins, "dest-chain", 10)},
+		{false, constructIBCTransferMsg(nil, "dest-chain", 0)},
+		{false, constructIBCTransferMsg(coins, "", 0)},
+		{false, constructIBCTransferMsg(coins, "dest-chain", -1)},
+		{false, NewIBCTransferMsg(nil, sdk.AccAddress([]byte("destination")), coins, "dest-chain", 0)},
 	}
 
 	for i, tc := range cases {
@@ -67,7 +74,7 @@ func TestIBCTransferMsgValidation(t *testing.T) {
 
 func TestIBCReceiveMsg(t *testing.T) {
 	packet := constructIBCPacket(true)
-	msg := IBCReceiveMsg{packet, sdk.AccAddress([]byte("relayer")), 0}
+	msg := IBCReceiveMsg{packet, sdk.AccAddress([]byte("relayer")), 0, nil, 0}
 
 	require.Equal(t, msg.Type(), "ibc")
 }
@@ -80,8 +87,66 @@ func TestIBCReceiveMsgValidation(t *testing.T) {
 		valid bool
 		msg   IBCReceiveMsg
 	}{
-		{true, IBCReceiveMsg{validPacket, sdk.AccAddress([]byte("relayer")), 0}},
-		{false, IBCReceiveMsg{invalidPacket, sdk.AccAddress([]byte("relayer")), 0}},
+		{true, IBCReceiveMsg{validPacket, sdk.AccAddress([]byte("relayer")), 0, nil, 0}},
+		{false, IBCReceiveMsg{invalidPacket, sdk.AccAddress([]byte("relayer")), 0, nil, 0}},
+	}
+
+	for i, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err, "%d: %+v", i, err)
+		} else {
+			require.NotNil(t, err, "%d", i)
+		}
+	}
+}
+
+// -------------------------------
+// MsgTimeout Tests
+
+func TestMsgTimeoutValidation(t *testing.T) {
+	relayer := sdk.AccAddress([]byte("relayer"))
+	packet := constructIBCPacket(true)
+	timeoutPacket := packet
+	timeoutPacket.TimeoutHeight = 10
+
+	cases := []struct {
+		valid bool
+		msg   MsgTimeout
+	}{
+		{true, NewMsgTimeout(relayer, timeoutPacket, 0, []byte("proof"), 10)},
+		{false, NewMsgTimeout(nil, timeoutPacket, 0, []byte("proof"), 10)},
+		{false, NewMsgTimeout(relayer, timeoutPacket, 0, nil, 10)},
+		{false, NewMsgTimeout(relayer, packet, 0, []byte("proof"), 10)},
+		{false, NewMsgTimeout(relayer, constructIBCPacket(false), 0, []byte("proof"), 10)},
+	}
+
+	for i, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err, "%d: %+v", i, err)
+		} else {
+			require.NotNil(t, err, "%d", i)
+		}
+	}
+}
+
+// -------------------------------
+// MsgCreateClient Tests
+
+func TestMsgCreateClientValidation(t *testing.T) {
+	signer := sdk.AccAddress([]byte("signer"))
+	valset := tmtypes.NewValidatorSet([]*tmtypes.Validator{tmtypes.NewValidator(ed25519.GenPrivKey().PubKey(), 10)})
+	header := tmtypes.Header{ChainID: "source-chain", Height: 1, ValidatorsHash: valset.Hash()}
+
+	cases := []struct {
+		valid bool
+		msg   MsgCreateClient
+	}{
+		{true, NewMsgCreateClient(signer, header, valset)},
+		{false, NewMsgCreateClient(nil, header, valset)},
+		{false, NewMsgCreateClient(signer, tmtypes.Header{Height: 1}, valset)},
+		{false, NewMsgCreateClient(signer, header, nil)},
 	}
 
 	for i, tc := range cases {
@@ -101,11 +166,18 @@ func constructIBCPacket(valid bool) IBCPacket {
 	srcAddr := sdk.AccAddress([]byte("source"))
 	destAddr := sdk.AccAddress([]byte("destination"))
 	coins := sdk.Coins{sdk.NewInt64Coin("atom", 10)}
+	data := TransferPacketData{srcAddr, destAddr, coins}
 	srcChain := "source-chain"
 	destChain := "dest-chain"
 
 	if valid {
-		return NewIBCPacket(srcAddr, destAddr, coins, srcChain, destChain)
+		return NewIBCPacket(PortTransfer, PortTransfer, srcChain, destChain, data.GetBytes(), 0)
 	}
-	return NewIBCPacket(srcAddr, destAddr, coins, srcChain, srcChain)
+	return NewIBCPacket(PortTransfer, PortTransfer, srcChain, srcChain, data.GetBytes(), 0)
+}
+
+func constructIBCTransferMsg(coins sdk.Coins, destChain string, timeoutHeight int64) IBCTransferMsg {
+	srcAddr := sdk.AccAddress([]byte("source"))
+	destAddr := sdk.AccAddress([]byte("destination"))
+	return NewIBCTransferMsg(srcAddr, destAddr, coins, destChain, timeoutHeight)
 }
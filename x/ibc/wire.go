@@ -8,4 +8,8 @@ import (
 func RegisterWire(cdc *wire.Codec) {
 	cdc.RegisterConcrete(IBCTransferMsg{}, "cosmos-sdk/IBCTransferMsg", nil)
 	cdc.RegisterConcrete(IBCReceiveMsg{}, "cosmos-sdk/IBCReceiveMsg", nil)
+	cdc.RegisterConcrete(MsgCreateClient{}, "cosmos-sdk/MsgCreateClient", nil)
+	cdc.RegisterConcrete(MsgUpdateClient{}, "cosmos-sdk/MsgUpdateClient", nil)
+	cdc.RegisterConcrete(MsgAcknowledgement{}, "cosmos-sdk/MsgAcknowledgement", nil)
+	cdc.RegisterConcrete(MsgTimeout{}, "cosmos-sdk/MsgTimeout", nil)
 }
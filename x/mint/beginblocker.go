@@ -0,0 +1,10 @@
+package mint
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker mints the provisions of every block
+func BeginBlocker(ctx sdk.Context, k Keeper) {
+	k.MintProvisions(ctx)
+}
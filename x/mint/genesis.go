@@ -0,0 +1,47 @@
+package mint
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState - all mint state that must be provided at genesis
+type GenesisState struct {
+	Params Params `json:"params"`
+	State  State  `json:"state"`
+}
+
+// NewGenesisState creates a new genesis state
+func NewGenesisState(params Params, state State) GenesisState {
+	return GenesisState{
+		Params: params,
+		State:  state,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state, starting at the
+// minimum inflation
+func DefaultGenesisState() GenesisState {
+	params := DefaultParams()
+	return GenesisState{
+		Params: params,
+		State:  InitialState(params.InflationMin),
+	}
+}
+
+// InitGenesis sets the parameters and the state of the minting
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) error {
+	if err := validateParams(data.Params); err != nil {
+		return err
+	}
+	k.SetParams(ctx, data.Params)
+	k.SetState(ctx, data.State)
+	return nil
+}
+
+// WriteGenesis returns the parameters and the state of the minting
+func WriteGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	return GenesisState{
+		Params: k.GetParams(ctx),
+		State:  k.GetState(ctx),
+	}
+}
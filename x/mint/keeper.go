@@ -0,0 +1,141 @@
+package mint
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+// name of the mint module account
+const ModuleName = "mint"
+
+// StakeKeeper - the staking functions used by the mint keeper
+type StakeKeeper interface {
+	BondedRatio(ctx sdk.Context) sdk.Dec
+	InflateSupply(ctx sdk.Context, minted sdk.Coins)
+}
+
+// Keeper of the mint store
+type Keeper struct {
+	storeKey    sdk.StoreKey
+	cdc         *wire.Codec
+	coinKeeper  bank.Keeper
+	stakeKeeper StakeKeeper
+	minter      Minter
+}
+
+// NewKeeper creates a mint keeper minting along the curve of the minter
+func NewKeeper(cdc *wire.Codec, key sdk.StoreKey, ck bank.Keeper, sk StakeKeeper, minter Minter) Keeper {
+	ck.RegisterModuleAccount(ModuleName, auth.Minter)
+	return Keeper{
+		storeKey:    key,
+		cdc:         cdc,
+		coinKeeper:  ck,
+		stakeKeeper: sk,
+		minter:      minter,
+	}
+}
+
+// GetParams returns the parameters of the minting
+func (k Keeper) GetParams(ctx sdk.Context) (params Params) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(ParamsKey)
+	if b == nil {
+		panic("stored params should not have been nil")
+	}
+	k.cdc.MustUnmarshalBinary(b, &params)
+	return
+}
+
+// SetParams sets the parameters of the minting
+func (k Keeper) SetParams(ctx sdk.Context, params Params) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinary(params)
+	store.Set(ParamsKey, b)
+}
+
+// GetState returns the state of the minting
+func (k Keeper) GetState(ctx sdk.Context) (state State) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(StateKey)
+	if b == nil {
+		panic("stored state should not have been nil")
+	}
+	k.cdc.MustUnmarshalBinary(b, &state)
+	return
+}
+
+// SetState sets the state of the minting
+func (k Keeper) SetState(ctx sdk.Context, state State) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinary(state)
+	store.Set(StateKey, b)
+}
+
+// get the time of the last block, if any block was processed
+func (k Keeper) getLastBlockTime(ctx sdk.Context) (blockTime time.Time, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(LastBlockTimeKey)
+	if b == nil {
+		return blockTime, false
+	}
+	k.cdc.MustUnmarshalBinary(b, &blockTime)
+	return blockTime, true
+}
+
+// set the time of the last block
+func (k Keeper) setLastBlockTime(ctx sdk.Context, blockTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinary(blockTime)
+	store.Set(LastBlockTimeKey, b)
+}
+
+// MintProvisions mints the provisions of the time elapsed since the last
+// block, and sends them to the fee collector module account, which must be
+// registered with the bank keeper. Nothing is minted for the first block, as
+// no time has elapsed yet.
+func (k Keeper) MintProvisions(ctx sdk.Context) sdk.Coins {
+	blockTime := ctx.BlockHeader().Time
+	lastBlockTime, found := k.getLastBlockTime(ctx)
+	k.setLastBlockTime(ctx, blockTime)
+	if !found || !blockTime.After(lastBlockTime) {
+		return nil
+	}
+
+	params := k.GetParams(ctx)
+	state := k.GetState(ctx)
+	status := Status{
+		Inflation:   state.Inflation,
+		Supply:      sdk.NewDecFromInt(k.coinKeeper.GetSupply(ctx, params.MintDenom)),
+		BondedRatio: k.stakeKeeper.BondedRatio(ctx),
+		Elapsed:     blockTime.Sub(lastBlockTime),
+	}
+	state.Inflation, state.AnnualProvisions = k.minter.NextInflation(ctx, params, status)
+
+	// mint whole coins, carrying the remaining fraction over to the next block
+	provisions := perElapsed(state.AnnualProvisions, status.Elapsed).Add(state.Remainder)
+	amount := provisions.RoundInt()
+	if amount.Sign() <= 0 {
+		state.Remainder = provisions
+		k.SetState(ctx, state)
+		return nil
+	}
+	state.Remainder = provisions.Sub(sdk.NewDecFromInt(amount))
+	k.SetState(ctx, state)
+
+	minted := sdk.Coins{sdk.NewCoin(params.MintDenom, amount)}
+	err := k.coinKeeper.MintModuleCoins(ctx, ModuleName, minted)
+	if err != nil {
+		panic(err)
+	}
+	feeCollector := k.coinKeeper.GetModuleAccount(ctx, auth.FeeCollectorName)
+	_, err = k.coinKeeper.SendCoinsFromModuleToAccount(ctx, ModuleName, feeCollector.GetAddress(), minted)
+	if err != nil {
+		panic(err)
+	}
+	k.stakeKeeper.InflateSupply(ctx, minted)
+	return minted
+}
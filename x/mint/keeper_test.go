@@ -0,0 +1,112 @@
+package mint
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/stake"
+)
+
+var (
+	addr      = sdk.AccAddress([]byte("addr1_______________"))
+	initCoins = sdk.NewInt(876600000)
+)
+
+func createTestInput(t *testing.T, minter Minter) (sdk.Context, bank.Keeper, stake.Keeper, Keeper) {
+	keyAcc := sdk.NewKVStoreKey("acc")
+	keyBank := sdk.NewKVStoreKey("bank")
+	keyStake := sdk.NewKVStoreKey("stake")
+	keyMint := sdk.NewKVStoreKey("mint")
+	keyParams := sdk.NewKVStoreKey("params")
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyBank, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyStake, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyMint, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	err := ms.LoadLatestVersion()
+	require.Nil(t, err)
+	ctx := sdk.NewContext(ms, abci.Header{Time: time.Unix(0, 0)}, false, log.NewTMLogger(os.Stdout))
+
+	cdc := wire.NewCodec()
+	auth.RegisterWire(cdc)
+	bank.RegisterWire(cdc)
+	stake.RegisterWire(cdc)
+	wire.RegisterCrypto(cdc)
+
+	accountMapper := auth.NewAccountMapper(cdc, keyAcc, auth.ProtoBaseAccount)
+	params := params.NewKeeper(cdc, keyParams)
+	ck := bank.NewKeeper(cdc, keyBank, accountMapper, params.Setter(), bank.DefaultCodespace)
+	ck.RegisterModuleAccount(auth.FeeCollectorName)
+	sk := stake.NewKeeper(cdc, keyStake, ck, stake.DefaultCodespace)
+	keeper := NewKeeper(cdc, keyMint, ck, sk, minter)
+
+	stakeGenesis := stake.DefaultGenesisState()
+	stakeGenesis.Pool.LooseTokens = sdk.NewDecFromInt(initCoins)
+	_, err = stake.InitGenesis(ctx, sk, stakeGenesis)
+	require.Nil(t, err)
+	_, err = ck.MintCoins(ctx, addr, sdk.Coins{sdk.NewCoin("steak", initCoins)})
+	require.Nil(t, err)
+	err = InitGenesis(ctx, keeper, DefaultGenesisState())
+	require.Nil(t, err)
+
+	return ctx, ck, sk, keeper
+}
+
+func TestMintProvisions(t *testing.T) {
+	// 10000 steak minted every hour
+	ctx, ck, sk, keeper := createTestInput(t, HalvingMinter{sdk.NewDec(87660000), 1000000})
+	feeCollector := auth.NewModuleAddress(auth.FeeCollectorName)
+
+	// nothing is minted for the first block
+	minted := keeper.MintProvisions(ctx)
+	require.True(t, minted.IsZero())
+
+	// mint the provisions of an hour
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(0, 0).Add(time.Hour)})
+	minted = keeper.MintProvisions(ctx)
+	expected := sdk.Coins{sdk.NewInt64Coin("steak", 10000)}
+	require.True(t, minted.IsEqual(expected), "%v", minted)
+	require.True(t, ck.GetCoins(ctx, feeCollector).IsEqual(expected))
+	require.True(t, initCoins.AddRaw(10000).Equal(ck.GetSupply(ctx, "steak")))
+	require.True(sdk.DecEq(t, sdk.NewDecFromInt(initCoins.AddRaw(10000)), sk.GetPool(ctx).TokenSupply()))
+
+	// mint the provisions of another hour by the second, carrying over the
+	// fractions of coins
+	for i := 1; i <= 3600; i++ {
+		ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(0, 0).Add(time.Hour + time.Duration(i)*time.Second)})
+		keeper.MintProvisions(ctx)
+	}
+	expected = sdk.Coins{sdk.NewInt64Coin("steak", 20000)}
+	require.True(t, ck.GetCoins(ctx, feeCollector).IsEqual(expected), "%v", ck.GetCoins(ctx, feeCollector))
+	require.True(sdk.DecEq(t, sdk.NewDecFromInt(initCoins.AddRaw(20000)), sk.GetPool(ctx).TokenSupply()))
+}
+
+func TestMintProvisionsBondedRatio(t *testing.T) {
+	ctx, ck, _, keeper := createTestInput(t, BondedRatioMinter{})
+	params := keeper.GetParams(ctx)
+	keeper.MintProvisions(ctx)
+
+	// nothing is bonded, so the inflation increases from the minimum
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(0, 0).Add(time.Hour)})
+	minted := keeper.MintProvisions(ctx)
+	state := keeper.GetState(ctx)
+	require.True(t, state.Inflation.GT(params.InflationMin))
+	require.True(sdk.DecEq(t, state.Inflation.Mul(sdk.NewDecFromInt(initCoins)), state.AnnualProvisions))
+	require.True(t, minted.AmountOf("steak").GT(sdk.ZeroInt()))
+	require.True(t, initCoins.Add(minted.AmountOf("steak")).Equal(ck.GetSupply(ctx, "steak")))
+}
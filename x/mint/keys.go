@@ -0,0 +1,8 @@
+package mint
+
+// nolint
+var (
+	ParamsKey        = []byte{0x00} // key for the parameters of the minting
+	StateKey         = []byte{0x01} // key for the state of the minting
+	LastBlockTimeKey = []byte{0x02} // key for the time of the last block
+)
@@ -0,0 +1,136 @@
+package mint
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// year as defined by a julian year of 365.25 days
+const year = 8766 * time.Hour
+
+// State - state of the minting, updated every block
+type State struct {
+	Inflation        sdk.Dec `json:"inflation"`         // current annual inflation rate
+	AnnualProvisions sdk.Dec `json:"annual_provisions"` // current annual provisions
+	Remainder        sdk.Dec `json:"remainder"`         // fraction of a coin left over from the provisions of the previous blocks
+}
+
+// InitialState returns the state of the minting with the initial inflation
+func InitialState(inflation sdk.Dec) State {
+	return State{
+		Inflation:        inflation,
+		AnnualProvisions: sdk.ZeroDec(),
+		Remainder:        sdk.ZeroDec(),
+	}
+}
+
+// Status - status of the chain from which a Minter computes the inflation of
+// a block
+type Status struct {
+	Inflation   sdk.Dec       // annual inflation rate of the last block
+	Supply      sdk.Dec       // total supply of the mint denom
+	BondedRatio sdk.Dec       // ratio of the supply of the bond denom which is bonded
+	Elapsed     time.Duration // time elapsed since the last block
+}
+
+// Minter computes the inflation curve of the mint denom. Apps choose the
+// curve of their chain by passing a Minter to the mint keeper.
+type Minter interface {
+	// NextInflation returns the annual inflation rate and the annual
+	// provisions for the block
+	NextInflation(ctx sdk.Context, params Params, status Status) (inflation, annualProvisions sdk.Dec)
+}
+
+// scale an annual amount down to the elapsed time
+func perElapsed(annual sdk.Dec, elapsed time.Duration) sdk.Dec {
+	return annual.Mul(sdk.NewDec(int64(elapsed))).Quo(sdk.NewDec(int64(year)))
+}
+
+//_______________________________________________________________________
+
+// FixedSupplyMinter never mints any coins
+type FixedSupplyMinter struct{}
+
+var _ Minter = FixedSupplyMinter{}
+
+// Implements Minter.
+func (m FixedSupplyMinter) NextInflation(_ sdk.Context, _ Params, _ Status) (inflation, annualProvisions sdk.Dec) {
+	return sdk.ZeroDec(), sdk.ZeroDec()
+}
+
+//_______________________________________________________________________
+
+// FixedRateMinter inflates the supply at a constant annual rate
+type FixedRateMinter struct {
+	Rate sdk.Dec
+}
+
+var _ Minter = FixedRateMinter{}
+
+// Implements Minter.
+func (m FixedRateMinter) NextInflation(_ sdk.Context, _ Params, status Status) (inflation, annualProvisions sdk.Dec) {
+	return m.Rate, m.Rate.Mul(status.Supply)
+}
+
+//_______________________________________________________________________
+
+// HalvingMinter mints constant annual provisions, which are halved every
+// HalvingBlocks blocks
+type HalvingMinter struct {
+	InitialProvisions sdk.Dec
+	HalvingBlocks     int64
+}
+
+var _ Minter = HalvingMinter{}
+
+// Implements Minter.
+func (m HalvingMinter) NextInflation(ctx sdk.Context, _ Params, status Status) (inflation, annualProvisions sdk.Dec) {
+	halvings := int64(0)
+	if m.HalvingBlocks > 0 {
+		halvings = ctx.BlockHeight() / m.HalvingBlocks
+	}
+	if halvings > 62 {
+		return sdk.ZeroDec(), sdk.ZeroDec()
+	}
+	annualProvisions = m.InitialProvisions.Quo(sdk.NewDec(1 << uint(halvings)))
+
+	if status.Supply.IsZero() {
+		return sdk.ZeroDec(), annualProvisions
+	}
+	return annualProvisions.Quo(status.Supply), annualProvisions
+}
+
+//_______________________________________________________________________
+
+// BondedRatioMinter moves the inflation rate towards InflationMax while less
+// than GoalBonded of the supply is bonded, and towards InflationMin otherwise
+type BondedRatioMinter struct{}
+
+var _ Minter = BondedRatioMinter{}
+
+// Implements Minter.
+func (m BondedRatioMinter) NextInflation(_ sdk.Context, params Params, status Status) (inflation, annualProvisions sdk.Dec) {
+
+	// The inflation is subject to a rate change (positive or negative)
+	// depending on the distance from the desired ratio (67%). The maximum rate
+	// change possible is defined to be 13% per year, however the annual
+	// inflation is capped as between 7% and 20%.
+
+	// (1 - bondedRatio/GoalBonded) * InflationRateChange
+	inflationRateChangePerYear := sdk.OneDec().
+		Sub(status.BondedRatio.Quo(params.GoalBonded)).
+		Mul(params.InflationRateChange)
+	inflationRateChange := perElapsed(inflationRateChangePerYear, status.Elapsed)
+
+	// adjust the annual inflation for this block
+	inflation = status.Inflation.Add(inflationRateChange)
+	if inflation.GT(params.InflationMax) {
+		inflation = params.InflationMax
+	}
+	if inflation.LT(params.InflationMin) {
+		inflation = params.InflationMin
+	}
+
+	return inflation, inflation.Mul(status.Supply)
+}
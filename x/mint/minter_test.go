@@ -0,0 +1,110 @@
+package mint
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestBondedRatioMinter(t *testing.T) {
+	params := DefaultParams()
+	minter := BondedRatioMinter{}
+	hrsPerYr := sdk.NewDec(8766)
+
+	// Governing Mechanism:
+	//    BondedRatio = BondedTokens / TotalSupply
+	//    inflationRateChangePerYear = (1- BondedRatio/ GoalBonded) * MaxInflationRateChange
+
+	tests := []struct {
+		name string
+		bondedRatio,
+		setInflation, expectedChange sdk.Dec
+	}{
+		// with 0% bonded atom supply the inflation should increase by InflationRateChange
+		{"test 1", sdk.ZeroDec(), sdk.NewDecWithPrec(7, 2), params.InflationRateChange.Quo(hrsPerYr)},
+
+		// 100% bonded, starting at 20% inflation and being reduced
+		// (1 - (1/0.67))*(0.13/8667)
+		{"test 2", sdk.OneDec(), sdk.NewDecWithPrec(20, 2),
+			sdk.OneDec().Sub(sdk.OneDec().Quo(params.GoalBonded)).Mul(params.InflationRateChange).Quo(hrsPerYr)},
+
+		// 50% bonded, starting at 10% inflation and being increased
+		{"test 3", sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(10, 2),
+			sdk.OneDec().Sub(sdk.NewDecWithPrec(5, 1).Quo(params.GoalBonded)).Mul(params.InflationRateChange).Quo(hrsPerYr)},
+
+		// test 7% minimum stop (testing with 100% bonded)
+		{"test 4", sdk.OneDec(), sdk.NewDecWithPrec(7, 2), sdk.ZeroDec()},
+		{"test 5", sdk.OneDec(), sdk.NewDecWithPrec(70001, 6), sdk.NewDecWithPrec(-1, 6)},
+
+		// test 20% maximum stop (testing with 0% bonded)
+		{"test 6", sdk.ZeroDec(), sdk.NewDecWithPrec(20, 2), sdk.ZeroDec()},
+		{"test 7", sdk.ZeroDec(), sdk.NewDecWithPrec(199999, 6), sdk.NewDecWithPrec(1, 6)},
+
+		// perfect balance shouldn't change inflation
+		{"test 8", sdk.NewDecWithPrec(67, 2), sdk.NewDecWithPrec(15, 2), sdk.ZeroDec()},
+	}
+	for _, tc := range tests {
+		status := Status{
+			Inflation:   tc.setInflation,
+			Supply:      sdk.NewDec(1000),
+			BondedRatio: tc.bondedRatio,
+			Elapsed:     time.Hour,
+		}
+		inflation, provisions := minter.NextInflation(sdk.Context{}, params, status)
+		diffInflation := inflation.Sub(tc.setInflation)
+
+		require.True(t, diffInflation.Equal(tc.expectedChange),
+			"Name: %v\nDiff:  %v\nExpected: %v\n", tc.name, diffInflation, tc.expectedChange)
+		require.True(sdk.DecEq(t, inflation.Mul(status.Supply), provisions))
+	}
+}
+
+func TestFixedMinters(t *testing.T) {
+	params := DefaultParams()
+	status := Status{
+		Inflation:   sdk.NewDecWithPrec(7, 2),
+		Supply:      sdk.NewDec(1000),
+		BondedRatio: sdk.NewDecWithPrec(5, 1),
+		Elapsed:     time.Hour,
+	}
+
+	inflation, provisions := FixedSupplyMinter{}.NextInflation(sdk.Context{}, params, status)
+	require.True(sdk.DecEq(t, sdk.ZeroDec(), inflation))
+	require.True(sdk.DecEq(t, sdk.ZeroDec(), provisions))
+
+	inflation, provisions = FixedRateMinter{sdk.NewDecWithPrec(3, 2)}.NextInflation(sdk.Context{}, params, status)
+	require.True(sdk.DecEq(t, sdk.NewDecWithPrec(3, 2), inflation))
+	require.True(sdk.DecEq(t, sdk.NewDec(30), provisions))
+}
+
+func TestHalvingMinter(t *testing.T) {
+	params := DefaultParams()
+	minter := HalvingMinter{sdk.NewDec(400), 100}
+	status := Status{
+		Inflation:   sdk.ZeroDec(),
+		Supply:      sdk.NewDec(1000),
+		BondedRatio: sdk.ZeroDec(),
+		Elapsed:     time.Hour,
+	}
+
+	tests := []struct {
+		height                            int64
+		expInflation, expAnnualProvisions sdk.Dec
+	}{
+		{0, sdk.NewDecWithPrec(4, 1), sdk.NewDec(400)},
+		{99, sdk.NewDecWithPrec(4, 1), sdk.NewDec(400)},
+		{100, sdk.NewDecWithPrec(2, 1), sdk.NewDec(200)},
+		{250, sdk.NewDecWithPrec(1, 1), sdk.NewDec(100)},
+		{10000, sdk.ZeroDec(), sdk.ZeroDec()},
+	}
+	for _, tc := range tests {
+		ctx := sdk.NewContext(nil, abci.Header{Height: tc.height}, false, nil)
+		inflation, provisions := minter.NextInflation(ctx, params, status)
+		require.True(t, tc.expInflation.Equal(inflation), "height %d: expected %v, got %v", tc.height, tc.expInflation, inflation)
+		require.True(t, tc.expAnnualProvisions.Equal(provisions), "height %d: expected %v, got %v", tc.height, tc.expAnnualProvisions, provisions)
+	}
+}
@@ -0,0 +1,42 @@
+package mint
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Params defines the parameters of the minting. The inflation parameters are
+// used by the BondedRatioMinter, other minters may ignore them.
+type Params struct {
+	MintDenom           string  `json:"mint_denom"`            // denomination of the minted coins
+	InflationRateChange sdk.Dec `json:"inflation_rate_change"` // maximum annual change in inflation rate
+	InflationMax        sdk.Dec `json:"inflation_max"`         // maximum inflation rate
+	InflationMin        sdk.Dec `json:"inflation_min"`         // minimum inflation rate
+	GoalBonded          sdk.Dec `json:"goal_bonded"`           // goal of percent bonded atoms
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return Params{
+		MintDenom:           "steak",
+		InflationRateChange: sdk.NewDecWithPrec(13, 2),
+		InflationMax:        sdk.NewDecWithPrec(20, 2),
+		InflationMin:        sdk.NewDecWithPrec(7, 2),
+		GoalBonded:          sdk.NewDecWithPrec(67, 2),
+	}
+}
+
+func validateParams(params Params) error {
+	if len(params.MintDenom) == 0 {
+		return fmt.Errorf("mint denom cannot be empty")
+	}
+	if params.InflationMax.LT(params.InflationMin) {
+		return fmt.Errorf("maximum inflation %v cannot be less than the minimum inflation %v",
+			params.InflationMax, params.InflationMin)
+	}
+	if !params.GoalBonded.GT(sdk.ZeroDec()) {
+		return fmt.Errorf("goal bonded must be positive, is %v", params.GoalBonded)
+	}
+	return nil
+}
@@ -61,6 +61,7 @@ func NewApp() *App {
 		app.KeyAccount,
 		auth.ProtoBaseAccount,
 	)
+	app.FeeCollectionKeeper = auth.NewFeeCollectionKeeper(app.AccountMapper)
 
 	// Initialize the app. The chainers and blockers can be overwritten before
 	// calling complete setup.
@@ -140,7 +141,7 @@ func GenTx(msgs []sdk.Msg, accnums []int64, seq []int64, priv ...crypto.PrivKey)
 	memo := "testmemotestmemo"
 
 	for i, p := range priv {
-		sig, err := p.Sign(auth.StdSignBytes(chainID, accnums[i], seq[i], fee, msgs, memo))
+		sig, err := p.Sign(auth.StdSignBytes(chainID, accnums[i], seq[i], fee, msgs, memo, 0))
 		if err != nil {
 			panic(err)
 		}
@@ -153,7 +154,7 @@ func GenTx(msgs []sdk.Msg, accnums []int64, seq []int64, priv ...crypto.PrivKey)
 		}
 	}
 
-	return auth.NewStdTx(msgs, fee, sigs, memo)
+	return auth.NewStdTx(msgs, fee, sigs, memo, 0)
 }
 
 // GeneratePrivKeys generates a total n Ed25519 private keys.
@@ -11,6 +11,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire" // XXX fix
 	"github.com/cosmos/cosmos-sdk/x/slashing"
+	"github.com/cosmos/cosmos-sdk/x/stake"
+	staketypes "github.com/cosmos/cosmos-sdk/x/stake/types"
 )
 
 // GetCmdQuerySigningInfo implements the command to query signing info.
@@ -57,3 +59,90 @@ func GetCmdQuerySigningInfo(storeName string, cdc *wire.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryValidatorEvents implements the command to query the slash events
+// and the missed blocks of a validator.
+func GetCmdQueryValidatorEvents(stakeStoreName, slashingStoreName string, cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-events [validator-owner]",
+		Short: "Query the slash events and missed blocks of a validator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ownerAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			events, err := QueryValidatorEvents(cliCtx, cdc, stakeStoreName, slashingStoreName, ownerAddr)
+			if err != nil {
+				return err
+			}
+
+			switch viper.Get(cli.OutputFlag) {
+
+			case "text":
+				for _, event := range events.SlashEvents {
+					fmt.Println(event.HumanReadableString())
+				}
+				for _, missedBlock := range events.MissedBlocks {
+					fmt.Printf("Missed block at height %d (%v)\n", missedBlock.Height, missedBlock.Time)
+				}
+
+			case "json":
+				output, err := wire.MarshalJSONIndent(cdc, events)
+				if err != nil {
+					return err
+				}
+				fmt.Println(string(output))
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// QueryValidatorEvents queries the slash events and the missed blocks of the
+// validator with the owner address
+func QueryValidatorEvents(cliCtx context.CLIContext, cdc *wire.Codec, stakeStoreName, slashingStoreName string,
+	ownerAddr sdk.AccAddress) (events slashing.ValidatorEvents, err error) {
+
+	res, err := cliCtx.QueryStore(stake.GetValidatorKey(ownerAddr), stakeStoreName)
+	if err != nil {
+		return events, err
+	}
+	if len(res) == 0 {
+		return events, fmt.Errorf("no validator found with address %s", ownerAddr)
+	}
+	validator, err := staketypes.UnmarshalValidator(cdc, ownerAddr, res)
+	if err != nil {
+		return events, err
+	}
+
+	resKVs, err := cliCtx.QuerySubspace(stake.GetSlashEventsKey(ownerAddr), stakeStoreName)
+	if err != nil {
+		return events, err
+	}
+	events.SlashEvents = []stake.SlashEvent{}
+	for _, kv := range resKVs {
+		var event stake.SlashEvent
+		cdc.MustUnmarshalBinary(kv.Value, &event)
+		events.SlashEvents = append(events.SlashEvents, event)
+	}
+
+	valAddr := sdk.ValAddress(validator.PubKey.Address())
+	resKVs, err = cliCtx.QuerySubspace(slashing.GetValidatorMissedBlocksKey(valAddr), slashingStoreName)
+	if err != nil {
+		return events, err
+	}
+	events.MissedBlocks = []slashing.MissedBlock{}
+	for _, kv := range resKVs {
+		var missedBlock slashing.MissedBlock
+		cdc.MustUnmarshalBinary(kv.Value, &missedBlock)
+		events.MissedBlocks = append(events.MissedBlocks, missedBlock)
+	}
+
+	return events, nil
+}
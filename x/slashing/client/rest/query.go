@@ -5,17 +5,33 @@ import (
 	"net/http"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/routes"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
+	slashingcmd "github.com/cosmos/cosmos-sdk/x/slashing/client/cli"
 	"github.com/gorilla/mux"
 )
 
-func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *wire.Codec) {
-	r.HandleFunc(
-		"/slashing/signing_info/{validator}",
-		signingInfoHandlerFn(cliCtx, "slashing", cdc),
-	).Methods("GET")
+func registerQueryRoutes(cliCtx context.CLIContext, r *routes.Router, cdc *wire.Codec) {
+	r.Register(
+		routes.Route{
+			Name:     "GetSigningInfo",
+			Method:   "GET",
+			Path:     "/slashing/signing_info/{validator}",
+			Summary:  "Get the signing info of a validator by its bech32 public key",
+			Response: new(slashing.ValidatorSigningInfo),
+			Handler:  signingInfoHandlerFn(cliCtx, "slashing", cdc),
+		},
+		routes.Route{
+			Name:     "GetValidatorEvents",
+			Method:   "GET",
+			Path:     "/slashing/validators/{validator}/events",
+			Summary:  "Get the slash events and missed blocks of a validator",
+			Response: new(slashing.ValidatorEvents),
+			Handler:  validatorEventsHandlerFn(cliCtx, "stake", "slashing", cdc),
+		},
+	)
 }
 
 // http request handler to query signing info
@@ -58,3 +74,34 @@ func signingInfoHandlerFn(cliCtx context.CLIContext, storeName string, cdc *wire
 		w.Write(output)
 	}
 }
+
+// http request handler to query the slash events and missed blocks of a
+// validator
+func validatorEventsHandlerFn(cliCtx context.CLIContext, stakeStoreName, slashingStoreName string, cdc *wire.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		ownerAddr, err := sdk.AccAddressFromBech32(vars["validator"])
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		events, err := slashingcmd.QueryValidatorEvents(cliCtx, cdc, stakeStoreName, slashingStoreName, ownerAddr)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(fmt.Sprintf("couldn't query validator events. Error: %s", err.Error())))
+			return
+		}
+
+		output, err := cdc.MarshalJSON(events)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		w.Write(output)
+	}
+}
@@ -2,26 +2,30 @@ package rest
 
 import (
 	"bytes"
-	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/routes"
+	"github.com/cosmos/cosmos-sdk/client/utils"
 	"github.com/cosmos/cosmos-sdk/crypto/keys"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
 	authctx "github.com/cosmos/cosmos-sdk/x/auth/client/context"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
-
-	"github.com/gorilla/mux"
 )
 
-func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *wire.Codec, kb keys.Keybase) {
-	r.HandleFunc(
-		"/slashing/unrevoke",
-		unrevokeRequestHandlerFn(cdc, kb, cliCtx),
-	).Methods("POST")
+func registerTxRoutes(cliCtx context.CLIContext, r *routes.Router, cdc *wire.Codec, kb keys.Keybase) {
+	r.Register(routes.Route{
+		Name:     "Unrevoke",
+		Method:   "POST",
+		Path:     "/slashing/unrevoke",
+		Summary:  "Unrevoke a validator",
+		Request:  &UnrevokeBody{},
+		Response: utils.TxResponse(kb),
+		Handler:  unrevokeRequestHandlerFn(cdc, kb, cliCtx),
+	})
 }
 
 // Unrevoke TX body
@@ -32,7 +36,15 @@ type UnrevokeBody struct {
 	AccountNumber    int64  `json:"account_number"`
 	Sequence         int64  `json:"sequence"`
 	Gas              int64  `json:"gas"`
+	TimeoutHeight    int64  `json:"timeout_height"`
 	ValidatorAddr    string `json:"validator_addr"`
+	// bech32 address of the signer, used when the server holds no keys
+	From string `json:"from"`
+	// return the unsigned tx instead of signing and broadcasting it
+	GenerateOnly bool `json:"generate_only"`
+	// return the gas estimated by simulating the tx instead of broadcasting it
+	Simulate      bool   `json:"simulate"`
+	GasAdjustment string `json:"gas_adjustment"`
 }
 
 func unrevokeRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, cliCtx context.CLIContext) http.HandlerFunc {
@@ -44,14 +56,14 @@ func unrevokeRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, cliCtx context.C
 			w.Write([]byte(err.Error()))
 			return
 		}
-		err = json.Unmarshal(body, &m)
+		err = cdc.UnmarshalJSON(body, &m)
 		if err != nil {
 			w.WriteHeader(http.StatusBadRequest)
 			w.Write([]byte(err.Error()))
 			return
 		}
 
-		info, err := kb.Get(m.LocalAccountName)
+		from, err := utils.GetRESTFromAddress(kb, m.LocalAccountName, m.From)
 		if err != nil {
 			w.WriteHeader(http.StatusUnauthorized)
 			w.Write([]byte(err.Error()))
@@ -65,7 +77,7 @@ func unrevokeRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, cliCtx context.C
 			return
 		}
 
-		if !bytes.Equal(info.GetPubKey().Address(), validatorAddr) {
+		if !bytes.Equal(from, validatorAddr) {
 			w.WriteHeader(http.StatusUnauthorized)
 			w.Write([]byte("Must use own validator address"))
 			return
@@ -77,10 +89,27 @@ func unrevokeRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, cliCtx context.C
 			AccountNumber: m.AccountNumber,
 			Sequence:      m.Sequence,
 			Gas:           m.Gas,
+			TimeoutHeight: m.TimeoutHeight,
 		}
 
 		msg := slashing.NewMsgUnrevoke(validatorAddr)
 
+		// a server holding no keys only returns the tx to sign
+		if kb == nil {
+			utils.WriteUnsignedTxResponse(w, txCtx, []sdk.Msg{msg})
+			return
+		}
+
+		if m.GenerateOnly {
+			utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{msg})
+			return
+		}
+
+		if m.Simulate {
+			utils.WriteSimulationResponse(w, txCtx, cliCtx, m.LocalAccountName, m.Password, m.GasAdjustment, []sdk.Msg{msg})
+			return
+		}
+
 		txBytes, err := txCtx.BuildAndSign(m.LocalAccountName, m.Password, []sdk.Msg{msg})
 		if err != nil {
 			w.WriteHeader(http.StatusUnauthorized)
@@ -95,7 +124,7 @@ func unrevokeRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, cliCtx context.C
 			return
 		}
 
-		output, err := json.MarshalIndent(res, "", "  ")
+		output, err := wire.MarshalJSONIndent(cdc, res)
 		if err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 			w.Write([]byte(err.Error()))
@@ -12,9 +12,11 @@ const (
 	// Default slashing codespace
 	DefaultCodespace sdk.CodespaceType = 10
 
-	CodeInvalidValidator    CodeType = 101
-	CodeValidatorJailed     CodeType = 102
-	CodeValidatorNotRevoked CodeType = 103
+	CodeInvalidValidator     CodeType = 101
+	CodeValidatorJailed      CodeType = 102
+	CodeValidatorNotRevoked  CodeType = 103
+	CodeValidatorTombstoned  CodeType = 104
+	CodeSelfDelegationTooLow CodeType = 105
 )
 
 func ErrNoValidatorForAddress(codespace sdk.CodespaceType) sdk.Error {
@@ -29,3 +31,9 @@ func ErrValidatorJailed(codespace sdk.CodespaceType) sdk.Error {
 func ErrValidatorNotRevoked(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeValidatorNotRevoked, "validator not revoked, cannot be unrevoked")
 }
+func ErrValidatorTombstoned(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeValidatorTombstoned, "validator tombstoned for double signing, cannot be unrevoked")
+}
+func ErrSelfDelegationTooLowToUnrevoke(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeSelfDelegationTooLow, "validator's self-delegation is below its minimum, cannot be unrevoked")
+}
@@ -0,0 +1,12 @@
+package slashing
+
+import (
+	staketypes "github.com/cosmos/cosmos-sdk/x/stake/types"
+)
+
+// ValidatorEvents are the slash events of a validator, recorded by stake, and
+// the blocks it missed within the signed blocks window
+type ValidatorEvents struct {
+	SlashEvents  []staketypes.SlashEvent `json:"slash_events"`
+	MissedBlocks []MissedBlock           `json:"missed_blocks"`
+}
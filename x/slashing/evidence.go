@@ -0,0 +1,19 @@
+package slashing
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence"
+)
+
+// NewEquivocationHandler returns the handler of the equivocation evidence,
+// slashing and jailing the validator who signed conflicting blocks
+func NewEquivocationHandler(k Keeper) evidence.Handler {
+	return func(ctx sdk.Context, ev evidence.Evidence) sdk.Error {
+		equivocation, ok := ev.(evidence.Equivocation)
+		if !ok {
+			return evidence.ErrInvalidEvidence(k.codespace, "evidence is not an equivocation")
+		}
+		k.handleDoubleSign(ctx, equivocation.PubKey, equivocation.Height, equivocation.Time, equivocation.Power)
+		return nil
+	}
+}
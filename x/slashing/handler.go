@@ -30,6 +30,19 @@ func handleMsgUnrevoke(ctx sdk.Context, msg MsgUnrevoke, k Keeper) sdk.Result {
 		return ErrValidatorNotRevoked(k.codespace).Result()
 	}
 
+	// Cannot be unrevoked while the self-delegation is below the minimum
+	minSelfDelegation := validator.GetMinSelfDelegation()
+	if !minSelfDelegation.IsZero() {
+		selfDelegation := k.validatorSet.Delegation(ctx, msg.ValidatorAddr, msg.ValidatorAddr)
+		if selfDelegation == nil {
+			return ErrSelfDelegationTooLowToUnrevoke(k.codespace).Result()
+		}
+		tokens := selfDelegation.GetBondShares().Mul(validator.GetTokens()).Quo(validator.GetDelegatorShares())
+		if tokens.LT(sdk.NewDecFromInt(minSelfDelegation)) {
+			return ErrSelfDelegationTooLowToUnrevoke(k.codespace).Result()
+		}
+	}
+
 	addr := sdk.ValAddress(validator.GetPubKey().Address())
 
 	// Signing info must exist
@@ -38,6 +51,11 @@ func handleMsgUnrevoke(ctx sdk.Context, msg MsgUnrevoke, k Keeper) sdk.Result {
 		return ErrNoValidatorForAddress(k.codespace).Result()
 	}
 
+	// Cannot be unrevoked after double signing
+	if info.Tombstoned {
+		return ErrValidatorTombstoned(k.codespace).Result()
+	}
+
 	// Cannot be unrevoked until out of jail
 	if ctx.BlockHeader().Time.Before(info.JailedUntil) {
 		return ErrValidatorJailed(k.codespace).Result()
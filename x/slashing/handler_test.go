@@ -27,3 +27,25 @@ func TestCannotUnrevokeUnlessRevoked(t *testing.T) {
 	require.False(t, got.IsOK(), "allowed unrevoke of non-revoked validator")
 	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeValidatorNotRevoked), got.Code)
 }
+
+func TestCannotUnrevokeBelowMinSelfDelegation(t *testing.T) {
+	// initial setup
+	ctx, _, sk, _, keeper := createTestInput(t)
+	slh := NewHandler(keeper)
+	addr, val, amt := addrs[0], pks[0], sdk.NewInt(100)
+	msg := newTestMsgCreateValidator(addr, val, amt)
+	msg.MinSelfDelegation = amt
+	got := stake.NewHandler(sk)(ctx, msg)
+	require.True(t, got.IsOK())
+	stake.EndBlocker(ctx, sk)
+
+	// unbond below the minimum self-delegation, revoking the validator
+	got = stake.NewHandler(sk)(ctx, stake.NewMsgBeginUnbonding(addr, addr, sdk.OneDec()))
+	require.True(t, got.IsOK())
+	require.True(t, sk.Validator(ctx, addr).GetRevoked())
+
+	// assert the validator can't be unrevoked
+	got = slh(ctx, NewMsgUnrevoke(addr))
+	require.False(t, got.IsOK(), "allowed unrevoke of validator below its minimum self-delegation")
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeSelfDelegationTooLow), got.Code)
+}
@@ -0,0 +1,57 @@
+package slashing
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// Hooks of the slashing keeper, to register on the stake keeper
+type Hooks struct {
+	k Keeper
+}
+
+var _ sdk.StakingHooks = Hooks{}
+
+// Hooks returns the staking hooks of the slashing keeper
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// Add the validator to the addr -> pubkey map when it is bonded, and create
+// its signing info if it was never bonded before
+func (h Hooks) OnValidatorBonded(ctx sdk.Context, pubKey crypto.PubKey, _ sdk.AccAddress) {
+	h.k.addPubkey(ctx, pubKey)
+
+	address := sdk.ValAddress(pubKey.Address())
+	_, found := h.k.getValidatorSigningInfo(ctx, address)
+	if !found {
+		signingInfo := NewValidatorSigningInfo(ctx.BlockHeight(), 0, time.Unix(0, 0), 0)
+		h.k.setValidatorSigningInfo(ctx, address, signingInfo)
+	}
+}
+
+// Add the new pubkey of the validator to the addr -> pubkey map, and carry its
+// jailing over to the signing info of the new pubkey, whose liveness is
+// tracked from the current height. The old pubkey is kept so that evidence
+// against it can still be handled.
+func (h Hooks) OnValidatorConsPubKeyRotated(ctx sdk.Context, oldPubKey, newPubKey crypto.PubKey, _ sdk.AccAddress) {
+	h.k.addPubkey(ctx, newPubKey)
+
+	oldInfo, found := h.k.getValidatorSigningInfo(ctx, sdk.ValAddress(oldPubKey.Address()))
+	if !found {
+		return
+	}
+	signingInfo := NewValidatorSigningInfo(ctx.BlockHeight(), 0, oldInfo.JailedUntil, 0)
+	signingInfo.Tombstoned = oldInfo.Tombstoned
+	h.k.setValidatorSigningInfo(ctx, sdk.ValAddress(newPubKey.Address()), signingInfo)
+}
+
+// nolint - unused hooks
+func (h Hooks) OnValidatorCreated(_ sdk.Context, _ crypto.PubKey, _ sdk.AccAddress)          {}
+func (h Hooks) OnValidatorBeginUnbonding(_ sdk.Context, _ crypto.PubKey, _ sdk.AccAddress)   {}
+func (h Hooks) OnValidatorRemoved(_ sdk.Context, _ crypto.PubKey, _ sdk.AccAddress)          {}
+func (h Hooks) OnDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.AccAddress)        {}
+func (h Hooks) OnDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.AccAddress) {}
+func (h Hooks) OnDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.AccAddress)        {}
@@ -1,6 +1,7 @@
 package slashing
 
 import (
+	"bytes"
 	"fmt"
 	"time"
 
@@ -49,22 +50,52 @@ func (k Keeper) handleDoubleSign(ctx sdk.Context, pubkey crypto.PubKey, infracti
 		return
 	}
 
+	// Validator not found, as it was removed or rotated its pubkey long enough
+	// ago for the old pubkey to be pruned
+	validator := k.validatorSet.ValidatorByPubKey(ctx, pubkey)
+	if validator == nil {
+		logger.Info(fmt.Sprintf("Ignored double sign from %s at height %d, validator not found", pubkey.Address(), infractionHeight))
+		return
+	}
+
+	signInfo, found := k.getValidatorSigningInfo(ctx, address)
+	if !found {
+		panic(fmt.Sprintf("Expected signing info for validator %s but not found", address))
+	}
+
+	// Validator already tombstoned, it must not be slashed again for the same
+	// key compromise
+	if signInfo.Tombstoned {
+		logger.Info(fmt.Sprintf("Ignored double sign from %s at height %d, validator already tombstoned", pubkey.Address(), infractionHeight))
+		return
+	}
+
 	// Double sign confirmed
 	logger.Info(fmt.Sprintf("Confirmed double sign from %s at height %d, age of %d less than max age of %d", pubkey.Address(), infractionHeight, age, maxEvidenceAge))
 
 	// Slash validator
-	k.validatorSet.Slash(ctx, pubkey, infractionHeight, power, k.SlashFractionDoubleSign(ctx))
+	k.validatorSet.Slash(ctx, pubkey, infractionHeight, power, k.SlashFractionDoubleSign(ctx), sdk.InfractionDoubleSign)
 
 	// Revoke validator
 	k.validatorSet.Revoke(ctx, pubkey)
 
-	// Jail validator
-	signInfo, found := k.getValidatorSigningInfo(ctx, address)
-	if !found {
-		panic(fmt.Sprintf("Expected signing info for validator %s but not found", address))
-	}
+	// Jail and tombstone validator, so that it can never be unrevoked
 	signInfo.JailedUntil = time.Add(k.DoubleSignUnbondDuration(ctx))
+	signInfo.Tombstoned = true
 	k.setValidatorSigningInfo(ctx, address, signInfo)
+
+	// The validator is unrevoked with the signing info of its current pubkey,
+	// which must be jailed and tombstoned too if the evidence is against a
+	// pubkey it rotated
+	current := sdk.ValAddress(validator.GetPubKey().Address())
+	if !bytes.Equal(current, address) {
+		currentInfo, found := k.getValidatorSigningInfo(ctx, current)
+		if found {
+			currentInfo.JailedUntil = signInfo.JailedUntil
+			currentInfo.Tombstoned = true
+			k.setValidatorSigningInfo(ctx, current, currentInfo)
+		}
+	}
 }
 
 // handle a validator signature, must be called once per validator per block
@@ -103,6 +134,12 @@ func (k Keeper) handleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 		signInfo.SignedBlocksCounter++
 	}
 
+	// Record the missed block, and prune the missed blocks out of the window
+	if !signed {
+		k.setValidatorMissedBlock(ctx, address, NewMissedBlock(height, ctx.BlockHeader().Time))
+	}
+	k.pruneValidatorMissedBlocks(ctx, address, height-k.SignedBlocksWindow(ctx))
+
 	if !signed {
 		logger.Info(fmt.Sprintf("Absent validator %s at height %d, %d signed, threshold %d", addr, height, signInfo.SignedBlocksCounter, k.MinSignedPerWindow(ctx)))
 	}
@@ -113,7 +150,7 @@ func (k Keeper) handleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 			// Downtime confirmed, slash, revoke, and jail the validator
 			logger.Info(fmt.Sprintf("Validator %s past min height of %d and below signed blocks threshold of %d",
 				pubkey.Address(), minHeight, k.MinSignedPerWindow(ctx)))
-			k.validatorSet.Slash(ctx, pubkey, height, power, k.SlashFractionDowntime(ctx))
+			k.validatorSet.Slash(ctx, pubkey, height, power, k.SlashFractionDowntime(ctx), sdk.InfractionDowntime)
 			k.validatorSet.Revoke(ctx, pubkey)
 			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.DowntimeUnbondDuration(ctx))
 		} else {
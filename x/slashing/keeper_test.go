@@ -52,6 +52,102 @@ func TestHandleDoubleSign(t *testing.T) {
 	require.Equal(t, sdk.NewDecFromInt(amt).Mul(sdk.NewDec(19).Quo(sdk.NewDec(20))), sk.Validator(ctx, addr).GetPower())
 }
 
+// Test that a double signing validator is tombstoned, so that it is
+// neither slashed again nor able to unrevoke itself
+func TestHandleDoubleSignTombstone(t *testing.T) {
+
+	// initial setup
+	ctx, _, sk, _, keeper := createTestInput(t)
+	amtInt := int64(100)
+	addr, val, amt := addrs[0], pks[0], sdk.NewInt(amtInt)
+	got := stake.NewHandler(sk)(ctx, newTestMsgCreateValidator(addr, val, amt))
+	require.True(t, got.IsOK())
+	validatorUpdates := stake.EndBlocker(ctx, sk)
+	keeper.AddValidators(ctx, validatorUpdates)
+	keeper.handleValidatorSignature(ctx, val.Address(), amtInt, true)
+
+	// double sign tombstones the validator
+	keeper.handleDoubleSign(ctx, val, 0, time.Unix(0, 0), amtInt)
+	info, found := keeper.getValidatorSigningInfo(ctx, sdk.ValAddress(val.Address()))
+	require.True(t, found)
+	require.True(t, info.Tombstoned)
+	slashedPower := sdk.NewDecFromInt(amt).Mul(sdk.NewDec(19).Quo(sdk.NewDec(20)))
+	require.Equal(t, slashedPower, sk.Validator(ctx, addr).GetTokens())
+
+	// later evidence for the same validator is ignored
+	keeper.handleDoubleSign(ctx, val, 0, time.Unix(0, 0), amtInt)
+	require.Equal(t, slashedPower, sk.Validator(ctx, addr).GetTokens())
+
+	// the validator can never be unrevoked, even once out of jail
+	ctx = ctx.WithBlockHeader(abci.Header{Time: info.JailedUntil.Add(time.Second)})
+	got = NewHandler(keeper)(ctx, NewMsgUnrevoke(addr))
+	require.False(t, got.IsOK())
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeValidatorTombstoned), got.Code)
+	require.True(t, sk.Validator(ctx, addr).GetRevoked())
+}
+
+// Test that evidence against a rotated pubkey tombstones the validator under
+// its current pubkey, so that it can't unrevoke itself
+func TestHandleDoubleSignRotatedPubKey(t *testing.T) {
+
+	// initial setup
+	ctx, _, sk, _, keeper := createTestInput(t)
+	sk.AddHooks(keeper.Hooks())
+	amtInt := int64(100)
+	addr, val, amt := addrs[0], pks[0], sdk.NewInt(amtInt)
+	got := stake.NewHandler(sk)(ctx, newTestMsgCreateValidator(addr, val, amt))
+	require.True(t, got.IsOK())
+	stake.EndBlocker(ctx, sk)
+
+	// rotate the consensus pubkey
+	newVal := pks[1]
+	got = stake.NewHandler(sk)(ctx, stake.NewMsgRotateConsPubKey(addr, newVal))
+	require.True(t, got.IsOK(), got.Log)
+	stake.EndBlocker(ctx, sk)
+
+	// double sign with the old pubkey
+	keeper.handleDoubleSign(ctx, val, 0, time.Unix(0, 0), amtInt)
+	require.True(t, sk.Validator(ctx, addr).GetRevoked())
+	info, found := keeper.getValidatorSigningInfo(ctx, sdk.ValAddress(newVal.Address()))
+	require.True(t, found)
+	require.True(t, info.Tombstoned)
+
+	// the validator can't be unrevoked with its current pubkey
+	ctx = ctx.WithBlockHeader(abci.Header{Time: info.JailedUntil.Add(time.Second)})
+	got = NewHandler(keeper)(ctx, NewMsgUnrevoke(addr))
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeValidatorTombstoned), got.Code)
+	require.True(t, sk.Validator(ctx, addr).GetRevoked())
+}
+
+// Test that evidence against a rotated pubkey whose index was pruned is
+// ignored
+func TestHandleDoubleSignPrunedPubKey(t *testing.T) {
+
+	// initial setup
+	ctx, _, sk, _, keeper := createTestInput(t)
+	sk.AddHooks(keeper.Hooks())
+	amtInt := int64(100)
+	addr, val, amt := addrs[0], pks[0], sdk.NewInt(amtInt)
+	got := stake.NewHandler(sk)(ctx, newTestMsgCreateValidator(addr, val, amt))
+	require.True(t, got.IsOK())
+	stake.EndBlocker(ctx, sk)
+
+	got = stake.NewHandler(sk)(ctx, stake.NewMsgRotateConsPubKey(addr, pks[1]))
+	require.True(t, got.IsOK(), got.Log)
+	stake.EndBlocker(ctx, sk)
+
+	// the index of the old pubkey is pruned after the unbonding time
+	now := ctx.BlockHeader().Time.Add(sk.GetParams(ctx).UnbondingTime)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: now})
+	stake.EndBlocker(ctx, sk)
+	require.Nil(t, sk.ValidatorByPubKey(ctx, val))
+
+	// recent evidence against the old pubkey is ignored
+	keeper.handleDoubleSign(ctx, val, 0, now, amtInt)
+	require.False(t, sk.Validator(ctx, addr).GetRevoked())
+	require.Equal(t, sdk.NewDecFromInt(amt), sk.Validator(ctx, addr).GetTokens())
+}
+
 // Test a validator through uptime, downtime, revocation,
 // unrevocation, starting height reset, and revocation again
 func TestHandleAbsentValidator(t *testing.T) {
@@ -244,3 +340,22 @@ func TestHandleAlreadyRevoked(t *testing.T) {
 	require.Equal(t, int64(amtInt-1), validator.GetTokens().RoundInt64())
 
 }
+
+// Test that the staking hooks add bonded validators to the addr -> pubkey map
+// along with their signing info
+func TestHooksValidatorBonded(t *testing.T) {
+	ctx, _, sk, _, keeper := createTestInput(t)
+	sk.AddHooks(keeper.Hooks())
+	ctx = ctx.WithBlockHeight(3)
+	addr, val, amt := addrs[0], pks[0], sdk.NewInt(100)
+	got := stake.NewHandler(sk)(ctx, newTestMsgCreateValidator(addr, val, amt))
+	require.True(t, got.IsOK())
+
+	pubkey, err := keeper.getPubkey(ctx, val.Address())
+	require.Nil(t, err)
+	require.Equal(t, val, pubkey)
+	info, found := keeper.getValidatorSigningInfo(ctx, sdk.ValAddress(val.Address()))
+	require.True(t, found)
+	require.Equal(t, int64(3), info.StartHeight)
+	require.Equal(t, int64(0), info.SignedBlocksCounter)
+}
@@ -48,6 +48,45 @@ func (k Keeper) setValidatorSigningBitArray(ctx sdk.Context, address sdk.ValAddr
 	store.Set(GetValidatorSigningBitArrayKey(address, index), bz)
 }
 
+// Stored by *validator* address (not owner address)
+func (k Keeper) getValidatorMissedBlocks(ctx sdk.Context, address sdk.ValAddress) (missedBlocks []MissedBlock) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, GetValidatorMissedBlocksKey(address))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var missedBlock MissedBlock
+		k.cdc.MustUnmarshalBinary(iter.Value(), &missedBlock)
+		missedBlocks = append(missedBlocks, missedBlock)
+	}
+	return
+}
+
+// Stored by *validator* address (not owner address)
+func (k Keeper) setValidatorMissedBlock(ctx sdk.Context, address sdk.ValAddress, missedBlock MissedBlock) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinary(missedBlock)
+	store.Set(GetValidatorMissedBlockKey(address, missedBlock.Height), bz)
+}
+
+// Delete the missed blocks of a validator below the height
+func (k Keeper) pruneValidatorMissedBlocks(ctx sdk.Context, address sdk.ValAddress, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, GetValidatorMissedBlocksKey(address))
+	var pruned [][]byte
+	for ; iter.Valid(); iter.Next() {
+		var missedBlock MissedBlock
+		k.cdc.MustUnmarshalBinary(iter.Value(), &missedBlock)
+		if missedBlock.Height >= height {
+			break
+		}
+		pruned = append(pruned, iter.Key())
+	}
+	iter.Close()
+	for _, key := range pruned {
+		store.Delete(key)
+	}
+}
+
 // Construct a new `ValidatorSigningInfo` struct
 func NewValidatorSigningInfo(startHeight int64, indexOffset int64, jailedUntil time.Time, signedBlocksCounter int64) ValidatorSigningInfo {
 	return ValidatorSigningInfo{
@@ -64,12 +103,13 @@ type ValidatorSigningInfo struct {
 	IndexOffset         int64     `json:"index_offset"`          // index offset into signed block bit array
 	JailedUntil         time.Time `json:"jailed_until"`          // timestamp validator cannot be unrevoked until
 	SignedBlocksCounter int64     `json:"signed_blocks_counter"` // signed blocks counter (to avoid scanning the array every time)
+	Tombstoned          bool      `json:"tombstoned"`            // whether or not the validator double signed, which it can never be unrevoked from
 }
 
 // Return human readable signing info
 func (i ValidatorSigningInfo) HumanReadableString() string {
-	return fmt.Sprintf("Start height: %d, index offset: %d, jailed until: %v, signed blocks counter: %d",
-		i.StartHeight, i.IndexOffset, i.JailedUntil, i.SignedBlocksCounter)
+	return fmt.Sprintf("Start height: %d, index offset: %d, jailed until: %v, signed blocks counter: %d, tombstoned: %t",
+		i.StartHeight, i.IndexOffset, i.JailedUntil, i.SignedBlocksCounter, i.Tombstoned)
 }
 
 // Stored by *validator* address (not owner address)
@@ -77,9 +117,35 @@ func GetValidatorSigningInfoKey(v sdk.ValAddress) []byte {
 	return append([]byte{0x01}, v.Bytes()...)
 }
 
+// Construct a new `MissedBlock` struct
+func NewMissedBlock(height int64, time time.Time) MissedBlock {
+	return MissedBlock{
+		Height: height,
+		Time:   time,
+	}
+}
+
+// Block a validator did not sign
+type MissedBlock struct {
+	Height int64     `json:"height"`
+	Time   time.Time `json:"time"`
+}
+
 // Stored by *validator* address (not owner address)
 func GetValidatorSigningBitArrayKey(v sdk.ValAddress, i int64) []byte {
 	b := make([]byte, 8)
 	binary.LittleEndian.PutUint64(b, uint64(i))
 	return append([]byte{0x02}, append(v.Bytes(), b...)...)
 }
+
+// Stored by *validator* address (not owner address)
+func GetValidatorMissedBlocksKey(v sdk.ValAddress) []byte {
+	return append([]byte{0x04}, v.Bytes()...)
+}
+
+// Stored by *validator* address (not owner address), then by height
+func GetValidatorMissedBlockKey(v sdk.ValAddress, height int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(height))
+	return append(GetValidatorMissedBlocksKey(v), b...)
+}
@@ -36,3 +36,26 @@ func TestGetSetValidatorSigningBitArray(t *testing.T) {
 	signed = keeper.getValidatorSigningBitArray(ctx, sdk.ValAddress(addrs[0]), 0)
 	require.True(t, signed) // now should be signed
 }
+
+func TestGetSetValidatorMissedBlocks(t *testing.T) {
+	ctx, _, _, _, keeper := createTestInput(t)
+	addr := sdk.ValAddress(addrs[0])
+	require.Empty(t, keeper.getValidatorMissedBlocks(ctx, addr))
+	for _, height := range []int64{3, 1, 2} {
+		keeper.setValidatorMissedBlock(ctx, addr, NewMissedBlock(height, time.Unix(height, 0).UTC()))
+	}
+	keeper.setValidatorMissedBlock(ctx, sdk.ValAddress(addrs[1]), NewMissedBlock(1, time.Unix(1, 0).UTC()))
+
+	// missed blocks are ordered by height
+	missedBlocks := keeper.getValidatorMissedBlocks(ctx, addr)
+	require.Equal(t, []MissedBlock{
+		NewMissedBlock(1, time.Unix(1, 0).UTC()),
+		NewMissedBlock(2, time.Unix(2, 0).UTC()),
+		NewMissedBlock(3, time.Unix(3, 0).UTC()),
+	}, missedBlocks)
+
+	// prune the missed blocks below height 3
+	keeper.pruneValidatorMissedBlocks(ctx, addr, 3)
+	require.Equal(t, []MissedBlock{NewMissedBlock(3, time.Unix(3, 0).UTC())}, keeper.getValidatorMissedBlocks(ctx, addr))
+	require.Len(t, keeper.getValidatorMissedBlocks(ctx, sdk.ValAddress(addrs[1])), 1)
+}
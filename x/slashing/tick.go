@@ -2,11 +2,9 @@ package slashing
 
 import (
 	"encoding/binary"
-	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
-	tmtypes "github.com/tendermint/tendermint/types"
 )
 
 // slashing begin block functionality
@@ -25,21 +23,8 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, sk Keeper) (tags
 		sk.handleValidatorSignature(ctx, signingValidator.Validator.Address, signingValidator.Validator.Power, present)
 	}
 
-	// Iterate through any newly discovered evidence of infraction
-	// Slash any validators (and since-unbonded stake within the unbonding period)
-	// who contributed to valid infractions
-	for _, evidence := range req.ByzantineValidators {
-		pk, err := tmtypes.PB2TM.PubKey(evidence.Validator.PubKey)
-		if err != nil {
-			panic(err)
-		}
-		switch evidence.Type {
-		case tmtypes.ABCIEvidenceTypeDuplicateVote:
-			sk.handleDoubleSign(ctx, pk, evidence.Height, evidence.Time, evidence.Validator.Power)
-		default:
-			ctx.Logger().With("module", "x/slashing").Error(fmt.Sprintf("ignored unknown evidence type: %s", evidence.Type))
-		}
-	}
+	// Evidence of infractions is routed by the evidence module, which calls
+	// the equivocation handler of the slashing keeper
 
 	return
 }
@@ -16,11 +16,15 @@ const (
 	FlagAmount              = "amount"
 	FlagSharesAmount        = "shares-amount"
 	FlagSharesPercent       = "shares-percent"
+	FlagMinSelfDelegation   = "min-self-delegation"
 
 	FlagMoniker  = "moniker"
 	FlagIdentity = "identity"
 	FlagWebsite  = "website"
 	FlagDetails  = "details"
+
+	FlagPage  = "page"
+	FlagLimit = "limit"
 )
 
 // common flagsets to add to various functions
@@ -33,6 +37,10 @@ var (
 	fsValidator         = flag.NewFlagSet("", flag.ContinueOnError)
 	fsDelegator         = flag.NewFlagSet("", flag.ContinueOnError)
 	fsRedelegation      = flag.NewFlagSet("", flag.ContinueOnError)
+
+	fsMinSelfDelegationCreate = flag.NewFlagSet("", flag.ContinueOnError)
+	fsMinSelfDelegationEdit   = flag.NewFlagSet("", flag.ContinueOnError)
+	fsPagination              = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
@@ -48,8 +56,12 @@ func init() {
 	fsDescriptionEdit.String(FlagIdentity, types.DoNotModifyDesc, "optional identity signature (ex. UPort or Keybase)")
 	fsDescriptionEdit.String(FlagWebsite, types.DoNotModifyDesc, "optional website")
 	fsDescriptionEdit.String(FlagDetails, types.DoNotModifyDesc, "optional details")
+	fsMinSelfDelegationCreate.String(FlagMinSelfDelegation, "0", "minimum tokens of the self-delegation, below which the validator is revoked")
+	fsMinSelfDelegationEdit.String(FlagMinSelfDelegation, "", "new minimum tokens of the self-delegation, which may only be increased")
 	fsValidator.String(FlagAddressValidator, "", "hex address of the validator")
 	fsDelegator.String(FlagAddressDelegator, "", "hex address of the delegator")
 	fsRedelegation.String(FlagAddressValidatorSrc, "", "hex address of the source validator")
 	fsRedelegation.String(FlagAddressValidatorDst, "", "hex address of the destination validator")
+	fsPagination.Int(FlagPage, 1, "page of results to query, starting at 1")
+	fsPagination.Int(FlagLimit, 0, "maximum number of results per page, 0 for all results")
 }
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -73,7 +74,7 @@ func GetCmdQueryValidators(storeName string, cdc *wire.Codec) *cobra.Command {
 			key := stake.ValidatorsKey
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			resKVs, err := cliCtx.QuerySubspace(key, storeName)
+			resKVs, err := cliCtx.QuerySubspacePage(key, storeName, viper.GetInt(FlagPage), viper.GetInt(FlagLimit))
 			if err != nil {
 				return err
 			}
@@ -111,6 +112,8 @@ func GetCmdQueryValidators(storeName string, cdc *wire.Codec) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().AddFlagSet(fsPagination)
+
 	return cmd
 }
 
@@ -315,11 +318,11 @@ func GetCmdQueryUnbondingDelegations(storeName string, cdc *wire.Codec) *cobra.C
 }
 
 // GetCmdQueryRedelegation implements the command to query a single
-// unbonding-delegation record.
+// redelegation record.
 func GetCmdQueryRedelegation(storeName string, cdc *wire.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "unbonding-delegation",
-		Short: "Query an unbonding-delegation record based on delegator and validator address",
+		Use:   "redelegation",
+		Short: "Query a redelegation record based on delegator and source and destination validator addresses",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			valSrcAddr, err := sdk.AccAddressFromBech32(viper.GetString(FlagAddressValidatorSrc))
 			if err != nil {
@@ -376,11 +379,11 @@ func GetCmdQueryRedelegation(storeName string, cdc *wire.Codec) *cobra.Command {
 }
 
 // GetCmdQueryRedelegations implements the command to query all the
-// unbonding-delegation records for a delegator.
+// redelegation records for a delegator.
 func GetCmdQueryRedelegations(storeName string, cdc *wire.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "unbonding-delegations [delegator-addr]",
-		Short: "Query all unbonding-delegations records for one delegator",
+		Use:   "redelegations [delegator-addr]",
+		Short: "Query all redelegation records for one delegator",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
@@ -417,3 +420,313 @@ func GetCmdQueryRedelegations(storeName string, cdc *wire.Codec) *cobra.Command
 
 	return cmd
 }
+
+// GetCmdQueryValidatorDelegations implements the command to query all the
+// delegations to a validator, using the delegations by validator index.
+func GetCmdQueryValidatorDelegations(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegations-to [validator-addr]",
+		Short: "Query all delegations made to one validator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			validatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			key := stake.GetDelegationsByValIndexKey(validatorAddr)
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			resKVs, err := cliCtx.QuerySubspacePage(key, storeName, viper.GetInt(FlagPage), viper.GetInt(FlagLimit))
+			if err != nil {
+				return err
+			}
+
+			// fetch the delegations pointed to by the index
+			var delegations []stake.Delegation
+			for _, kv := range resKVs {
+				delKey := stake.GetDelegationKeyFromValIndexKey(kv.Key)
+				res, err := cliCtx.QueryStore(delKey, storeName)
+				if err != nil {
+					return err
+				} else if len(res) == 0 {
+					continue
+				}
+				delegations = append(delegations, types.MustUnmarshalDelegation(cdc, delKey, res))
+			}
+
+			output, err := wire.MarshalJSONIndent(cdc, delegations)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsPagination)
+
+	return cmd
+}
+
+// GetCmdQueryValidatorUnbondingDelegations implements the command to query all
+// the unbonding-delegation records from a validator.
+func GetCmdQueryValidatorUnbondingDelegations(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbonding-delegations-from [validator-addr]",
+		Short: "Query all unbonding-delegation records from one validator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			validatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			key := stake.GetUBDsByValIndexKey(validatorAddr)
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			resKVs, err := cliCtx.QuerySubspacePage(key, storeName, viper.GetInt(FlagPage), viper.GetInt(FlagLimit))
+			if err != nil {
+				return err
+			}
+
+			// fetch the unbonding-delegations pointed to by the index
+			var ubds []stake.UnbondingDelegation
+			for _, kv := range resKVs {
+				ubdKey := stake.GetUBDKeyFromValIndexKey(kv.Key)
+				res, err := cliCtx.QueryStore(ubdKey, storeName)
+				if err != nil {
+					return err
+				} else if len(res) == 0 {
+					continue
+				}
+				ubds = append(ubds, types.MustUnmarshalUBD(cdc, ubdKey, res))
+			}
+
+			output, err := wire.MarshalJSONIndent(cdc, ubds)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsPagination)
+
+	return cmd
+}
+
+// GetCmdQueryValidatorRedelegationsFrom implements the command to query all
+// the redelegation records from a validator.
+func GetCmdQueryValidatorRedelegationsFrom(storeName string, cdc *wire.Codec) *cobra.Command {
+	return getCmdQueryValidatorRedelegations(storeName, cdc, "redelegations-from [validator-addr]",
+		"Query all redelegation records from one validator",
+		stake.GetREDsFromValSrcIndexKey, stake.GetREDKeyFromValSrcIndexKey)
+}
+
+// GetCmdQueryValidatorRedelegationsTo implements the command to query all
+// the redelegation records to a validator.
+func GetCmdQueryValidatorRedelegationsTo(storeName string, cdc *wire.Codec) *cobra.Command {
+	return getCmdQueryValidatorRedelegations(storeName, cdc, "redelegations-to [validator-addr]",
+		"Query all redelegation records to one validator",
+		stake.GetREDsToValDstIndexKey, stake.GetREDKeyFromValDstIndexKey)
+}
+
+func getCmdQueryValidatorRedelegations(storeName string, cdc *wire.Codec, use, short string,
+	indexPrefix func(sdk.AccAddress) []byte, keyFromIndexKey func([]byte) []byte) *cobra.Command {
+
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			validatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			key := indexPrefix(validatorAddr)
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			resKVs, err := cliCtx.QuerySubspacePage(key, storeName, viper.GetInt(FlagPage), viper.GetInt(FlagLimit))
+			if err != nil {
+				return err
+			}
+
+			// fetch the redelegations pointed to by the index
+			var reds []stake.Redelegation
+			for _, kv := range resKVs {
+				redKey := keyFromIndexKey(kv.Key)
+				res, err := cliCtx.QueryStore(redKey, storeName)
+				if err != nil {
+					return err
+				} else if len(res) == 0 {
+					continue
+				}
+				reds = append(reds, types.MustUnmarshalRED(cdc, redKey, res))
+			}
+
+			output, err := wire.MarshalJSONIndent(cdc, reds)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsPagination)
+
+	return cmd
+}
+
+// GetCmdQueryDelegatorValidators implements the command to query all the
+// validators a delegator is bonded to.
+func GetCmdQueryDelegatorValidators(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegator-validators [delegator-addr]",
+		Short: "Query all validators one delegator is bonded to",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			key := stake.GetDelegationsKey(delegatorAddr)
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			resKVs, err := cliCtx.QuerySubspacePage(key, storeName, viper.GetInt(FlagPage), viper.GetInt(FlagLimit))
+			if err != nil {
+				return err
+			}
+
+			// fetch the validator of each delegation
+			var validators []stake.Validator
+			for _, kv := range resKVs {
+				delegation := types.MustUnmarshalDelegation(cdc, kv.Key, kv.Value)
+				res, err := cliCtx.QueryStore(stake.GetValidatorKey(delegation.ValidatorAddr), storeName)
+				if err != nil {
+					return err
+				} else if len(res) == 0 {
+					continue
+				}
+				validators = append(validators, types.MustUnmarshalValidator(cdc, delegation.ValidatorAddr, res))
+			}
+
+			output, err := wire.MarshalJSONIndent(cdc, validators)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsPagination)
+
+	return cmd
+}
+
+// GetCmdQuerySelfDelegation implements the command to query the delegation of
+// a validator operator to its own validator.
+func GetCmdQuerySelfDelegation(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "self-delegation [validator-addr]",
+		Short: "Query the self-delegation of a validator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			validatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			key := stake.GetDelegationKey(validatorAddr, validatorAddr)
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := cliCtx.QueryStore(key, storeName)
+			if err != nil {
+				return err
+			} else if len(res) == 0 {
+				return fmt.Errorf("No self-delegation found for validator %s", args[0])
+			}
+
+			delegation := types.MustUnmarshalDelegation(cdc, key, res)
+
+			switch viper.Get(cli.OutputFlag) {
+			case "text":
+				resp, err := delegation.HumanReadableString()
+				if err != nil {
+					return err
+				}
+
+				fmt.Println(resp)
+			case "json":
+				output, err := wire.MarshalJSONIndent(cdc, delegation)
+				if err != nil {
+					return err
+				}
+
+				fmt.Println(string(output))
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQueryHistoricalInfo implements the historical info query command.
+func GetCmdQueryHistoricalInfo(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "historical-info [height]",
+		Short: "Query the header and validator set of a recent block",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			key := stake.GetHistoricalInfoKey(height)
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := cliCtx.QueryStore(key, storeName)
+			if err != nil {
+				return err
+			} else if len(res) == 0 {
+				return fmt.Errorf("No historical info found at height %d", height)
+			}
+
+			var hi stake.HistoricalInfo
+			cdc.MustUnmarshalBinary(res, &hi)
+
+			switch viper.Get(cli.OutputFlag) {
+			case "text":
+				human, err := hi.HumanReadableString()
+				if err != nil {
+					return err
+				}
+				fmt.Println(human)
+
+			case "json":
+				output, err := wire.MarshalJSONIndent(cdc, hi)
+				if err != nil {
+					return err
+				}
+
+				fmt.Println(string(output))
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
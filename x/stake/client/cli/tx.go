@@ -75,7 +75,12 @@ func GetCmdCreateValidator(cdc *wire.Codec) *cobra.Command {
 
 				msg = stake.NewMsgCreateValidatorOnBehalfOf(delegatorAddr, validatorAddr, pk, amount, description)
 			} else {
-				msg = stake.NewMsgCreateValidator(validatorAddr, pk, amount, description)
+				minSelfDelegation, ok := sdk.NewIntFromString(viper.GetString(FlagMinSelfDelegation))
+				if !ok {
+					return fmt.Errorf("minimum self-delegation must be an integer")
+				}
+
+				msg = stake.NewMsgCreateValidator(validatorAddr, pk, amount, minSelfDelegation, description)
 			}
 
 			// build and sign the transaction, then broadcast to Tendermint
@@ -86,6 +91,7 @@ func GetCmdCreateValidator(cdc *wire.Codec) *cobra.Command {
 	cmd.Flags().AddFlagSet(fsPk)
 	cmd.Flags().AddFlagSet(fsAmount)
 	cmd.Flags().AddFlagSet(fsDescriptionCreate)
+	cmd.Flags().AddFlagSet(fsMinSelfDelegationCreate)
 	cmd.Flags().AddFlagSet(fsDelegator)
 
 	return cmd
@@ -114,7 +120,17 @@ func GetCmdEditValidator(cdc *wire.Codec) *cobra.Command {
 				Website:  viper.GetString(FlagWebsite),
 				Details:  viper.GetString(FlagDetails),
 			}
-			msg := stake.NewMsgEditValidator(validatorAddr, description)
+
+			var minSelfDelegation *sdk.Int
+			if minStr := viper.GetString(FlagMinSelfDelegation); minStr != "" {
+				min, ok := sdk.NewIntFromString(minStr)
+				if !ok {
+					return fmt.Errorf("minimum self-delegation must be an integer")
+				}
+				minSelfDelegation = &min
+			}
+
+			msg := stake.NewMsgEditValidator(validatorAddr, description, minSelfDelegation)
 
 			// build and sign the transaction, then broadcast to Tendermint
 			return utils.SendTx(txCtx, cliCtx, []sdk.Msg{msg})
@@ -122,6 +138,46 @@ func GetCmdEditValidator(cdc *wire.Codec) *cobra.Command {
 	}
 
 	cmd.Flags().AddFlagSet(fsDescriptionEdit)
+	cmd.Flags().AddFlagSet(fsMinSelfDelegationEdit)
+
+	return cmd
+}
+
+// GetCmdRotateConsPubKey implements the rotate consensus pubkey command.
+func GetCmdRotateConsPubKey(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-cons-pubkey",
+		Short: "rotate the consensus pubkey of an existing validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txCtx := authctx.NewTxContextFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			validatorAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			pkStr := viper.GetString(FlagPubKey)
+			if len(pkStr) == 0 {
+				return fmt.Errorf("must use --pubkey flag")
+			}
+
+			pk, err := sdk.GetValPubKeyBech32(pkStr)
+			if err != nil {
+				return err
+			}
+
+			msg := stake.NewMsgRotateConsPubKey(validatorAddr, pk)
+
+			// build and sign the transaction, then broadcast to Tendermint
+			return utils.SendTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsPk)
 
 	return cmd
 }
@@ -416,3 +472,77 @@ func GetCmdCompleteUnbonding(cdc *wire.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdTokenizeShares implements the tokenize shares command.
+func GetCmdTokenizeShares(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokenize-shares",
+		Short: "tokenize shares of a delegation into transferable coins",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txCtx := authctx.NewTxContextFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			delegatorAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			validatorAddr, err := sdk.AccAddressFromBech32(viper.GetString(FlagAddressValidator))
+			if err != nil {
+				return err
+			}
+
+			sharesAmount, ok := sdk.NewIntFromString(viper.GetString(FlagSharesAmount))
+			if !ok || !sharesAmount.GT(sdk.ZeroInt()) {
+				return errors.Errorf("shares amount must be a positive integer")
+			}
+
+			msg := stake.NewMsgTokenizeShares(delegatorAddr, validatorAddr, sdk.NewDecFromInt(sharesAmount))
+
+			// build and sign the transaction, then broadcast to Tendermint
+			return utils.SendTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagSharesAmount, "", "Amount of shares to tokenize as a positive integer")
+	cmd.Flags().AddFlagSet(fsValidator)
+
+	return cmd
+}
+
+// GetCmdRedeemTokens implements the redeem tokenized shares command.
+func GetCmdRedeemTokens(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redeem-tokens",
+		Short: "redeem tokenized shares for the delegation of the shares",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txCtx := authctx.NewTxContextFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			delegatorAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoin(viper.GetString(FlagAmount))
+			if err != nil {
+				return err
+			}
+
+			msg := stake.NewMsgRedeemTokens(delegatorAddr, amount)
+
+			// build and sign the transaction, then broadcast to Tendermint
+			return utils.SendTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagAmount, "", "Amount of tokenized shares to redeem, such as 10stake/<validator>")
+
+	return cmd
+}
@@ -3,9 +3,11 @@ package rest
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/routes"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
@@ -18,56 +20,155 @@ import (
 
 const storeName = "stake"
 
-func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *wire.Codec) {
-
-	// Get all delegations (delegation, undelegation and redelegation) from a delegator
-	r.HandleFunc(
-		"/stake/delegators/{delegatorAddr}",
-		delegatorHandlerFn(cliCtx, cdc),
-	).Methods("GET")
-
-	// Get all staking txs (i.e msgs) from a delegator
-	r.HandleFunc(
-		"/stake/delegators/{delegatorAddr}/txs",
-		delegatorTxsHandlerFn(cliCtx, cdc),
-	).Methods("GET")
-
-	// Query all validators that a delegator is bonded to
-	r.HandleFunc(
-		"/stake/delegators/{delegatorAddr}/validators",
-		delegatorValidatorsHandlerFn(cliCtx, cdc),
-	).Methods("GET")
-
-	// Query a validator that a delegator is bonded to
-	r.HandleFunc(
-		"/stake/delegators/{delegatorAddr}/validators/{validatorAddr}",
-		delegatorValidatorHandlerFn(cliCtx, cdc),
-	).Methods("GET")
-
-	// Query a delegation between a delegator and a validator
-	r.HandleFunc(
-		"/stake/delegators/{delegatorAddr}/delegations/{validatorAddr}",
-		delegationHandlerFn(cliCtx, cdc),
-	).Methods("GET")
-
-	// Query all unbonding_delegations between a delegator and a validator
-	r.HandleFunc(
-		"/stake/delegators/{delegatorAddr}/unbonding_delegations/{validatorAddr}",
-		unbondingDelegationsHandlerFn(cliCtx, cdc),
-	).Methods("GET")
-
-	// Get all validators
-	r.HandleFunc(
-		"/stake/validators",
-		validatorsHandlerFn(cliCtx, cdc),
-	).Methods("GET")
-
-	// Get a single validator info
-	r.HandleFunc(
-		"/stake/validators/{addr}",
-		validatorHandlerFn(cliCtx, cdc),
-	).Methods("GET")
-
+func registerQueryRoutes(cliCtx context.CLIContext, r *routes.Router, cdc *wire.Codec) {
+	r.Register(
+		// Get all delegations (delegation, undelegation and redelegation) from a delegator
+		routes.Route{
+			Name:     "GetDelegator",
+			Method:   "GET",
+			Path:     "/stake/delegators/{delegatorAddr}",
+			Summary:  "Get the delegations, unbonding delegations and redelegations of a delegator",
+			Response: new(DelegationSummary),
+			Handler:  delegatorHandlerFn(cliCtx, cdc),
+		},
+
+		// Get all staking txs (i.e msgs) from a delegator
+		routes.Route{
+			Name:     "GetDelegatorTxs",
+			Method:   "GET",
+			Path:     "/stake/delegators/{delegatorAddr}/txs",
+			Summary:  "Get the staking txs of a delegator, filtered by the space separated types bond, unbond and redelegate",
+			Query:    []string{"type"},
+			Response: new([]tx.Info),
+			Handler:  delegatorTxsHandlerFn(cliCtx, cdc),
+		},
+
+		// Query all validators that a delegator is bonded to
+		routes.Route{
+			Name:     "GetDelegatorValidators",
+			Method:   "GET",
+			Path:     "/stake/delegators/{delegatorAddr}/validators",
+			Summary:  "Get the validators a delegator is bonded to",
+			Query:    []string{"page", "limit"},
+			Response: new([]types.BechValidator),
+			Handler:  delegatorValidatorsHandlerFn(cliCtx, cdc),
+		},
+
+		// Query a validator that a delegator is bonded to
+		routes.Route{
+			Name:     "GetDelegatorValidator",
+			Method:   "GET",
+			Path:     "/stake/delegators/{delegatorAddr}/validators/{validatorAddr}",
+			Summary:  "Get a validator a delegator is bonded to",
+			Response: new(types.BechValidator),
+			Handler:  delegatorValidatorHandlerFn(cliCtx, cdc),
+		},
+
+		// Query a delegation between a delegator and a validator
+		routes.Route{
+			Name:     "GetDelegation",
+			Method:   "GET",
+			Path:     "/stake/delegators/{delegatorAddr}/delegations/{validatorAddr}",
+			Summary:  "Get the delegation of a delegator to a validator",
+			Response: new(DelegationWithoutRat),
+			Handler:  delegationHandlerFn(cliCtx, cdc),
+		},
+
+		// Query all unbonding_delegations between a delegator and a validator
+		routes.Route{
+			Name:     "GetUnbondingDelegations",
+			Method:   "GET",
+			Path:     "/stake/delegators/{delegatorAddr}/unbonding_delegations/{validatorAddr}",
+			Summary:  "Get the unbonding delegations of a delegator from a validator",
+			Response: new([]stake.UnbondingDelegation),
+			Handler:  unbondingDelegationsHandlerFn(cliCtx, cdc),
+		},
+
+		// Get all validators
+		routes.Route{
+			Name:     "GetValidators",
+			Method:   "GET",
+			Path:     "/stake/validators",
+			Summary:  "Get the validators",
+			Query:    []string{"page", "limit"},
+			Response: new([]types.BechValidator),
+			Handler:  validatorsHandlerFn(cliCtx, cdc),
+		},
+
+		// Get a single validator info
+		routes.Route{
+			Name:     "GetValidator",
+			Method:   "GET",
+			Path:     "/stake/validators/{addr}",
+			Summary:  "Get a validator",
+			Response: new(types.BechValidator),
+			Handler:  validatorHandlerFn(cliCtx, cdc),
+		},
+
+		// Get all delegations to a validator
+		routes.Route{
+			Name:     "GetValidatorDelegations",
+			Method:   "GET",
+			Path:     "/stake/validators/{addr}/delegations",
+			Summary:  "Get the delegations to a validator",
+			Query:    []string{"page", "limit"},
+			Response: new([]DelegationWithoutRat),
+			Handler:  validatorDelegationsHandlerFn(cliCtx, cdc),
+		},
+
+		// Get all unbonding-delegations from a validator
+		routes.Route{
+			Name:     "GetValidatorUnbondingDelegations",
+			Method:   "GET",
+			Path:     "/stake/validators/{addr}/unbonding_delegations",
+			Summary:  "Get the unbonding delegations from a validator",
+			Query:    []string{"page", "limit"},
+			Response: new([]types.UnbondingDelegation),
+			Handler:  validatorUnbondingDelegationsHandlerFn(cliCtx, cdc),
+		},
+
+		// Get all redelegations from a validator
+		routes.Route{
+			Name:     "GetValidatorRedelegationsFrom",
+			Method:   "GET",
+			Path:     "/stake/validators/{addr}/redelegations_from",
+			Summary:  "Get the redelegations from a validator",
+			Query:    []string{"page", "limit"},
+			Response: new([]types.Redelegation),
+			Handler:  validatorRedelegationsHandlerFn(cliCtx, cdc, stake.GetREDsFromValSrcIndexKey, stake.GetREDKeyFromValSrcIndexKey),
+		},
+
+		// Get all redelegations to a validator
+		routes.Route{
+			Name:     "GetValidatorRedelegationsTo",
+			Method:   "GET",
+			Path:     "/stake/validators/{addr}/redelegations_to",
+			Summary:  "Get the redelegations to a validator",
+			Query:    []string{"page", "limit"},
+			Response: new([]types.Redelegation),
+			Handler:  validatorRedelegationsHandlerFn(cliCtx, cdc, stake.GetREDsToValDstIndexKey, stake.GetREDKeyFromValDstIndexKey),
+		},
+
+		// Get the self-delegation of a validator
+		routes.Route{
+			Name:     "GetValidatorSelfDelegation",
+			Method:   "GET",
+			Path:     "/stake/validators/{addr}/self_delegation",
+			Summary:  "Get the self-delegation of a validator",
+			Response: new(DelegationWithoutRat),
+			Handler:  validatorSelfDelegationHandlerFn(cliCtx, cdc),
+		},
+
+		// Get the header and validator set of a recent block
+		routes.Route{
+			Name:     "GetHistoricalInfo",
+			Method:   "GET",
+			Path:     "/stake/historical_info/{height}",
+			Summary:  "Get the header and validator set of a recent block",
+			Response: new(stake.HistoricalInfo),
+			Handler:  historicalInfoHandlerFn(cliCtx, cdc),
+		},
+	)
 }
 
 // already resolve the rational shares to not handle this in the client
@@ -90,8 +191,6 @@ type DelegationSummary struct {
 // HTTP request handler to query a delegator delegations
 func delegatorHandlerFn(cliCtx context.CLIContext, cdc *wire.Codec) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-
-		var validatorAddr sdk.AccAddress
 		var delegationSummary = DelegationSummary{}
 
 		// read parameters
@@ -105,51 +204,55 @@ func delegatorHandlerFn(cliCtx context.CLIContext, cdc *wire.Codec) http.Handler
 			return
 		}
 
-		// Get all validators using key
-		validators, statusCode, errMsg, err := getBech32Validators(storeName, cliCtx, cdc)
+		// Delegations
+		kvs, err := cliCtx.QuerySubspace(stake.GetDelegationsKey(delegatorAddr), storeName)
 		if err != nil {
-			w.WriteHeader(statusCode)
-			w.Write([]byte(fmt.Sprintf("%s%s", errMsg, err.Error())))
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(fmt.Sprintf("couldn't query delegations. Error: %s", err.Error())))
 			return
 		}
-
-		for _, validator := range validators {
-			validatorAddr = validator.Operator
-
-			// Delegations
-			delegations, statusCode, errMsg, err := getDelegatorDelegations(cliCtx, cdc, delegatorAddr, validatorAddr)
+		for _, kv := range kvs {
+			delegation, err := types.UnmarshalDelegation(cdc, kv.Key, kv.Value)
 			if err != nil {
-				w.WriteHeader(statusCode)
-				w.Write([]byte(fmt.Sprintf("%s%s", errMsg, err.Error())))
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write([]byte(fmt.Sprintf("couldn't unmarshall delegation. Error: %s", err.Error())))
 				return
 			}
-			if statusCode != http.StatusNoContent {
-				delegationSummary.Delegations = append(delegationSummary.Delegations, delegations)
-			}
+			delegationSummary.Delegations = append(delegationSummary.Delegations, newDelegationWithoutRat(delegation))
+		}
 
-			// Undelegations
-			unbondingDelegation, statusCode, errMsg, err := getDelegatorUndelegations(cliCtx, cdc, delegatorAddr, validatorAddr)
+		// Undelegations
+		kvs, err = cliCtx.QuerySubspace(stake.GetUBDsKey(delegatorAddr), storeName)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(fmt.Sprintf("couldn't query unbonding-delegations. Error: %s", err.Error())))
+			return
+		}
+		for _, kv := range kvs {
+			ubd, err := types.UnmarshalUBD(cdc, kv.Key, kv.Value)
 			if err != nil {
-				w.WriteHeader(statusCode)
-				w.Write([]byte(fmt.Sprintf("%s%s", errMsg, err.Error())))
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write([]byte(fmt.Sprintf("couldn't unmarshall unbonding-delegation. Error: %s", err.Error())))
 				return
 			}
-			if statusCode != http.StatusNoContent {
-				delegationSummary.UnbondingDelegations = append(delegationSummary.UnbondingDelegations, unbondingDelegation)
-			}
+			delegationSummary.UnbondingDelegations = append(delegationSummary.UnbondingDelegations, ubd)
+		}
 
-			// Redelegations
-			// only querying redelegations to a validator as this should give us already all relegations
-			// if we also would put in redelegations from, we would have every redelegation double
-			redelegations, statusCode, errMsg, err := getDelegatorRedelegations(cliCtx, cdc, delegatorAddr, validatorAddr)
+		// Redelegations
+		kvs, err = cliCtx.QuerySubspace(stake.GetREDsKey(delegatorAddr), storeName)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(fmt.Sprintf("couldn't query redelegations. Error: %s", err.Error())))
+			return
+		}
+		for _, kv := range kvs {
+			red, err := types.UnmarshalRED(cdc, kv.Key, kv.Value)
 			if err != nil {
-				w.WriteHeader(statusCode)
-				w.Write([]byte(fmt.Sprintf("%s%s", errMsg, err.Error())))
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write([]byte(fmt.Sprintf("couldn't unmarshall redelegation. Error: %s", err.Error())))
 				return
 			}
-			if statusCode != http.StatusNoContent {
-				delegationSummary.Redelegations = append(delegationSummary.Redelegations, redelegations)
-			}
+			delegationSummary.Redelegations = append(delegationSummary.Redelegations, red)
 		}
 
 		output, err := cdc.MarshalJSON(delegationSummary)
@@ -364,10 +467,6 @@ func delegationHandlerFn(cliCtx context.CLIContext, cdc *wire.Codec) http.Handle
 // HTTP request handler to query all delegator bonded validators
 func delegatorValidatorsHandlerFn(cliCtx context.CLIContext, cdc *wire.Codec) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-
-		var validatorAccAddr sdk.AccAddress
-		var bondedValidators []types.BechValidator
-
 		// read parameters
 		vars := mux.Vars(r)
 		bech32delegator := vars["delegatorAddr"]
@@ -379,40 +478,20 @@ func delegatorValidatorsHandlerFn(cliCtx context.CLIContext, cdc *wire.Codec) ht
 			return
 		}
 
-		// Get all validators using key
-		kvs, err := cliCtx.QuerySubspace(stake.ValidatorsKey, storeName)
+		page, limit, err := parsePagination(r)
 		if err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
-			w.Write([]byte(fmt.Sprintf("couldn't query validators. Error: %s", err.Error())))
-			return
-		} else if len(kvs) == 0 {
-			// the query will return empty if there are no validators
-			w.WriteHeader(http.StatusNoContent)
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
 			return
 		}
 
-		validators, err := getValidators(kvs, cdc)
+		bondedValidators, err := getDelegatorValidators(cliCtx, cdc, delegatorAddr, page, limit)
 		if err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
-			w.Write([]byte(fmt.Sprintf("Error: %s", err.Error())))
+			w.Write([]byte(fmt.Sprintf("couldn't query delegator validators. Error: %s", err.Error())))
 			return
 		}
 
-		for _, validator := range validators {
-			// get all transactions from the delegator to val and append
-			validatorAccAddr = validator.Operator
-
-			validator, statusCode, errMsg, errRes := getDelegatorValidator(cliCtx, cdc, delegatorAddr, validatorAccAddr)
-			if errRes != nil {
-				w.WriteHeader(statusCode)
-				w.Write([]byte(fmt.Sprintf("%s%s", errMsg, errRes.Error())))
-				return
-			} else if statusCode == http.StatusNoContent {
-				continue
-			}
-
-			bondedValidators = append(bondedValidators, validator)
-		}
 		output, err := cdc.MarshalJSON(bondedValidators)
 		if err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
@@ -465,7 +544,14 @@ func delegatorValidatorHandlerFn(cliCtx context.CLIContext, cdc *wire.Codec) htt
 // http request handler to query list of validators
 func validatorsHandlerFn(cliCtx context.CLIContext, cdc *wire.Codec) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		kvs, err := cliCtx.QuerySubspace(stake.ValidatorsKey, storeName)
+		page, limit, err := parsePagination(r)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		kvs, err := cliCtx.QuerySubspacePage(stake.ValidatorsKey, storeName, page, limit)
 		if err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 			w.Write([]byte(fmt.Sprintf("couldn't query validators. Error: %s", err.Error())))
@@ -554,3 +640,180 @@ func validatorHandlerFn(cliCtx context.CLIContext, cdc *wire.Codec) http.Handler
 		w.Write(output)
 	}
 }
+
+// HTTP request handler to query the delegations to a validator
+func validatorDelegationsHandlerFn(cliCtx context.CLIContext, cdc *wire.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		validatorAddr, err := sdk.AccAddressFromBech32(mux.Vars(r)["addr"])
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		page, limit, err := parsePagination(r)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		delegations, err := getValidatorDelegations(cliCtx, cdc, validatorAddr, page, limit)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(fmt.Sprintf("couldn't query delegations. Error: %s", err.Error())))
+			return
+		}
+
+		output, err := cdc.MarshalJSON(delegations)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		w.Write(output)
+	}
+}
+
+// HTTP request handler to query the unbonding-delegations from a validator
+func validatorUnbondingDelegationsHandlerFn(cliCtx context.CLIContext, cdc *wire.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		validatorAddr, err := sdk.AccAddressFromBech32(mux.Vars(r)["addr"])
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		page, limit, err := parsePagination(r)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		ubds, err := getValidatorUnbondingDelegations(cliCtx, cdc, validatorAddr, page, limit)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(fmt.Sprintf("couldn't query unbonding-delegations. Error: %s", err.Error())))
+			return
+		}
+
+		output, err := cdc.MarshalJSON(ubds)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		w.Write(output)
+	}
+}
+
+// HTTP request handler to query the redelegations from or to a validator,
+// depending on the redelegation index used
+func validatorRedelegationsHandlerFn(cliCtx context.CLIContext, cdc *wire.Codec,
+	indexPrefix func(sdk.AccAddress) []byte, keyFromIndexKey func([]byte) []byte) http.HandlerFunc {
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		validatorAddr, err := sdk.AccAddressFromBech32(mux.Vars(r)["addr"])
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		page, limit, err := parsePagination(r)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		reds, err := getValidatorRedelegations(cliCtx, cdc, indexPrefix(validatorAddr), keyFromIndexKey, page, limit)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(fmt.Sprintf("couldn't query redelegations. Error: %s", err.Error())))
+			return
+		}
+
+		output, err := cdc.MarshalJSON(reds)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		w.Write(output)
+	}
+}
+
+// HTTP request handler to query the self-delegation of a validator
+func validatorSelfDelegationHandlerFn(cliCtx context.CLIContext, cdc *wire.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		validatorAddr, err := sdk.AccAddressFromBech32(mux.Vars(r)["addr"])
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		delegation, statusCode, errMsg, err := getDelegatorDelegations(cliCtx, cdc, validatorAddr, validatorAddr)
+		if err != nil {
+			w.WriteHeader(statusCode)
+			w.Write([]byte(fmt.Sprintf("%s%s", errMsg, err.Error())))
+			return
+		} else if statusCode == http.StatusNoContent {
+			w.WriteHeader(statusCode)
+			return
+		}
+
+		output, err := cdc.MarshalJSON(delegation)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		w.Write(output)
+	}
+}
+
+// HTTP request handler to query the header and validator set of a recent block
+func historicalInfoHandlerFn(cliCtx context.CLIContext, cdc *wire.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		height, err := strconv.ParseInt(vars["height"], 10, 64)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(fmt.Sprintf("error: %s", err.Error())))
+			return
+		}
+
+		res, err := cliCtx.QueryStore(stake.GetHistoricalInfoKey(height), storeName)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(fmt.Sprintf("couldn't query historical info, error: %s", err.Error())))
+			return
+		}
+
+		// the query will return empty if there is no data for this record
+		if len(res) == 0 {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		var hi stake.HistoricalInfo
+		err = cdc.UnmarshalBinary(res, &hi)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		output, err := cdc.MarshalJSON(hi)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(fmt.Sprintf("Error: %s", err.Error())))
+			return
+		}
+		w.Write(output)
+	}
+}
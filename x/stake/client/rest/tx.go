@@ -7,46 +7,57 @@ import (
 	"net/http"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/routes"
+	"github.com/cosmos/cosmos-sdk/client/utils"
 	"github.com/cosmos/cosmos-sdk/crypto/keys"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
 	authcliCtx "github.com/cosmos/cosmos-sdk/x/auth/client/context"
 	"github.com/cosmos/cosmos-sdk/x/stake"
 
-	"github.com/gorilla/mux"
-
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 )
 
-func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *wire.Codec, kb keys.Keybase) {
-	r.HandleFunc(
-		"/stake/delegators/{delegatorAddr}/delegations",
-		delegationsRequestHandlerFn(cdc, kb, cliCtx),
-	).Methods("POST")
+func registerTxRoutes(cliCtx context.CLIContext, r *routes.Router, cdc *wire.Codec, kb keys.Keybase) {
+	// the messages are broadcast in a tx each by a server holding keys
+	var response interface{} = new([]ctypes.ResultBroadcastTxCommit)
+	if kb == nil {
+		response = new(utils.UnsignedTxResponse)
+	}
+
+	r.Register(routes.Route{
+		Name:     "EditDelegations",
+		Method:   "POST",
+		Path:     "/stake/delegators/{delegatorAddr}/delegations",
+		Summary:  "Delegate, unbond and redelegate in a single transaction",
+		Request:  &EditDelegationsBody{},
+		Response: response,
+		Handler:  delegationsRequestHandlerFn(cdc, kb, cliCtx),
+	})
 }
 
-type msgDelegationsInput struct {
+type MsgDelegationsInput struct {
 	DelegatorAddr string   `json:"delegator_addr"` // in bech32
 	ValidatorAddr string   `json:"validator_addr"` // in bech32
 	Delegation    sdk.Coin `json:"delegation"`
 }
-type msgBeginRedelegateInput struct {
+type MsgBeginRedelegateInput struct {
 	DelegatorAddr    string `json:"delegator_addr"`     // in bech32
 	ValidatorSrcAddr string `json:"validator_src_addr"` // in bech32
 	ValidatorDstAddr string `json:"validator_dst_addr"` // in bech32
 	SharesAmount     string `json:"shares"`
 }
-type msgCompleteRedelegateInput struct {
+type MsgCompleteRedelegateInput struct {
 	DelegatorAddr    string `json:"delegator_addr"`     // in bech32
 	ValidatorSrcAddr string `json:"validator_src_addr"` // in bech32
 	ValidatorDstAddr string `json:"validator_dst_addr"` // in bech32
 }
-type msgBeginUnbondingInput struct {
+type MsgBeginUnbondingInput struct {
 	DelegatorAddr string `json:"delegator_addr"` // in bech32
 	ValidatorAddr string `json:"validator_addr"` // in bech32
 	SharesAmount  string `json:"shares"`
 }
-type msgCompleteUnbondingInput struct {
+type MsgCompleteUnbondingInput struct {
 	DelegatorAddr string `json:"delegator_addr"` // in bech32
 	ValidatorAddr string `json:"validator_addr"` // in bech32
 }
@@ -59,11 +70,16 @@ type EditDelegationsBody struct {
 	AccountNumber       int64                        `json:"account_number"`
 	Sequence            int64                        `json:"sequence"`
 	Gas                 int64                        `json:"gas"`
-	Delegations         []msgDelegationsInput        `json:"delegations"`
-	BeginUnbondings     []msgBeginUnbondingInput     `json:"begin_unbondings"`
-	CompleteUnbondings  []msgCompleteUnbondingInput  `json:"complete_unbondings"`
-	BeginRedelegates    []msgBeginRedelegateInput    `json:"begin_redelegates"`
-	CompleteRedelegates []msgCompleteRedelegateInput `json:"complete_redelegates"`
+	TimeoutHeight       int64                        `json:"timeout_height"`
+	Delegations         []MsgDelegationsInput        `json:"delegations"`
+	BeginUnbondings     []MsgBeginUnbondingInput     `json:"begin_unbondings"`
+	CompleteUnbondings  []MsgCompleteUnbondingInput  `json:"complete_unbondings"`
+	BeginRedelegates    []MsgBeginRedelegateInput    `json:"begin_redelegates"`
+	CompleteRedelegates []MsgCompleteRedelegateInput `json:"complete_redelegates"`
+	From                string                       `json:"from"` // in bech32, used when the server holds no keys
+	GenerateOnly        bool                         `json:"generate_only"`
+	Simulate            bool                         `json:"simulate"`
+	GasAdjustment       string                       `json:"gas_adjustment"`
 }
 
 // nolint: gocyclo
@@ -87,7 +103,7 @@ func delegationsRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, cliCtx contex
 			return
 		}
 
-		info, err := kb.Get(m.LocalAccountName)
+		from, err := utils.GetRESTFromAddress(kb, m.LocalAccountName, m.From)
 		if err != nil {
 			w.WriteHeader(http.StatusUnauthorized)
 			w.Write([]byte(err.Error()))
@@ -117,7 +133,7 @@ func delegationsRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, cliCtx contex
 				return
 			}
 
-			if !bytes.Equal(info.GetPubKey().Address(), delegatorAddr) {
+			if !bytes.Equal(from, delegatorAddr) {
 				w.WriteHeader(http.StatusUnauthorized)
 				w.Write([]byte("Must use own delegator address"))
 				return
@@ -140,7 +156,7 @@ func delegationsRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, cliCtx contex
 				return
 			}
 
-			if !bytes.Equal(info.GetPubKey().Address(), delegatorAddr) {
+			if !bytes.Equal(from, delegatorAddr) {
 				w.WriteHeader(http.StatusUnauthorized)
 				w.Write([]byte("Must use own delegator address"))
 				return
@@ -197,7 +213,7 @@ func delegationsRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, cliCtx contex
 				return
 			}
 
-			if !bytes.Equal(info.GetPubKey().Address(), delegatorAddr) {
+			if !bytes.Equal(from, delegatorAddr) {
 				w.WriteHeader(http.StatusUnauthorized)
 				w.Write([]byte("Must use own delegator address"))
 				return
@@ -220,7 +236,7 @@ func delegationsRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, cliCtx contex
 				return
 			}
 
-			if !bytes.Equal(info.GetPubKey().Address(), delegatorAddr) {
+			if !bytes.Equal(from, delegatorAddr) {
 				w.WriteHeader(http.StatusUnauthorized)
 				w.Write([]byte("Must use own delegator address"))
 				return
@@ -264,7 +280,7 @@ func delegationsRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, cliCtx contex
 				return
 			}
 
-			if !bytes.Equal(info.GetPubKey().Address(), delegatorAddr) {
+			if !bytes.Equal(from, delegatorAddr) {
 				w.WriteHeader(http.StatusUnauthorized)
 				w.Write([]byte("Must use own delegator address"))
 				return
@@ -279,9 +295,32 @@ func delegationsRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, cliCtx contex
 		}
 
 		txCtx := authcliCtx.TxContext{
-			Codec:   cdc,
-			ChainID: m.ChainID,
-			Gas:     m.Gas,
+			Codec:         cdc,
+			ChainID:       m.ChainID,
+			Gas:           m.Gas,
+			TimeoutHeight: m.TimeoutHeight,
+		}
+
+		// a server holding no keys only returns a single tx of the messages to
+		// sign
+		if kb == nil {
+			txCtx = txCtx.WithAccountNumber(m.AccountNumber).WithSequence(m.Sequence)
+			utils.WriteUnsignedTxResponse(w, txCtx, messages)
+			return
+		}
+
+		// the messages are returned in a single unsigned tx
+		if m.GenerateOnly {
+			utils.WriteGenerateStdTxResponse(w, txCtx, messages)
+			return
+		}
+
+		// the gas is estimated for a single tx of all the messages, as the txs
+		// broadcast one after the other cannot be simulated against the same state
+		if m.Simulate {
+			txCtx = txCtx.WithAccountNumber(m.AccountNumber).WithSequence(m.Sequence)
+			utils.WriteSimulationResponse(w, txCtx, cliCtx, m.LocalAccountName, m.Password, m.GasAdjustment, messages)
+			return
 		}
 
 		// sign messages
@@ -3,6 +3,7 @@ package rest
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -83,46 +84,6 @@ func getDelegatorDelegations(cliCtx context.CLIContext, cdc *wire.Codec, delegat
 	return outputDelegation, http.StatusOK, "", nil
 }
 
-func getDelegatorUndelegations(cliCtx context.CLIContext, cdc *wire.Codec, delegatorAddr sdk.AccAddress, validatorAddr sdk.AccAddress) (
-	unbonds types.UnbondingDelegation, httpStatusCode int, errMsg string, err error) {
-	undelegationKey := stake.GetUBDKey(delegatorAddr, validatorAddr)
-	marshalledUnbondingDelegation, err := cliCtx.QueryStore(undelegationKey, storeName)
-	if err != nil {
-		return types.UnbondingDelegation{}, http.StatusInternalServerError, "couldn't query unbonding-delegation. Error: ", err
-	}
-
-	if len(marshalledUnbondingDelegation) == 0 {
-		return types.UnbondingDelegation{}, http.StatusNoContent, "", nil
-	}
-
-	unbondingDelegation, err := types.UnmarshalUBD(cdc, undelegationKey, marshalledUnbondingDelegation)
-	if err != nil {
-		return types.UnbondingDelegation{}, http.StatusInternalServerError, "couldn't unmarshall unbonding-delegation. Error: ", err
-	}
-	return unbondingDelegation, http.StatusOK, "", nil
-}
-
-func getDelegatorRedelegations(cliCtx context.CLIContext, cdc *wire.Codec, delegatorAddr sdk.AccAddress, validatorAddr sdk.AccAddress) (
-	regelegations types.Redelegation, httpStatusCode int, errMsg string, err error) {
-
-	key := stake.GetREDsByDelToValDstIndexKey(delegatorAddr, validatorAddr)
-	marshalledRedelegations, err := cliCtx.QueryStore(key, storeName)
-	if err != nil {
-		return types.Redelegation{}, http.StatusInternalServerError, "couldn't query redelegation. Error: ", err
-	}
-
-	if len(marshalledRedelegations) == 0 {
-		return types.Redelegation{}, http.StatusNoContent, "", nil
-	}
-
-	redelegations, err := types.UnmarshalRED(cdc, key, marshalledRedelegations)
-	if err != nil {
-		return types.Redelegation{}, http.StatusInternalServerError, "couldn't unmarshall redelegations. Error: ", err
-	}
-
-	return redelegations, http.StatusOK, "", nil
-}
-
 // queries staking txs
 func queryTxs(node rpcclient.Client, cdc *wire.Codec, tag string, delegatorAddr string) ([]tx.Info, error) {
 	page := 0
@@ -157,24 +118,158 @@ func getValidators(validatorKVs []sdk.KVPair, cdc *wire.Codec) ([]types.BechVali
 	return validators, nil
 }
 
-//  gets all Bech32 validators from a key
-func getBech32Validators(storeName string, cliCtx context.CLIContext, cdc *wire.Codec) (
-	validators []types.BechValidator, httpStatusCode int, errMsg string, err error) {
+// parses the page and limit query parameters, a limit of 0 returns all results
+func parsePagination(r *http.Request) (page, limit int, err error) {
+	page, limit = 1, 0
 
-	// Get all validators using key
-	kvs, err := cliCtx.QuerySubspace(stake.ValidatorsKey, storeName)
+	if pageStr := r.URL.Query().Get("page"); pageStr != "" {
+		page, err = strconv.Atoi(pageStr)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	return page, limit, nil
+}
+
+func newDelegationWithoutRat(delegation types.Delegation) DelegationWithoutRat {
+	return DelegationWithoutRat{
+		DelegatorAddr: delegation.DelegatorAddr,
+		ValidatorAddr: delegation.ValidatorAddr,
+		Height:        delegation.Height,
+		Shares:        delegation.Shares.String(),
+	}
+}
+
+// gets a page of the delegations to a validator, using the delegations by validator index
+func getValidatorDelegations(cliCtx context.CLIContext, cdc *wire.Codec, validatorAddr sdk.AccAddress, page, limit int) (
+	delegations []DelegationWithoutRat, err error) {
+
+	kvs, err := cliCtx.QuerySubspacePage(stake.GetDelegationsByValIndexKey(validatorAddr), storeName, page, limit)
 	if err != nil {
-		return nil, http.StatusInternalServerError, "couldn't query validators. Error: ", err
+		return nil, err
 	}
 
-	// the query will return empty if there are no validators
-	if len(kvs) == 0 {
-		return nil, http.StatusNoContent, "", nil
+	delegations = make([]DelegationWithoutRat, 0, len(kvs))
+	for _, kv := range kvs {
+		key := stake.GetDelegationKeyFromValIndexKey(kv.Key)
+		res, err := cliCtx.QueryStore(key, storeName)
+		if err != nil {
+			return nil, err
+		}
+		// the delegation might have been removed since the index was queried
+		if len(res) == 0 {
+			continue
+		}
+
+		delegation, err := types.UnmarshalDelegation(cdc, key, res)
+		if err != nil {
+			return nil, err
+		}
+		delegations = append(delegations, newDelegationWithoutRat(delegation))
 	}
+	return delegations, nil
+}
 
-	validators, err = getValidators(kvs, cdc)
+// gets a page of the unbonding-delegations from a validator, using the unbonding-delegations by validator index
+func getValidatorUnbondingDelegations(cliCtx context.CLIContext, cdc *wire.Codec, validatorAddr sdk.AccAddress, page, limit int) (
+	ubds []types.UnbondingDelegation, err error) {
+
+	kvs, err := cliCtx.QuerySubspacePage(stake.GetUBDsByValIndexKey(validatorAddr), storeName, page, limit)
 	if err != nil {
-		return nil, http.StatusInternalServerError, "Error: ", err
+		return nil, err
 	}
-	return validators, http.StatusOK, "", nil
+
+	ubds = make([]types.UnbondingDelegation, 0, len(kvs))
+	for _, kv := range kvs {
+		key := stake.GetUBDKeyFromValIndexKey(kv.Key)
+		res, err := cliCtx.QueryStore(key, storeName)
+		if err != nil {
+			return nil, err
+		}
+		if len(res) == 0 {
+			continue
+		}
+
+		ubd, err := types.UnmarshalUBD(cdc, key, res)
+		if err != nil {
+			return nil, err
+		}
+		ubds = append(ubds, ubd)
+	}
+	return ubds, nil
+}
+
+// gets a page of the redelegations from or to a validator, using the given redelegations by validator index
+func getValidatorRedelegations(cliCtx context.CLIContext, cdc *wire.Codec, indexPrefix []byte,
+	keyFromIndexKey func([]byte) []byte, page, limit int) (reds []types.Redelegation, err error) {
+
+	kvs, err := cliCtx.QuerySubspacePage(indexPrefix, storeName, page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	reds = make([]types.Redelegation, 0, len(kvs))
+	for _, kv := range kvs {
+		key := keyFromIndexKey(kv.Key)
+		res, err := cliCtx.QueryStore(key, storeName)
+		if err != nil {
+			return nil, err
+		}
+		if len(res) == 0 {
+			continue
+		}
+
+		red, err := types.UnmarshalRED(cdc, key, res)
+		if err != nil {
+			return nil, err
+		}
+		reds = append(reds, red)
+	}
+	return reds, nil
+}
+
+// gets a page of the validators a delegator is bonded to, using the delegations of the delegator
+func getDelegatorValidators(cliCtx context.CLIContext, cdc *wire.Codec, delegatorAddr sdk.AccAddress, page, limit int) (
+	validators []types.BechValidator, err error) {
+
+	kvs, err := cliCtx.QuerySubspacePage(stake.GetDelegationsKey(delegatorAddr), storeName, page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	validators = make([]types.BechValidator, 0, len(kvs))
+	for _, kv := range kvs {
+		delegation, err := types.UnmarshalDelegation(cdc, kv.Key, kv.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		key := stake.GetValidatorKey(delegation.ValidatorAddr)
+		res, err := cliCtx.QueryStore(key, storeName)
+		if err != nil {
+			return nil, err
+		}
+		if len(res) == 0 {
+			continue
+		}
+
+		validator, err := types.UnmarshalValidator(cdc, delegation.ValidatorAddr, res)
+		if err != nil {
+			return nil, err
+		}
+		bech32Validator, err := validator.Bech32Validator()
+		if err != nil {
+			return nil, err
+		}
+		validators = append(validators, bech32Validator)
+	}
+	return validators, nil
 }
@@ -36,17 +36,23 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) (res [
 
 		keeper.SetValidatorByPowerIndex(ctx, validator, data.Pool)
 
+		keeper.Hooks().OnValidatorCreated(ctx, validator.PubKey, validator.Operator)
 		if validator.Status == sdk.Bonded {
 			keeper.SetValidatorBondedIndex(ctx, validator)
+			keeper.Hooks().OnValidatorBonded(ctx, validator.PubKey, validator.Operator)
 		}
 	}
 
 	for _, bond := range data.Bonds {
 		keeper.SetDelegation(ctx, bond)
+		keeper.Hooks().OnDelegationCreated(ctx, bond.DelegatorAddr, bond.ValidatorAddr)
 	}
 
 	keeper.UpdateBondedValidatorsFull(ctx)
 
+	// record the tokens held by the validators in the total supply
+	keeper.InitSupply(ctx)
+
 	vals := keeper.GetValidatorsBonded(ctx)
 	res = make([]abci.Validator, len(vals))
 	for i, val := range vals {
@@ -1,8 +1,6 @@
 package stake
 
 import (
-	"time"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/stake/keeper"
 	"github.com/cosmos/cosmos-sdk/x/stake/tags"
@@ -18,6 +16,8 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgCreateValidator(ctx, msg, k)
 		case types.MsgEditValidator:
 			return handleMsgEditValidator(ctx, msg, k)
+		case types.MsgRotateConsPubKey:
+			return handleMsgRotateConsPubKey(ctx, msg, k)
 		case types.MsgDelegate:
 			return handleMsgDelegate(ctx, msg, k)
 		case types.MsgBeginRedelegate:
@@ -28,24 +28,23 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgBeginUnbonding(ctx, msg, k)
 		case types.MsgCompleteUnbonding:
 			return handleMsgCompleteUnbonding(ctx, msg, k)
+		case types.MsgTokenizeShares:
+			return handleMsgTokenizeShares(ctx, msg, k)
+		case types.MsgRedeemTokens:
+			return handleMsgRedeemTokens(ctx, msg, k)
 		default:
 			return sdk.ErrTxDecode("invalid message parse in staking module").Result()
 		}
 	}
 }
 
-// Called every block, process inflation, update validator set
+// Called every block, update validator set
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) (ValidatorUpdates []abci.Validator) {
-	pool := k.GetPool(ctx)
+	// record the header and validator set of the block
+	k.TrackHistoricalInfo(ctx)
 
-	// Process provision inflation
-	blockTime := ctx.BlockHeader().Time
-	if blockTime.Sub(pool.InflationLastTime) >= time.Hour {
-		params := k.GetParams(ctx)
-		pool.InflationLastTime = blockTime
-		pool = pool.ProcessProvisions(params)
-		k.SetPool(ctx, pool)
-	}
+	// remove the index of the pubkeys rotated an unbonding time ago
+	k.RemoveMatureRotatedPubKeys(ctx)
 
 	// reset the intra-transaction counter
 	k.SetIntraTxCounter(ctx, 0)
@@ -77,8 +76,10 @@ func handleMsgCreateValidator(ctx sdk.Context, msg types.MsgCreateValidator, k k
 	}
 
 	validator := NewValidator(msg.ValidatorAddr, msg.PubKey, msg.Description)
+	validator.MinSelfDelegation = msg.MinSelfDelegation
 	k.SetValidator(ctx, validator)
 	k.SetValidatorByPubKeyIndex(ctx, validator)
+	k.Hooks().OnValidatorCreated(ctx, validator.PubKey, validator.Operator)
 
 	// move coins from the msg.Address account to a (self-delegation) delegator account
 	// the validator account and global shares are updated within here
@@ -107,20 +108,60 @@ func handleMsgEditValidator(ctx sdk.Context, msg types.MsgEditValidator, k keepe
 	}
 
 	// replace all editable fields (clients should autofill existing values)
-	description, err := validator.Description.UpdateDescription(msg.Description)
-	if err != nil {
-		return err.Result()
+	if msg.Description != (Description{}) {
+		description, err := validator.Description.UpdateDescription(msg.Description)
+		if err != nil {
+			return err.Result()
+		}
+		validator.Description = description
+	}
+
+	// the minimum self-delegation may only be increased, up to the current
+	// self-delegation
+	if msg.MinSelfDelegation != nil {
+		if msg.MinSelfDelegation.LT(validator.MinSelfDelegation) {
+			return ErrMinSelfDelegationDecreased(k.Codespace()).Result()
+		}
+		validator.MinSelfDelegation = *msg.MinSelfDelegation
+		if k.SelfDelegationBelowMinimum(ctx, validator) {
+			return ErrSelfDelegationBelowMinimum(k.Codespace()).Result()
+		}
 	}
-	validator.Description = description
 
 	// We don't need to run through all the power update logic within k.UpdateValidator
-	// We just need to override the entry in state, since only the description has changed.
+	// We just need to override the entry in state, since only the description
+	// and the minimum self-delegation have changed.
 	k.SetValidator(ctx, validator)
 	tags := sdk.NewTags(
 		tags.Action, tags.ActionEditValidator,
 		tags.DstValidator, []byte(msg.ValidatorAddr.String()),
-		tags.Moniker, []byte(description.Moniker),
-		tags.Identity, []byte(description.Identity),
+		tags.Moniker, []byte(validator.Description.Moniker),
+		tags.Identity, []byte(validator.Description.Identity),
+	)
+	return sdk.Result{
+		Tags: tags,
+	}
+}
+
+func handleMsgRotateConsPubKey(ctx sdk.Context, msg types.MsgRotateConsPubKey, k keeper.Keeper) sdk.Result {
+
+	// validator must already be registered
+	validator, found := k.GetValidator(ctx, msg.ValidatorAddr)
+	if !found {
+		return ErrNoValidatorFound(k.Codespace()).Result()
+	}
+
+	// the new pubkey must not be used by any validator, including as a pubkey
+	// rotated within the unbonding time
+	_, found = k.GetValidatorByPubKey(ctx, msg.NewPubKey)
+	if found {
+		return ErrValidatorPubKeyExists(k.Codespace()).Result()
+	}
+
+	k.RotateConsPubKey(ctx, validator, msg.NewPubKey)
+	tags := sdk.NewTags(
+		tags.Action, tags.ActionRotateConsPubKey,
+		tags.DstValidator, []byte(msg.ValidatorAddr.String()),
 	)
 	return sdk.Result{
 		Tags: tags,
@@ -214,3 +255,33 @@ func handleMsgCompleteRedelegate(ctx sdk.Context, msg types.MsgCompleteRedelegat
 	)
 	return sdk.Result{Tags: tags}
 }
+
+func handleMsgTokenizeShares(ctx sdk.Context, msg types.MsgTokenizeShares, k keeper.Keeper) sdk.Result {
+	_, err := k.TokenizeShares(ctx, msg.DelegatorAddr, msg.ValidatorAddr, msg.SharesAmount)
+	if err != nil {
+		return err.Result()
+	}
+
+	tags := sdk.NewTags(
+		tags.Action, tags.ActionTokenizeShares,
+		tags.Delegator, []byte(msg.DelegatorAddr.String()),
+		tags.SrcValidator, []byte(msg.ValidatorAddr.String()),
+	)
+	return sdk.Result{Tags: tags}
+}
+
+func handleMsgRedeemTokens(ctx sdk.Context, msg types.MsgRedeemTokens, k keeper.Keeper) sdk.Result {
+	err := k.RedeemTokens(ctx, msg.DelegatorAddr, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+
+	// the denomination was checked by the keeper
+	validatorAddr, _ := types.ParseSharesDenom(msg.Amount.Denom)
+	tags := sdk.NewTags(
+		tags.Action, tags.ActionRedeemTokens,
+		tags.Delegator, []byte(msg.DelegatorAddr.String()),
+		tags.DstValidator, []byte(validatorAddr.String()),
+	)
+	return sdk.Result{Tags: tags}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,7 +18,7 @@ import (
 //______________________________________________________________________
 
 func newTestMsgCreateValidator(address sdk.AccAddress, pubKey crypto.PubKey, amt int64) MsgCreateValidator {
-	return types.NewMsgCreateValidator(address, pubKey, sdk.Coin{"steak", sdk.NewInt(amt)}, Description{})
+	return types.NewMsgCreateValidator(address, pubKey, sdk.Coin{"steak", sdk.NewInt(amt)}, sdk.ZeroInt(), Description{})
 }
 
 func newTestMsgDelegate(delegatorAddr, validatorAddr sdk.AccAddress, amt int64) MsgDelegate {
@@ -30,11 +31,12 @@ func newTestMsgDelegate(delegatorAddr, validatorAddr sdk.AccAddress, amt int64)
 
 func newTestMsgCreateValidatorOnBehalfOf(delegatorAddr, validatorAddr sdk.AccAddress, valPubKey crypto.PubKey, amt int64) MsgCreateValidator {
 	return MsgCreateValidator{
-		Description:   Description{},
-		DelegatorAddr: delegatorAddr,
-		ValidatorAddr: validatorAddr,
-		PubKey:        valPubKey,
-		Delegation:    sdk.Coin{"steak", sdk.NewInt(amt)},
+		Description:       Description{},
+		DelegatorAddr:     delegatorAddr,
+		ValidatorAddr:     validatorAddr,
+		PubKey:            valPubKey,
+		Delegation:        sdk.Coin{"steak", sdk.NewInt(amt)},
+		MinSelfDelegation: sdk.ZeroInt(),
 	}
 }
 
@@ -81,7 +83,7 @@ func TestValidatorByPowerIndex(t *testing.T) {
 	require.True(t, got.IsOK(), "expected create-validator to be ok, got %v", got)
 
 	// slash and revoke the first validator
-	keeper.Slash(ctx, keep.PKs[0], 0, initBond, sdk.NewDecWithPrec(5, 1))
+	keeper.Slash(ctx, keep.PKs[0], 0, initBond, sdk.NewDecWithPrec(5, 1), sdk.InfractionDoubleSign)
 	keeper.Revoke(ctx, keep.PKs[0])
 	validator, found = keeper.GetValidator(ctx, validatorAddr)
 	require.True(t, found)
@@ -101,9 +103,9 @@ func TestValidatorByPowerIndex(t *testing.T) {
 	// inflate a bunch
 	params := keeper.GetParams(ctx)
 	for i := 0; i < 200; i++ {
-		pool = pool.ProcessProvisions(params)
-		keeper.SetPool(ctx, pool)
+		keeper.InflateSupply(ctx, sdk.Coins{sdk.NewInt64Coin(params.BondDenom, 1000)})
 	}
+	pool = keeper.GetPool(ctx)
 
 	// now the new record power index should be the same as the original record
 	power3 := GetValidatorsByPowerIndexKey(validator, pool)
@@ -847,7 +849,7 @@ func TestBondUnbondRedelegateSlashTwice(t *testing.T) {
 	require.Equal(t, sdk.NewDec(6), delegation.Shares)
 
 	// slash the validator by half
-	keeper.Slash(ctx, keep.PKs[0], 0, 20, sdk.NewDecWithPrec(5, 1))
+	keeper.Slash(ctx, keep.PKs[0], 0, 20, sdk.NewDecWithPrec(5, 1), sdk.InfractionDoubleSign)
 
 	// unbonding delegation should have been slashed by half
 	unbonding, found := keeper.GetUnbondingDelegation(ctx, del, valA)
@@ -871,7 +873,7 @@ func TestBondUnbondRedelegateSlashTwice(t *testing.T) {
 
 	// slash the validator for an infraction committed after the unbonding and redelegation begin
 	ctx = ctx.WithBlockHeight(3)
-	keeper.Slash(ctx, keep.PKs[0], 2, 10, sdk.NewDecWithPrec(5, 1))
+	keeper.Slash(ctx, keep.PKs[0], 2, 10, sdk.NewDecWithPrec(5, 1), sdk.InfractionDoubleSign)
 
 	// unbonding delegation should be unchanged
 	unbonding, found = keeper.GetUnbondingDelegation(ctx, del, valA)
@@ -893,3 +895,112 @@ func TestBondUnbondRedelegateSlashTwice(t *testing.T) {
 	_, found = keeper.GetValidator(ctx, valA)
 	require.False(t, found)
 }
+
+func TestRotateConsPubKey(t *testing.T) {
+	ctx, _, keeper := keep.CreateTestInput(t, false, 1000)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(0, 0)})
+	validatorAddr, validatorAddr2 := keep.Addrs[0], keep.Addrs[1]
+	oldPubKey, newPubKey := keep.PKs[0], keep.PKs[2]
+	params := keeper.GetParams(ctx)
+	params.UnbondingTime = 7 * time.Second
+	keeper.SetParams(ctx, params)
+
+	// create two bonded validators
+	got := handleMsgCreateValidator(ctx, newTestMsgCreateValidator(validatorAddr, oldPubKey, 10), keeper)
+	require.True(t, got.IsOK(), "expected no error on runMsgCreateValidator")
+	got = handleMsgCreateValidator(ctx, newTestMsgCreateValidator(validatorAddr2, keep.PKs[1], 10), keeper)
+	require.True(t, got.IsOK(), "expected no error on runMsgCreateValidator")
+	EndBlocker(ctx, keeper)
+
+	// cannot rotate to a pubkey in use
+	got = handleMsgRotateConsPubKey(ctx, NewMsgRotateConsPubKey(validatorAddr, keep.PKs[1]), keeper)
+	require.False(t, got.IsOK(), "expected an error")
+
+	// rotate the pubkey, updating Tendermint with both pubkeys
+	got = handleMsgRotateConsPubKey(ctx, NewMsgRotateConsPubKey(validatorAddr, newPubKey), keeper)
+	require.True(t, got.IsOK(), "expected no error, got %v", got)
+	validator, found := keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, newPubKey, validator.PubKey)
+	updates := EndBlocker(ctx, keeper)
+	require.Equal(t, 2, len(updates))
+	require.Contains(t, updates, validator.ABCIValidator())
+	oldValidator := validator
+	oldValidator.PubKey = oldPubKey
+	require.Contains(t, updates, oldValidator.ABCIValidatorZero())
+
+	// both pubkeys are indexed, and the old pubkey cannot be reused
+	validator, found = keeper.GetValidatorByPubKey(ctx, newPubKey)
+	require.True(t, found)
+	require.Equal(t, validatorAddr, validator.Operator)
+	validator, found = keeper.GetValidatorByPubKey(ctx, oldPubKey)
+	require.True(t, found)
+	require.Equal(t, validatorAddr, validator.Operator)
+	got = handleMsgRotateConsPubKey(ctx, NewMsgRotateConsPubKey(validatorAddr2, oldPubKey), keeper)
+	require.False(t, got.IsOK(), "expected an error")
+
+	// evidence against the old pubkey is slashed within the unbonding time
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(0, 0).Add(6 * time.Second)})
+	EndBlocker(ctx, keeper)
+	keeper.Slash(ctx, oldPubKey, 0, 10, sdk.NewDecWithPrec(5, 1), sdk.InfractionDoubleSign)
+	validator, _ = keeper.GetValidator(ctx, validatorAddr)
+	require.Equal(t, int64(5), validator.Tokens.RoundInt64())
+
+	// the old pubkey is no longer indexed after the unbonding time
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(0, 0).Add(7 * time.Second)})
+	EndBlocker(ctx, keeper)
+	_, found = keeper.GetValidatorByPubKey(ctx, oldPubKey)
+	require.False(t, found)
+	_, found = keeper.GetValidatorByPubKey(ctx, newPubKey)
+	require.True(t, found)
+}
+
+func TestMinSelfDelegation(t *testing.T) {
+	ctx, _, keeper := keep.CreateTestInput(t, false, 1000)
+	validatorAddr, validatorAddr2, delegatorAddr := keep.Addrs[0], keep.Addrs[1], keep.Addrs[2]
+
+	// create a validator with a minimum self-delegation, and delegate to it
+	msgCreateValidator := newTestMsgCreateValidator(validatorAddr, keep.PKs[0], 10)
+	msgCreateValidator.MinSelfDelegation = sdk.NewInt(8)
+	got := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.True(t, got.IsOK(), "expected no error on runMsgCreateValidator")
+	got = handleMsgDelegate(ctx, newTestMsgDelegate(delegatorAddr, validatorAddr, 10), keeper)
+	require.True(t, got.IsOK(), "expected no error on runMsgDelegate")
+
+	// the minimum cannot be decreased, nor increased above the self-delegation
+	minSelfDelegation := sdk.NewInt(7)
+	got = handleMsgEditValidator(ctx, NewMsgEditValidator(validatorAddr, Description{}, &minSelfDelegation), keeper)
+	require.False(t, got.IsOK(), "expected an error")
+	minSelfDelegation = sdk.NewInt(11)
+	got = handleMsgEditValidator(ctx, NewMsgEditValidator(validatorAddr, Description{}, &minSelfDelegation), keeper)
+	require.False(t, got.IsOK(), "expected an error")
+	minSelfDelegation = sdk.NewInt(9)
+	got = handleMsgEditValidator(ctx, NewMsgEditValidator(validatorAddr, Description{}, &minSelfDelegation), keeper)
+	require.True(t, got.IsOK(), "expected no error, got %v", got)
+	validator, found := keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, int64(9), validator.MinSelfDelegation.Int64())
+	require.Equal(t, "", validator.Description.Moniker)
+
+	// unbonding down to the minimum keeps the validator
+	got = handleMsgBeginUnbonding(ctx, NewMsgBeginUnbonding(validatorAddr, validatorAddr, sdk.NewDec(1)), keeper)
+	require.True(t, got.IsOK(), "expected no error, got %v", got)
+	validator, _ = keeper.GetValidator(ctx, validatorAddr)
+	require.False(t, validator.Revoked)
+
+	// redelegating below the minimum revokes the validator
+	got = handleMsgCreateValidator(ctx, newTestMsgCreateValidator(validatorAddr2, keep.PKs[1], 10), keeper)
+	require.True(t, got.IsOK(), "expected no error on runMsgCreateValidator")
+	got = handleMsgBeginRedelegate(ctx, NewMsgBeginRedelegate(validatorAddr, validatorAddr, validatorAddr2, sdk.NewDec(1)), keeper)
+	require.True(t, got.IsOK(), "expected no error, got %v", got)
+	validator, _ = keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, validator.Revoked)
+
+	// slashing the self-delegation below the minimum revokes the validator
+	minSelfDelegation = sdk.NewInt(10)
+	got = handleMsgEditValidator(ctx, NewMsgEditValidator(validatorAddr2, Description{}, &minSelfDelegation), keeper)
+	require.True(t, got.IsOK(), "expected no error, got %v", got)
+	keeper.Slash(ctx, keep.PKs[1], 0, 11, sdk.NewDecWithPrec(1, 1), sdk.InfractionDowntime)
+	validator, _ = keeper.GetValidator(ctx, validatorAddr2)
+	require.True(t, validator.Revoked)
+}
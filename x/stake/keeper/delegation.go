@@ -62,17 +62,33 @@ func (k Keeper) GetDelegations(ctx sdk.Context, delegator sdk.AccAddress,
 	return delegations[:i] // trim
 }
 
-// set the delegation
+// load all delegations to a particular validator
+func (k Keeper) GetValidatorDelegations(ctx sdk.Context, valAddr sdk.AccAddress) (delegations []types.Delegation) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, GetDelegationsByValIndexKey(valAddr))
+	for ; iterator.Valid(); iterator.Next() {
+		key := GetDelegationKeyFromValIndexKey(iterator.Key())
+		value := store.Get(key)
+		delegation := types.MustUnmarshalDelegation(k.cdc, key, value)
+		delegations = append(delegations, delegation)
+	}
+	iterator.Close()
+	return delegations
+}
+
+// set the delegation and associated index
 func (k Keeper) SetDelegation(ctx sdk.Context, delegation types.Delegation) {
 	store := ctx.KVStore(k.storeKey)
 	b := types.MustMarshalDelegation(k.cdc, delegation)
 	store.Set(GetDelegationKey(delegation.DelegatorAddr, delegation.ValidatorAddr), b)
+	store.Set(GetDelegationByValIndexKey(delegation.DelegatorAddr, delegation.ValidatorAddr), []byte{}) // index, store empty bytes
 }
 
-// remove the delegation
+// remove the delegation and associated index
 func (k Keeper) RemoveDelegation(ctx sdk.Context, delegation types.Delegation) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(GetDelegationKey(delegation.DelegatorAddr, delegation.ValidatorAddr))
+	store.Delete(GetDelegationByValIndexKey(delegation.DelegatorAddr, delegation.ValidatorAddr))
 }
 
 //_____________________________________________________________________________________
@@ -232,7 +248,7 @@ func (k Keeper) Delegate(ctx sdk.Context, delegatorAddr sdk.AccAddress, bondAmt
 
 	if subtractAccount {
 		// Account new shares, save
-		_, _, err = k.coinKeeper.SubtractCoins(ctx, delegation.DelegatorAddr, sdk.Coins{bondAmt})
+		_, err = k.coinKeeper.DelegateCoins(ctx, delegation.DelegatorAddr, types.ModuleName, sdk.Coins{bondAmt})
 		if err != nil {
 			return
 		}
@@ -247,6 +263,11 @@ func (k Keeper) Delegate(ctx sdk.Context, delegatorAddr sdk.AccAddress, bondAmt
 
 	k.SetPool(ctx, pool)
 	k.SetDelegation(ctx, delegation)
+	if found {
+		k.Hooks().OnDelegationSharesModified(ctx, delegation.DelegatorAddr, delegation.ValidatorAddr)
+	} else {
+		k.Hooks().OnDelegationCreated(ctx, delegation.DelegatorAddr, delegation.ValidatorAddr)
+	}
 	k.UpdateValidator(ctx, validator)
 
 	return
@@ -288,10 +309,12 @@ func (k Keeper) unbond(ctx sdk.Context, delegatorAddr, validatorAddr sdk.AccAddr
 			validator.Revoked = true
 		}
 		k.RemoveDelegation(ctx, delegation)
+		k.Hooks().OnDelegationRemoved(ctx, delegation.DelegatorAddr, delegation.ValidatorAddr)
 	} else {
 		// Update height
 		delegation.Height = ctx.BlockHeight()
 		k.SetDelegation(ctx, delegation)
+		k.Hooks().OnDelegationSharesModified(ctx, delegation.DelegatorAddr, delegation.ValidatorAddr)
 	}
 
 	// remove the coins from the validator
@@ -300,6 +323,13 @@ func (k Keeper) unbond(ctx sdk.Context, delegatorAddr, validatorAddr sdk.AccAddr
 
 	k.SetPool(ctx, pool)
 
+	// revoke the validator if the operator unbonded below the minimum
+	// self-delegation
+	if bytes.Equal(delegation.DelegatorAddr, validator.Operator) && !validator.Revoked &&
+		k.SelfDelegationBelowMinimum(ctx, validator) {
+		validator.Revoked = true
+	}
+
 	// update then remove validator if necessary
 	validator = k.UpdateValidator(ctx, validator)
 	if validator.DelegatorShares.IsZero() {
@@ -329,6 +359,7 @@ func (k Keeper) BeginUnbonding(ctx sdk.Context, delegatorAddr, validatorAddr sdk
 	params := k.GetParams(ctx)
 	minTime := ctx.BlockHeader().Time.Add(params.UnbondingTime)
 	balance := sdk.Coin{params.BondDenom, returnAmount.RoundInt()}
+	k.AddUndistributedTokens(ctx, returnAmount.Sub(sdk.NewDecFromInt(balance.Amount)))
 
 	ubd := types.UnbondingDelegation{
 		DelegatorAddr:  delegatorAddr,
@@ -355,7 +386,7 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delegatorAddr, validatorAddr
 		return types.ErrNotMature(k.Codespace(), "unbonding", "unit-time", ubd.MinTime, ctxTime)
 	}
 
-	_, _, err := k.coinKeeper.AddCoins(ctx, ubd.DelegatorAddr, sdk.Coins{ubd.Balance})
+	_, err := k.coinKeeper.UndelegateCoins(ctx, types.ModuleName, ubd.DelegatorAddr, sdk.Coins{ubd.Balance})
 	if err != nil {
 		return err
 	}
@@ -379,6 +410,7 @@ func (k Keeper) BeginRedelegation(ctx sdk.Context, delegatorAddr, validatorSrcAd
 
 	params := k.GetParams(ctx)
 	returnCoin := sdk.Coin{params.BondDenom, returnAmount.RoundInt()}
+	k.AddUndistributedTokens(ctx, returnAmount.Sub(sdk.NewDecFromInt(returnCoin.Amount)))
 	dstValidator, found := k.GetValidator(ctx, validatorDstAddr)
 	if !found {
 		return types.ErrBadRedelegationDst(k.Codespace())
@@ -422,3 +454,19 @@ func (k Keeper) CompleteRedelegation(ctx sdk.Context, delegatorAddr, validatorSr
 	k.RemoveRedelegation(ctx, red)
 	return nil
 }
+
+//______________________________________________________________________________________________________
+
+// whether the tokens of the self-delegation of the operator of a validator
+// are below its minimum self-delegation
+func (k Keeper) SelfDelegationBelowMinimum(ctx sdk.Context, validator types.Validator) bool {
+	if validator.MinSelfDelegation.IsZero() {
+		return false
+	}
+	delegation, found := k.GetDelegation(ctx, validator.Operator, validator.Operator)
+	if !found {
+		return true
+	}
+	tokens := delegation.Shares.Mul(validator.DelegatorShareExRate())
+	return tokens.LT(sdk.NewDecFromInt(validator.MinSelfDelegation))
+}
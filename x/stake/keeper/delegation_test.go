@@ -10,7 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-// tests GetDelegation, GetDelegations, SetDelegation, RemoveDelegation, GetDelegations
+// tests GetDelegation, GetDelegations, GetValidatorDelegations, SetDelegation, RemoveDelegation
 func TestDelegation(t *testing.T) {
 	ctx, _, keeper := CreateTestInput(t, false, 10)
 	pool := keeper.GetPool(ctx)
@@ -87,6 +87,10 @@ func TestDelegation(t *testing.T) {
 	require.True(t, bond2to1.Equal(allBonds[3]))
 	require.True(t, bond2to2.Equal(allBonds[4]))
 	require.True(t, bond2to3.Equal(allBonds[5]))
+	valBonds := keeper.GetValidatorDelegations(ctx, addrVals[0])
+	require.Equal(t, 2, len(valBonds))
+	valBonds = keeper.GetValidatorDelegations(ctx, addrVals[2])
+	require.Equal(t, 2, len(valBonds))
 
 	// delete a record
 	keeper.RemoveDelegation(ctx, bond2to3)
@@ -96,6 +100,9 @@ func TestDelegation(t *testing.T) {
 	require.Equal(t, 2, len(resBonds))
 	require.True(t, bond2to1.Equal(resBonds[0]))
 	require.True(t, bond2to2.Equal(resBonds[1]))
+	valBonds = keeper.GetValidatorDelegations(ctx, addrVals[2])
+	require.Equal(t, 1, len(valBonds))
+	require.True(t, bond1to3.Equal(valBonds[0]))
 
 	// delete all the records from delegator 2
 	keeper.RemoveDelegation(ctx, bond2to1)
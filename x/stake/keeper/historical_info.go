@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake/types"
+)
+
+// get the historical info of a height
+func (k Keeper) GetHistoricalInfo(ctx sdk.Context, height int64) (hi types.HistoricalInfo, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(GetHistoricalInfoKey(height))
+	if b == nil {
+		return hi, false
+	}
+	k.cdc.MustUnmarshalBinary(b, &hi)
+	return hi, true
+}
+
+// set the historical info of a height
+func (k Keeper) SetHistoricalInfo(ctx sdk.Context, height int64, hi types.HistoricalInfo) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinary(hi)
+	store.Set(GetHistoricalInfoKey(height), b)
+}
+
+// delete the historical info of a height
+func (k Keeper) DeleteHistoricalInfo(ctx sdk.Context, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetHistoricalInfoKey(height))
+}
+
+// record the header and the bonded validators of the current block, keeping
+// the historical info of the last HistoricalEntries blocks only
+func (k Keeper) TrackHistoricalInfo(ctx sdk.Context) {
+	entries := int64(k.GetParams(ctx).HistoricalEntries)
+
+	// Prune the entries older than the kept ones. As entries are pruned every
+	// block, more than one entry is pruned only after HistoricalEntries was
+	// decreased, and the pruning stops at the first missing entry.
+	pruneHeight := ctx.BlockHeight() - entries
+	if entries == 0 {
+		pruneHeight = ctx.BlockHeight() - 1
+	}
+	for height := pruneHeight; height >= 0; height-- {
+		if _, found := k.GetHistoricalInfo(ctx, height); !found {
+			break
+		}
+		k.DeleteHistoricalInfo(ctx, height)
+	}
+
+	if entries == 0 {
+		return
+	}
+	hi := types.NewHistoricalInfo(ctx.BlockHeader(), k.GetValidatorsBonded(ctx))
+	k.SetHistoricalInfo(ctx, ctx.BlockHeight(), hi)
+}
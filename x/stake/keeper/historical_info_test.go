@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/x/stake/types"
+)
+
+func TestTrackHistoricalInfo(t *testing.T) {
+	ctx, _, keeper := CreateTestInput(t, false, 1000)
+	params := keeper.GetParams(ctx)
+	params.HistoricalEntries = 5
+	keeper.SetParams(ctx, params)
+
+	// bond a validator
+	pool := keeper.GetPool(ctx)
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	validator, pool, _ = validator.AddTokensFromDel(pool, 10)
+	keeper.SetPool(ctx, pool)
+	validator = keeper.UpdateValidator(ctx, validator)
+
+	// record the first 10 blocks, keeping the last 5 only
+	for height := int64(1); height <= 10; height++ {
+		ctx = ctx.WithBlockHeader(abci.Header{ChainID: "test", Height: height}).WithBlockHeight(height)
+		keeper.TrackHistoricalInfo(ctx)
+	}
+	for height := int64(1); height <= 5; height++ {
+		_, found := keeper.GetHistoricalInfo(ctx, height)
+		require.False(t, found, "height %d", height)
+	}
+	for height := int64(6); height <= 10; height++ {
+		hi, found := keeper.GetHistoricalInfo(ctx, height)
+		require.True(t, found, "height %d", height)
+		require.Equal(t, height, hi.Header.Height)
+		require.Equal(t, "test", hi.Header.ChainID)
+		require.Equal(t, 1, len(hi.ValSet))
+		require.True(t, validator.Equal(hi.ValSet[0]))
+	}
+
+	// decreasing the number of entries prunes the older entries at once
+	params.HistoricalEntries = 2
+	keeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "test", Height: 11}).WithBlockHeight(11)
+	keeper.TrackHistoricalInfo(ctx)
+	for height := int64(6); height <= 9; height++ {
+		_, found := keeper.GetHistoricalInfo(ctx, height)
+		require.False(t, found, "height %d", height)
+	}
+	for height := int64(10); height <= 11; height++ {
+		_, found := keeper.GetHistoricalInfo(ctx, height)
+		require.True(t, found, "height %d", height)
+	}
+
+	// no entries are kept
+	params.HistoricalEntries = 0
+	keeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "test", Height: 12}).WithBlockHeight(12)
+	keeper.TrackHistoricalInfo(ctx)
+	for height := int64(10); height <= 12; height++ {
+		_, found := keeper.GetHistoricalInfo(ctx, height)
+		require.False(t, found, "height %d", height)
+	}
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// AddHooks registers the staking hooks of a module. The hooks are called in
+// the order they were registered, and are shared by all copies of the keeper,
+// so they may be registered after the keeper was passed to other keepers.
+func (k Keeper) AddHooks(hooks sdk.StakingHooks) {
+	*k.hooks = append(*k.hooks, hooks)
+}
+
+// Hooks returns the registered staking hooks
+func (k Keeper) Hooks() sdk.StakingHooks {
+	return multiHooks(*k.hooks)
+}
+
+// combination of staking hooks, called in order
+type multiHooks []sdk.StakingHooks
+
+var _ sdk.StakingHooks = multiHooks{}
+
+// nolint
+func (h multiHooks) OnValidatorCreated(ctx sdk.Context, pubKey crypto.PubKey, operator sdk.AccAddress) {
+	for _, hooks := range h {
+		hooks.OnValidatorCreated(ctx, pubKey, operator)
+	}
+}
+func (h multiHooks) OnValidatorBonded(ctx sdk.Context, pubKey crypto.PubKey, operator sdk.AccAddress) {
+	for _, hooks := range h {
+		hooks.OnValidatorBonded(ctx, pubKey, operator)
+	}
+}
+func (h multiHooks) OnValidatorBeginUnbonding(ctx sdk.Context, pubKey crypto.PubKey, operator sdk.AccAddress) {
+	for _, hooks := range h {
+		hooks.OnValidatorBeginUnbonding(ctx, pubKey, operator)
+	}
+}
+func (h multiHooks) OnValidatorRemoved(ctx sdk.Context, pubKey crypto.PubKey, operator sdk.AccAddress) {
+	for _, hooks := range h {
+		hooks.OnValidatorRemoved(ctx, pubKey, operator)
+	}
+}
+func (h multiHooks) OnValidatorConsPubKeyRotated(ctx sdk.Context, oldPubKey, newPubKey crypto.PubKey, operator sdk.AccAddress) {
+	for _, hooks := range h {
+		hooks.OnValidatorConsPubKeyRotated(ctx, oldPubKey, newPubKey, operator)
+	}
+}
+func (h multiHooks) OnDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.AccAddress) {
+	for _, hooks := range h {
+		hooks.OnDelegationCreated(ctx, delAddr, valAddr)
+	}
+}
+func (h multiHooks) OnDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.AccAddress) {
+	for _, hooks := range h {
+		hooks.OnDelegationSharesModified(ctx, delAddr, valAddr)
+	}
+}
+func (h multiHooks) OnDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.AccAddress) {
+	for _, hooks := range h {
+		hooks.OnDelegationRemoved(ctx, delAddr, valAddr)
+	}
+}
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake/types"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// staking hooks recording the calls to a shared log
+type recordingHooks struct {
+	name string
+	log  *[]string
+}
+
+var _ sdk.StakingHooks = recordingHooks{}
+
+func (h recordingHooks) record(event string, addr sdk.AccAddress) {
+	*h.log = append(*h.log, fmt.Sprintf("%s:%s:%s", h.name, event, addr))
+}
+
+// nolint
+func (h recordingHooks) OnValidatorCreated(_ sdk.Context, _ crypto.PubKey, operator sdk.AccAddress) {
+	h.record("validator-created", operator)
+}
+func (h recordingHooks) OnValidatorBonded(_ sdk.Context, _ crypto.PubKey, operator sdk.AccAddress) {
+	h.record("validator-bonded", operator)
+}
+func (h recordingHooks) OnValidatorBeginUnbonding(_ sdk.Context, _ crypto.PubKey, operator sdk.AccAddress) {
+	h.record("validator-begin-unbonding", operator)
+}
+func (h recordingHooks) OnValidatorRemoved(_ sdk.Context, _ crypto.PubKey, operator sdk.AccAddress) {
+	h.record("validator-removed", operator)
+}
+func (h recordingHooks) OnValidatorConsPubKeyRotated(_ sdk.Context, _, _ crypto.PubKey, operator sdk.AccAddress) {
+	h.record("validator-pubkey-rotated", operator)
+}
+func (h recordingHooks) OnDelegationCreated(_ sdk.Context, delAddr sdk.AccAddress, _ sdk.AccAddress) {
+	h.record("delegation-created", delAddr)
+}
+func (h recordingHooks) OnDelegationSharesModified(_ sdk.Context, delAddr sdk.AccAddress, _ sdk.AccAddress) {
+	h.record("delegation-modified", delAddr)
+}
+func (h recordingHooks) OnDelegationRemoved(_ sdk.Context, delAddr sdk.AccAddress, _ sdk.AccAddress) {
+	h.record("delegation-removed", delAddr)
+}
+
+// the events recorded by both hooks, in registration order
+func hookEvents(event string, addr sdk.AccAddress) []string {
+	return []string{
+		fmt.Sprintf("first:%s:%s", event, addr),
+		fmt.Sprintf("second:%s:%s", event, addr),
+	}
+}
+
+func TestHooksOrder(t *testing.T) {
+	ctx, _, keeper := CreateTestInput(t, false, 1000)
+	params := keeper.GetParams(ctx)
+	params.MaxValidators = 1
+	keeper.SetParams(ctx, params)
+
+	var log []string
+	keeper.AddHooks(recordingHooks{"first", &log})
+	keeper.AddHooks(recordingHooks{"second", &log})
+	requireEvents := func(expected ...[]string) {
+		events := []string{}
+		for _, e := range expected {
+			events = append(events, e...)
+		}
+		require.Equal(t, events, log)
+		log = nil
+	}
+
+	// bond a first validator
+	pool := keeper.GetPool(ctx)
+	validator0 := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	validator0, pool, _ = validator0.AddTokensFromDel(pool, 10)
+	keeper.SetPool(ctx, pool)
+	keeper.UpdateValidator(ctx, validator0)
+	requireEvents(hookEvents("validator-bonded", addrVals[0]))
+
+	// a stronger validator replaces it, unbonding it before being bonded
+	pool = keeper.GetPool(ctx)
+	validator1 := types.NewValidator(addrVals[1], PKs[1], types.Description{})
+	validator1, pool, _ = validator1.AddTokensFromDel(pool, 20)
+	keeper.SetPool(ctx, pool)
+	validator1 = keeper.UpdateValidator(ctx, validator1)
+	requireEvents(
+		hookEvents("validator-begin-unbonding", addrVals[0]),
+		hookEvents("validator-bonded", addrVals[1]),
+	)
+
+	// delegate twice, creating then modifying the delegation
+	_, err := keeper.Delegate(ctx, addrDels[0], sdk.NewInt64Coin(params.BondDenom, 10), validator1, true)
+	require.Nil(t, err)
+	requireEvents(hookEvents("delegation-created", addrDels[0]))
+	validator1, _ = keeper.GetValidator(ctx, addrVals[1])
+	_, err = keeper.Delegate(ctx, addrDels[0], sdk.NewInt64Coin(params.BondDenom, 10), validator1, true)
+	require.Nil(t, err)
+	requireEvents(hookEvents("delegation-modified", addrDels[0]))
+
+	// unbond part of the delegation, then all of it
+	_, err = keeper.unbond(ctx, addrDels[0], addrVals[1], sdk.NewDec(5))
+	require.Nil(t, err)
+	requireEvents(hookEvents("delegation-modified", addrDels[0]))
+	_, err = keeper.unbond(ctx, addrDels[0], addrVals[1], sdk.NewDec(15))
+	require.Nil(t, err)
+	requireEvents(hookEvents("delegation-removed", addrDels[0]))
+
+	// remove the unbonded validator
+	keeper.RemoveValidator(ctx, addrVals[0])
+	requireEvents(hookEvents("validator-removed", addrVals[0]))
+}
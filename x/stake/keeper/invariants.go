@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/stake/types"
+)
+
+// BondedTokensInvariant checks that the bonded tokens of the pool equal the
+// tokens held by the bonded validators
+func BondedTokensInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		pool := k.GetPool(ctx)
+
+		bonded := sdk.ZeroDec()
+		k.IterateValidatorsBonded(ctx, func(_ int64, validator sdk.Validator) bool {
+			bonded = bonded.Add(validator.GetPower())
+			return false
+		})
+		if !pool.BondedTokens.Equal(bonded) {
+			return fmt.Errorf("pool holds %v bonded tokens, but bonded validators hold %v",
+				pool.BondedTokens, bonded)
+		}
+		return nil
+	}
+}
+
+// ModuleAccountInvariant checks that the stake module account holds the
+// tokens of the validators, the undistributed tokens and the balances of the
+// unbonding delegations
+func ModuleAccountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		held := k.coinKeeper.GetCoins(ctx, auth.NewModuleAddress(types.ModuleName))
+		expected := k.GetHeldTokens(ctx)
+		if !held.IsEqual(expected) {
+			return fmt.Errorf("stake module account holds %v, but should hold %v", held, expected)
+		}
+		return nil
+	}
+}
+
+// TokenizedSharesInvariant checks that the supply of the tokenized shares of
+// each validator equals the shares of its tokenized shares delegation
+func TokenizedSharesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (err error) {
+		k.IterateValidators(ctx, func(_ int64, validator sdk.Validator) bool {
+			shares := sdk.ZeroDec()
+			delegation, found := k.GetDelegation(ctx, k.GetTokenizedSharesAddr(), validator.GetOperator())
+			if found {
+				shares = delegation.Shares
+			}
+			supply := k.coinKeeper.GetSupply(ctx, types.GetSharesDenom(validator.GetOperator()))
+			if !shares.Equal(sdk.NewDecFromInt(supply)) {
+				err = fmt.Errorf("supply of the tokenized shares of %s is %v, but %v shares are tokenized",
+					validator.GetOperator(), supply, shares)
+				return true
+			}
+			return false
+		})
+		return
+	}
+}
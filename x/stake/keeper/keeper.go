@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
 
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/stake/types"
 )
@@ -13,16 +14,20 @@ type Keeper struct {
 	storeKey   sdk.StoreKey
 	cdc        *wire.Codec
 	coinKeeper bank.Keeper
+	hooks      *[]sdk.StakingHooks
 
 	// codespace
 	codespace sdk.CodespaceType
 }
 
 func NewKeeper(cdc *wire.Codec, key sdk.StoreKey, ck bank.Keeper, codespace sdk.CodespaceType) Keeper {
+	ck.RegisterModuleAccount(types.ModuleName, auth.Minter, auth.Burner, auth.Staking)
+	ck.RegisterModuleAccount(types.TokenizedSharesName, auth.Minter, auth.Burner)
 	keeper := Keeper{
 		storeKey:   key,
 		cdc:        cdc,
 		coinKeeper: ck,
+		hooks:      &[]sdk.StakingHooks{},
 		codespace:  codespace,
 	}
 	return keeper
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"encoding/binary"
+	"time"
 
 	"github.com/tendermint/tendermint/crypto"
 
@@ -30,10 +31,19 @@ var (
 	RedelegationKey                  = []byte{0x0D} // key for a redelegation
 	RedelegationByValSrcIndexKey     = []byte{0x0E} // prefix for each key for an redelegation, by source validator owner
 	RedelegationByValDstIndexKey     = []byte{0x0F} // prefix for each key for an redelegation, by destination validator owner
+	UndistributedTokensKey           = []byte{0x10} // key for the tokens of the pool held by no validator or unbonding-delegation
+	SlashEventKey                    = []byte{0x11} // prefix for each key to a slash event, by validator owner
+	HistoricalInfoKey                = []byte{0x12} // prefix for each key to the historical info of a height
+	RotatedPubKeyQueueKey            = []byte{0x13} // prefix for each key to a rotated pubkey, by completion time
+	DelegationByValIndexKey          = []byte{0x14} // prefix for each key for a delegation, by validator owner
+	SupplyRemainderKey               = []byte{0x15} // key for the fraction of the token supply of the pool not minted as coins
 )
 
 const maxDigitsForAccount = 12 // ~220,000,000 atoms created at launch
 
+// format of the times in keys, sorting as the times do
+const sortableTimeFormat = "2006-01-02T15:04:05.000000000"
+
 // gets the key for the validator with address
 // VALUE: stake/types.Validator
 func GetValidatorKey(ownerAddr sdk.AccAddress) []byte {
@@ -114,6 +124,28 @@ func GetDelegationsKey(delegatorAddr sdk.AccAddress) []byte {
 	return append(DelegationKey, delegatorAddr.Bytes()...)
 }
 
+// gets the index-key for a delegation, stored by validator-index
+// VALUE: none (key rearrangement used)
+func GetDelegationByValIndexKey(delegatorAddr, validatorAddr sdk.AccAddress) []byte {
+	return append(GetDelegationsByValIndexKey(validatorAddr), delegatorAddr.Bytes()...)
+}
+
+// rearranges the ValIndexKey to get the DelegationKey
+func GetDelegationKeyFromValIndexKey(IndexKey []byte) []byte {
+	addrs := IndexKey[1:] // remove prefix bytes
+	if len(addrs) != 2*sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	valAddr := addrs[:sdk.AddrLen]
+	delAddr := addrs[sdk.AddrLen:]
+	return GetDelegationKey(delAddr, valAddr)
+}
+
+// gets the prefix keyspace for the indexes of delegations to a validator
+func GetDelegationsByValIndexKey(validatorAddr sdk.AccAddress) []byte {
+	return append(DelegationByValIndexKey, validatorAddr.Bytes()...)
+}
+
 //______________________________________________________________________________
 
 // gets the key for an unbonding delegation by delegator and validator addr
@@ -231,3 +263,50 @@ func GetREDsByDelToValDstIndexKey(delegatorAddr, validatorDstAddr sdk.AccAddress
 		GetREDsToValDstIndexKey(validatorDstAddr),
 		delegatorAddr.Bytes()...)
 }
+
+//______________________________________________________________
+
+// gets the prefix for the slash events of a validator
+func GetSlashEventsKey(ownerAddr sdk.AccAddress) []byte {
+	return append(SlashEventKey, ownerAddr.Bytes()...)
+}
+
+// gets the prefix for the slash events of a validator at a height
+func GetSlashEventsByHeightKey(ownerAddr sdk.AccAddress, height int64) []byte {
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, uint64(height))
+	return append(GetSlashEventsKey(ownerAddr), heightBytes...)
+}
+
+// gets the key for the slash event of a validator at a height, indexed among
+// the slash events of the validator at that height
+// VALUE: stake/types.SlashEvent
+func GetSlashEventKey(ownerAddr sdk.AccAddress, height int64, index int64) []byte {
+	indexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(indexBytes, uint64(index))
+	return append(GetSlashEventsByHeightKey(ownerAddr, height), indexBytes...)
+}
+
+//______________________________________________________________
+
+// gets the key for the historical info of a height
+// VALUE: stake/types.HistoricalInfo
+func GetHistoricalInfoKey(height int64) []byte {
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, uint64(height))
+	return append(HistoricalInfoKey, heightBytes...)
+}
+
+//______________________________________________________________
+
+// gets the prefix for the rotated pubkeys whose index is kept until a time
+func GetRotatedPubKeysByTimeKey(completionTime time.Time) []byte {
+	timeBytes := []byte(completionTime.UTC().Format(sortableTimeFormat))
+	return append(RotatedPubKeyQueueKey, timeBytes...)
+}
+
+// gets the key for a rotated pubkey whose index is kept until a time
+// VALUE: crypto.PubKey
+func GetRotatedPubKeyKey(completionTime time.Time, pubkey crypto.PubKey) []byte {
+	return append(GetRotatedPubKeysByTimeKey(completionTime), pubkey.Address()...)
+}
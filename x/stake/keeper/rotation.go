@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake/types"
+)
+
+// Rotate the consensus pubkey of a validator. If the validator is bonded,
+// Tendermint is updated to remove the old pubkey and add the new one. The
+// index of the old pubkey is kept for the unbonding time, so that evidence
+// against it can still be slashed.
+func (k Keeper) RotateConsPubKey(ctx sdk.Context, validator types.Validator, newPubKey crypto.PubKey) types.Validator {
+	store := ctx.KVStore(k.storeKey)
+	oldPubKey := validator.PubKey
+
+	bonded := validator.Status == sdk.Bonded
+	if bonded {
+		bz := k.cdc.MustMarshalBinary(validator.ABCIValidatorZero())
+		store.Set(append(GetTendermintUpdatesKey(validator.Operator), oldPubKey.Address()...), bz)
+	}
+
+	validator.PubKey = newPubKey
+	k.SetValidator(ctx, validator)
+	k.SetValidatorByPubKeyIndex(ctx, validator)
+	if bonded {
+		bz := k.cdc.MustMarshalBinary(validator.ABCIValidator())
+		store.Set(GetTendermintUpdatesKey(validator.Operator), bz)
+	}
+
+	completionTime := ctx.BlockHeader().Time.Add(k.GetParams(ctx).UnbondingTime)
+	store.Set(GetRotatedPubKeyKey(completionTime, oldPubKey), k.cdc.MustMarshalBinary(oldPubKey))
+
+	k.Hooks().OnValidatorConsPubKeyRotated(ctx, oldPubKey, newPubKey, validator.Operator)
+	return validator
+}
+
+// Remove the index of the pubkeys rotated at least the unbonding time ago
+func (k Keeper) RemoveMatureRotatedPubKeys(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	end := sdk.PrefixEndBytes(GetRotatedPubKeysByTimeKey(ctx.BlockHeader().Time))
+	iterator := store.Iterator(RotatedPubKeyQueueKey, end)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pubkey crypto.PubKey
+		k.cdc.MustUnmarshalBinary(iterator.Value(), &pubkey)
+		store.Delete(GetValidatorByPubKeyIndexKey(pubkey))
+		store.Delete(iterator.Key())
+	}
+}
@@ -74,6 +74,12 @@ func (k Keeper) TotalPower(ctx sdk.Context) sdk.Dec {
 	return pool.BondedTokens
 }
 
+// ratio of the supply of the bond denom which is bonded
+func (k Keeper) BondedRatio(ctx sdk.Context) sdk.Dec {
+	pool := k.GetPool(ctx)
+	return pool.BondedRatio()
+}
+
 //__________________________________________________________________________
 
 // Implements DelegationSet
@@ -20,7 +20,7 @@ import (
 // CONTRACT:
 //    Infraction committed at the current height or at a past height,
 //    not at a height in the future
-func (k Keeper) Slash(ctx sdk.Context, pubkey crypto.PubKey, infractionHeight int64, power int64, slashFactor sdk.Dec) {
+func (k Keeper) Slash(ctx sdk.Context, pubkey crypto.PubKey, infractionHeight int64, power int64, slashFactor sdk.Dec, infraction sdk.Infraction) {
 	logger := ctx.Logger().With("module", "x/stake")
 
 	if slashFactor.LT(sdk.ZeroDec()) {
@@ -45,6 +45,9 @@ func (k Keeper) Slash(ctx sdk.Context, pubkey crypto.PubKey, infractionHeight in
 	}
 	operatorAddress := validator.GetOperator()
 
+	// Track the tokens burned by the slash for the slash event
+	supplyBefore := k.GetPool(ctx).TokenSupply()
+
 	// Track remaining slash amount for the validator
 	// This will decrease when we slash unbondings and
 	// redelegations, as that stake has since unbonded
@@ -97,8 +100,13 @@ func (k Keeper) Slash(ctx sdk.Context, pubkey crypto.PubKey, infractionHeight in
 	validator, pool = validator.RemoveTokens(pool, tokensToBurn)
 	// burn tokens
 	pool.LooseTokens = pool.LooseTokens.Sub(tokensToBurn)
-	// update the pool
-	k.SetPool(ctx, pool)
+	// update the pool and the total supply
+	k.SetPoolAndSupply(ctx, pool)
+	// revoke the validator if its self-delegation is slashed below the minimum
+	if !validator.Revoked && k.SelfDelegationBelowMinimum(ctx, validator) {
+		validator.Revoked = true
+		logger.Info(fmt.Sprintf("Validator %s revoked, self-delegation below the minimum", pubkey.Address()))
+	}
 	// update the validator, possibly kicking it out
 	validator = k.UpdateValidator(ctx, validator)
 	// remove validator if it has been reduced to zero shares
@@ -106,10 +114,15 @@ func (k Keeper) Slash(ctx sdk.Context, pubkey crypto.PubKey, infractionHeight in
 		k.RemoveValidator(ctx, validator.Operator)
 	}
 
+	// Record the slash event
+	tokensBurned := supplyBefore.Sub(k.GetPool(ctx).TokenSupply())
+	k.addSlashEvent(ctx, types.NewSlashEvent(operatorAddress, ctx.BlockHeight(), infractionHeight,
+		slashFactor, infraction, tokensBurned))
+
 	// Log that a slash occurred!
 	logger.Info(fmt.Sprintf(
-		"Validator %s slashed by slashFactor %v, burned %v tokens",
-		pubkey.Address(), slashFactor, tokensToBurn))
+		"Validator %s slashed by slashFactor %v for %s, burned %v tokens",
+		pubkey.Address(), slashFactor, infraction, tokensBurned))
 
 	// TODO Return event(s), blocked on https://github.com/tendermint/tendermint/pull/1803
 	return
@@ -181,8 +194,8 @@ func (k Keeper) slashUnbondingDelegation(ctx sdk.Context, unbondingDelegation ty
 		pool := k.GetPool(ctx)
 		// Burn loose tokens
 		// Ref https://github.com/cosmos/cosmos-sdk/pull/1278#discussion_r198657760
-		pool.LooseTokens = pool.LooseTokens.Sub(slashAmount)
-		k.SetPool(ctx, pool)
+		pool.LooseTokens = pool.LooseTokens.Sub(sdk.NewDecFromInt(unbondingSlashAmount))
+		k.SetPoolAndSupply(ctx, pool)
 	}
 
 	return
@@ -242,8 +255,39 @@ func (k Keeper) slashRedelegation(ctx sdk.Context, validator types.Validator, re
 		// Burn loose tokens
 		pool := k.GetPool(ctx)
 		pool.LooseTokens = pool.LooseTokens.Sub(tokensToBurn)
-		k.SetPool(ctx, pool)
+		k.SetPoolAndSupply(ctx, pool)
 	}
 
 	return slashAmount
 }
+
+//______________________________________________________________________________________________________
+
+// get the slash events of a validator, ordered by height
+func (k Keeper) GetSlashEvents(ctx sdk.Context, ownerAddr sdk.AccAddress) (events []types.SlashEvent) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, GetSlashEventsKey(ownerAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var event types.SlashEvent
+		k.cdc.MustUnmarshalBinary(iterator.Value(), &event)
+		events = append(events, event)
+	}
+	return events
+}
+
+// record a slash event, after the slash events of the validator at the same
+// height
+func (k Keeper) addSlashEvent(ctx sdk.Context, event types.SlashEvent) {
+	store := ctx.KVStore(k.storeKey)
+	index := int64(0)
+	iterator := sdk.KVStorePrefixIterator(store, GetSlashEventsByHeightKey(event.ValidatorAddr, event.Height))
+	for ; iterator.Valid(); iterator.Next() {
+		index++
+	}
+	iterator.Close()
+
+	bz := k.cdc.MustMarshalBinary(event)
+	store.Set(GetSlashEventKey(event.ValidatorAddr, event.Height, index), bz)
+}
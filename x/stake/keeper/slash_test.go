@@ -31,6 +31,10 @@ func setupHelper(t *testing.T, amt int64) (sdk.Context, Keeper, types.Params) {
 	}
 	pool = keeper.GetPool(ctx)
 
+	// the stake module account holds the bonded tokens, which are burned when slashed
+	err := keeper.coinKeeper.MintModuleCoins(ctx, types.ModuleName, sdk.Coins{sdk.NewInt64Coin(params.BondDenom, amt*int64(numVals))})
+	require.Nil(t, err)
+
 	return ctx, keeper, params
 }
 
@@ -173,7 +177,7 @@ func TestSlashAtFutureHeight(t *testing.T) {
 	ctx, keeper, _ := setupHelper(t, 10)
 	pk := PKs[0]
 	fraction := sdk.NewDecWithPrec(5, 1)
-	require.Panics(t, func() { keeper.Slash(ctx, pk, 1, 10, fraction) })
+	require.Panics(t, func() { keeper.Slash(ctx, pk, 1, 10, fraction, sdk.InfractionDoubleSign) })
 }
 
 // tests Slash at the current height
@@ -185,7 +189,7 @@ func TestSlashAtCurrentHeight(t *testing.T) {
 	oldPool := keeper.GetPool(ctx)
 	validator, found := keeper.GetValidatorByPubKey(ctx, pk)
 	require.True(t, found)
-	keeper.Slash(ctx, pk, ctx.BlockHeight(), 10, fraction)
+	keeper.Slash(ctx, pk, ctx.BlockHeight(), 10, fraction, sdk.InfractionDoubleSign)
 
 	// read updated state
 	validator, found = keeper.GetValidatorByPubKey(ctx, pk)
@@ -221,7 +225,7 @@ func TestSlashWithUnbondingDelegation(t *testing.T) {
 	oldPool := keeper.GetPool(ctx)
 	validator, found := keeper.GetValidatorByPubKey(ctx, pk)
 	require.True(t, found)
-	keeper.Slash(ctx, pk, 10, 10, fraction)
+	keeper.Slash(ctx, pk, 10, 10, fraction, sdk.InfractionDoubleSign)
 
 	// read updating unbonding delegation
 	ubd, found = keeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
@@ -243,7 +247,7 @@ func TestSlashWithUnbondingDelegation(t *testing.T) {
 
 	// slash validator again
 	ctx = ctx.WithBlockHeight(13)
-	keeper.Slash(ctx, pk, 9, 10, fraction)
+	keeper.Slash(ctx, pk, 9, 10, fraction, sdk.InfractionDoubleSign)
 	ubd, found = keeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
 	require.True(t, found)
 	// balance decreased again
@@ -263,7 +267,7 @@ func TestSlashWithUnbondingDelegation(t *testing.T) {
 	// on the unbonding delegation, but it will slash stake bonded since the infraction
 	// this may not be the desirable behaviour, ref https://github.com/cosmos/cosmos-sdk/issues/1440
 	ctx = ctx.WithBlockHeight(13)
-	keeper.Slash(ctx, pk, 9, 10, fraction)
+	keeper.Slash(ctx, pk, 9, 10, fraction, sdk.InfractionDoubleSign)
 	ubd, found = keeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
 	require.True(t, found)
 	// balance unchanged
@@ -283,7 +287,7 @@ func TestSlashWithUnbondingDelegation(t *testing.T) {
 	// on the unbonding delegation, but it will slash stake bonded since the infraction
 	// this may not be the desirable behaviour, ref https://github.com/cosmos/cosmos-sdk/issues/1440
 	ctx = ctx.WithBlockHeight(13)
-	keeper.Slash(ctx, pk, 9, 10, fraction)
+	keeper.Slash(ctx, pk, 9, 10, fraction, sdk.InfractionDoubleSign)
 	ubd, found = keeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
 	require.True(t, found)
 	// balance unchanged
@@ -337,7 +341,7 @@ func TestSlashWithRedelegation(t *testing.T) {
 	oldPool := keeper.GetPool(ctx)
 	validator, found := keeper.GetValidatorByPubKey(ctx, pk)
 	require.True(t, found)
-	keeper.Slash(ctx, pk, 10, 10, fraction)
+	keeper.Slash(ctx, pk, 10, 10, fraction, sdk.InfractionDoubleSign)
 
 	// read updating redelegation
 	rd, found = keeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
@@ -361,7 +365,7 @@ func TestSlashWithRedelegation(t *testing.T) {
 	ctx = ctx.WithBlockHeight(12)
 	validator, found = keeper.GetValidatorByPubKey(ctx, pk)
 	require.True(t, found)
-	require.NotPanics(t, func() { keeper.Slash(ctx, pk, 10, 10, sdk.OneDec()) })
+	require.NotPanics(t, func() { keeper.Slash(ctx, pk, 10, 10, sdk.OneDec(), sdk.InfractionDoubleSign) })
 
 	// read updating redelegation
 	rd, found = keeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
@@ -382,7 +386,7 @@ func TestSlashWithRedelegation(t *testing.T) {
 	ctx = ctx.WithBlockHeight(12)
 	validator, found = keeper.GetValidatorByPubKey(ctx, pk)
 	require.True(t, found)
-	keeper.Slash(ctx, pk, 10, 10, sdk.OneDec())
+	keeper.Slash(ctx, pk, 10, 10, sdk.OneDec(), sdk.InfractionDoubleSign)
 
 	// read updating redelegation
 	rd, found = keeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
@@ -404,7 +408,7 @@ func TestSlashWithRedelegation(t *testing.T) {
 	// validator no longer in the store
 	_, found = keeper.GetValidatorByPubKey(ctx, pk)
 	require.False(t, found)
-	keeper.Slash(ctx, pk, 10, 10, sdk.OneDec())
+	keeper.Slash(ctx, pk, 10, 10, sdk.OneDec(), sdk.InfractionDoubleSign)
 
 	// read updating redelegation
 	rd, found = keeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
@@ -466,7 +470,7 @@ func TestSlashBoth(t *testing.T) {
 	oldPool := keeper.GetPool(ctx)
 	validator, found := keeper.GetValidatorByPubKey(ctx, PKs[0])
 	require.True(t, found)
-	keeper.Slash(ctx, PKs[0], 10, 10, fraction)
+	keeper.Slash(ctx, PKs[0], 10, 10, fraction, sdk.InfractionDoubleSign)
 
 	// read updating redelegation
 	rdA, found = keeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
@@ -485,3 +489,24 @@ func TestSlashBoth(t *testing.T) {
 	// power not decreased, all stake was bonded since
 	require.Equal(t, sdk.NewDec(10), validator.GetPower())
 }
+
+// tests the slash events recorded by Slash
+func TestSlashEvents(t *testing.T) {
+	ctx, keeper, _ := setupHelper(t, 10)
+	ctx = ctx.WithBlockHeight(12)
+	fraction := sdk.NewDecWithPrec(5, 1)
+	require.Empty(t, keeper.GetSlashEvents(ctx, addrVals[0]))
+
+	// slash twice at the same height
+	keeper.Slash(ctx, PKs[0], 10, 10, fraction, sdk.InfractionDoubleSign)
+	keeper.Slash(ctx, PKs[0], 12, 10, sdk.NewDecWithPrec(1, 1), sdk.InfractionDowntime)
+
+	events := keeper.GetSlashEvents(ctx, addrVals[0])
+	require.Equal(t, []types.SlashEvent{
+		types.NewSlashEvent(addrVals[0], 12, 10, fraction, sdk.InfractionDoubleSign, sdk.NewDec(5)),
+		types.NewSlashEvent(addrVals[0], 12, 12, sdk.NewDecWithPrec(1, 1), sdk.InfractionDowntime, sdk.NewDec(1)),
+	}, events)
+
+	// slash events are recorded by validator
+	require.Empty(t, keeper.GetSlashEvents(ctx, addrVals[1]))
+}
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake/types"
+)
+
+// The pool tracks the bond denom tokens in fractions, while the stake module
+// account holds whole coins. The tokens held by the stake module (by
+// validators, unbonding-delegations, and undistributed) are accounted so that
+// the module account always holds them rounded.
+
+// get the tokens of the pool which are held by no validator or
+// unbonding-delegation, such as provisions and rounding remainders
+func (k Keeper) GetUndistributedTokens(ctx sdk.Context) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(UndistributedTokensKey)
+	if b == nil {
+		return sdk.ZeroDec()
+	}
+	var tokens sdk.Dec
+	k.cdc.MustUnmarshalBinary(b, &tokens)
+	return tokens
+}
+
+// set the undistributed tokens of the pool
+func (k Keeper) SetUndistributedTokens(ctx sdk.Context, tokens sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinary(tokens)
+	store.Set(UndistributedTokensKey, b)
+}
+
+// add to the undistributed tokens of the pool
+func (k Keeper) AddUndistributedTokens(ctx sdk.Context, tokens sdk.Dec) {
+	if tokens.IsZero() {
+		return
+	}
+	k.SetUndistributedTokens(ctx, k.GetUndistributedTokens(ctx).Add(tokens))
+}
+
+// get the fraction of the token supply of the pool which is not minted as
+// bond denom coins, left over from rounding the minted and burned coins
+func (k Keeper) GetSupplyRemainder(ctx sdk.Context) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(SupplyRemainderKey)
+	if b == nil {
+		return sdk.ZeroDec()
+	}
+	var remainder sdk.Dec
+	k.cdc.MustUnmarshalBinary(b, &remainder)
+	return remainder
+}
+
+// set the fraction of the token supply of the pool not minted as coins
+func (k Keeper) SetSupplyRemainder(ctx sdk.Context, remainder sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinary(remainder)
+	store.Set(SupplyRemainderKey, b)
+}
+
+// set the pool, minting or burning bond denom coins of the stake module
+// account for any change of its token supply (from provisions or burned
+// tokens). The coins are minted and burned whole, rounding the token supply
+// of the pool, and the rounded fraction is kept as the supply remainder.
+func (k Keeper) SetPoolAndSupply(ctx sdk.Context, pool types.Pool) {
+	prevTokens := k.GetPool(ctx).TokenSupply()
+	prevSupply := prevTokens.RoundInt()
+	k.SetPool(ctx, pool)
+
+	tokens := pool.TokenSupply()
+	supply := tokens.RoundInt()
+	bondDenom := k.GetParams(ctx).BondDenom
+	var err sdk.Error
+	switch {
+	case supply.GT(prevSupply):
+		err = k.coinKeeper.MintModuleCoins(ctx, types.ModuleName, sdk.Coins{sdk.NewCoin(bondDenom, supply.Sub(prevSupply))})
+	case supply.LT(prevSupply):
+		err = k.coinKeeper.BurnModuleCoins(ctx, types.ModuleName, sdk.Coins{sdk.NewCoin(bondDenom, prevSupply.Sub(supply))})
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	minted := sdk.NewDecFromInt(supply.Sub(prevSupply))
+	remainder := k.GetSupplyRemainder(ctx).Add(tokens.Sub(prevTokens)).Sub(minted)
+	k.SetSupplyRemainder(ctx, remainder)
+}
+
+// record the bond denom coins minted outside of the stake module, such as
+// inflation provisions, as loose tokens of the pool. The minted coins are
+// held by accounts rather than by the stake module account.
+func (k Keeper) InflateSupply(ctx sdk.Context, minted sdk.Coins) {
+	tokens := minted.AmountOf(k.GetParams(ctx).BondDenom)
+	if tokens.IsZero() {
+		return
+	}
+	pool := k.GetPool(ctx)
+	pool.LooseTokens = pool.LooseTokens.Add(sdk.NewDecFromInt(tokens))
+	k.SetPool(ctx, pool)
+}
+
+// mint the tokens held by the genesis validators into the stake module
+// account. The accounts holding the loose tokens of the pool are loaded
+// separately.
+func (k Keeper) InitSupply(ctx sdk.Context) {
+	validatorTokens := sdk.ZeroDec()
+	for _, validator := range k.GetAllValidators(ctx) {
+		validatorTokens = validatorTokens.Add(validator.Tokens)
+	}
+
+	// keep the fraction of the pool not held by the validators as
+	// undistributed, so that the held tokens round as the pool does
+	rest := k.GetPool(ctx).TokenSupply().Sub(validatorTokens)
+	k.SetUndistributedTokens(ctx, rest.Sub(sdk.NewDecFromInt(rest.RoundInt())))
+
+	// the tokens of the validators and the undistributed tokens are minted
+	// rounded, so keep their fraction as the supply remainder
+	held := validatorTokens.Add(k.GetUndistributedTokens(ctx))
+	k.SetSupplyRemainder(ctx, held.Sub(sdk.NewDecFromInt(held.RoundInt())))
+
+	err := k.coinKeeper.MintModuleCoins(ctx, types.ModuleName, k.GetHeldTokens(ctx))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// get the bond denom coins which the stake module account must hold: the
+// tokens of the validators and the undistributed tokens, rounded, and the
+// balances of the unbonding-delegations
+func (k Keeper) GetHeldTokens(ctx sdk.Context) sdk.Coins {
+	tokens := k.GetUndistributedTokens(ctx)
+	for _, validator := range k.GetAllValidators(ctx) {
+		tokens = tokens.Add(validator.Tokens)
+	}
+
+	held := tokens.RoundInt()
+	k.IterateUnbondingDelegations(ctx, func(_ int64, ubd types.UnbondingDelegation) (stop bool) {
+		held = held.Add(ubd.Balance.Amount)
+		return false
+	})
+	if held.IsZero() {
+		return sdk.Coins{}
+	}
+	return sdk.Coins{sdk.NewCoin(k.GetParams(ctx).BondDenom, held)}
+}
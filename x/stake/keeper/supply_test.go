@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/stake/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// require the total supply of the bond denom to equal the coins held by the
+// accounts, the token supply of the pool to equal it plus the supply
+// remainder, and the stake module account to hold the tokens held by stake
+func requireSupplyHeld(t *testing.T, ctx sdk.Context, am auth.AccountMapper, keeper Keeper) {
+	held := sdk.Coins{}
+	am.IterateAccounts(ctx, func(acc auth.Account) (stop bool) {
+		held = held.Plus(acc.GetCoins())
+		return false
+	})
+	bondDenom := keeper.GetParams(ctx).BondDenom
+	supply := keeper.coinKeeper.GetSupply(ctx, bondDenom)
+	require.True(t, supply.Equal(held.AmountOf(bondDenom)), "supply %v, held %v", supply, held)
+	tokens := sdk.NewDecFromInt(supply).Add(keeper.GetSupplyRemainder(ctx))
+	pool := keeper.GetPool(ctx)
+	require.True(t, pool.TokenSupply().Equal(tokens), "pool %v, supply plus remainder %v", pool.TokenSupply(), tokens)
+	require.Nil(t, ModuleAccountInvariant(keeper)(ctx))
+}
+
+func TestSupplyTracking(t *testing.T) {
+	ctx, am, keeper := CreateTestInput(t, false, 1000)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(0, 0)})
+	params := keeper.GetParams(ctx)
+	requireSupplyHeld(t, ctx, am, keeper)
+
+	// delegate to a new validator
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	validator = keeper.UpdateValidator(ctx, validator)
+	keeper.SetValidatorByPubKeyIndex(ctx, validator)
+	_, err := keeper.Delegate(ctx, addrDels[0], sdk.NewInt64Coin(params.BondDenom, 100), validator, true)
+	require.Nil(t, err)
+	validator, _ = keeper.GetValidator(ctx, addrVals[0])
+	_, err = keeper.Delegate(ctx, addrDels[1], sdk.NewInt64Coin(params.BondDenom, 33), validator, true)
+	require.Nil(t, err)
+	requireSupplyHeld(t, ctx, am, keeper)
+
+	// slash a third of the tokens, leaving fractional tokens
+	keeper.Slash(ctx, PKs[0], 0, 133, sdk.NewDecWithPrec(333, 3), sdk.InfractionDoubleSign)
+	validator, _ = keeper.GetValidator(ctx, addrVals[0])
+	require.False(t, validator.Tokens.Equal(sdk.NewDecFromInt(validator.Tokens.RoundInt())))
+	require.False(t, keeper.GetSupplyRemainder(ctx).IsZero())
+	requireSupplyHeld(t, ctx, am, keeper)
+
+	// unbond a fractional amount, rounding the unbonding balance
+	err = keeper.BeginUnbonding(ctx, addrDels[1], addrVals[0], sdk.NewDec(10))
+	require.Nil(t, err)
+	requireSupplyHeld(t, ctx, am, keeper)
+
+	// slash the unbonding delegation as well
+	ctx = ctx.WithBlockHeight(1)
+	keeper.Slash(ctx, PKs[0], 0, 120, sdk.NewDecWithPrec(5, 1), sdk.InfractionDoubleSign)
+	requireSupplyHeld(t, ctx, am, keeper)
+
+	// complete the unbonding
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(0, 0).Add(params.UnbondingTime)})
+	err = keeper.CompleteUnbonding(ctx, addrDels[1], addrVals[0])
+	require.Nil(t, err)
+	requireSupplyHeld(t, ctx, am, keeper)
+
+	// unbond every remaining share, removing the validator
+	for _, delAddr := range addrDels {
+		delegation, found := keeper.GetDelegation(ctx, delAddr, addrVals[0])
+		require.True(t, found)
+		err = keeper.BeginUnbonding(ctx, delAddr, addrVals[0], delegation.Shares)
+		require.Nil(t, err)
+	}
+	_, found := keeper.GetValidator(ctx, addrVals[0])
+	require.False(t, found)
+	requireSupplyHeld(t, ctx, am, keeper)
+}
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/stake/types"
+)
+
+// Tokenized shares are coins of a denomination per validator, one coin per
+// delegator share, minted through bank. The tokenized shares are held as a
+// delegation of the tokenized shares module address, so that slashing the
+// validator decreases the tokens which each coin is redeemed for.
+
+// get the address holding the delegations of the tokenized shares
+func (k Keeper) GetTokenizedSharesAddr() sdk.AccAddress {
+	return auth.NewModuleAddress(types.TokenizedSharesName)
+}
+
+// tokenize shares of a delegation, moving them to the tokenized shares
+// delegation and minting as many coins of the shares denomination to the
+// delegator
+func (k Keeper) TokenizeShares(ctx sdk.Context, delegatorAddr, validatorAddr sdk.AccAddress,
+	shares sdk.Dec) (sdk.Coin, sdk.Error) {
+
+	validator, found := k.GetValidator(ctx, validatorAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound(k.Codespace())
+	}
+
+	// shares redelegated to the validator must stay with the delegator, so
+	// that they can be slashed for the source validator until the
+	// redelegation completes
+	if k.HasReceivingRedelegation(ctx, delegatorAddr, validatorAddr) {
+		return sdk.Coin{}, types.ErrTokenizeRedelegatedShares(k.Codespace())
+	}
+
+	err := k.transferShares(ctx, delegatorAddr, k.GetTokenizedSharesAddr(), validator, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	tokens := sdk.Coins{sdk.NewCoin(types.GetSharesDenom(validatorAddr), shares.RoundInt())}
+	err = k.coinKeeper.MintModuleCoins(ctx, types.TokenizedSharesName, tokens)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	_, err = k.coinKeeper.SendCoinsFromModuleToAccount(ctx, types.TokenizedSharesName, delegatorAddr, tokens)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return tokens[0], nil
+}
+
+// redeem tokenized shares, burning the coins and moving as many shares from
+// the tokenized shares delegation to the delegation of the delegator
+func (k Keeper) RedeemTokens(ctx sdk.Context, delegatorAddr sdk.AccAddress, amount sdk.Coin) sdk.Error {
+	validatorAddr, parseErr := types.ParseSharesDenom(amount.Denom)
+	if parseErr != nil {
+		return types.ErrBadDenom(k.Codespace())
+	}
+	validator, found := k.GetValidator(ctx, validatorAddr)
+	if !found {
+		return types.ErrNoValidatorFound(k.Codespace())
+	}
+
+	tokens := sdk.Coins{amount}
+	_, err := k.coinKeeper.SendCoinsFromAccountToModule(ctx, delegatorAddr, types.TokenizedSharesName, tokens)
+	if err != nil {
+		return err
+	}
+	err = k.coinKeeper.BurnModuleCoins(ctx, types.TokenizedSharesName, tokens)
+	if err != nil {
+		return err
+	}
+	return k.transferShares(ctx, k.GetTokenizedSharesAddr(), delegatorAddr, validator, sdk.NewDecFromInt(amount.Amount))
+}
+
+// move delegator shares of a validator from one delegation to another,
+// leaving the tokens of the validator unchanged
+func (k Keeper) transferShares(ctx sdk.Context, srcAddr, dstAddr sdk.AccAddress,
+	validator types.Validator, shares sdk.Dec) sdk.Error {
+
+	src, found := k.GetDelegation(ctx, srcAddr, validator.Operator)
+	if !found {
+		return types.ErrNoDelegatorForAddress(k.Codespace())
+	}
+	if src.Shares.LT(shares) {
+		return types.ErrNotEnoughDelegationShares(k.Codespace(), src.Shares.String())
+	}
+
+	src.Shares = src.Shares.Sub(shares)
+	if src.Shares.IsZero() {
+		k.RemoveDelegation(ctx, src)
+		k.Hooks().OnDelegationRemoved(ctx, src.DelegatorAddr, src.ValidatorAddr)
+	} else {
+		src.Height = ctx.BlockHeight()
+		k.SetDelegation(ctx, src)
+		k.Hooks().OnDelegationSharesModified(ctx, src.DelegatorAddr, src.ValidatorAddr)
+	}
+
+	dst, found := k.GetDelegation(ctx, dstAddr, validator.Operator)
+	if !found {
+		dst = types.Delegation{
+			DelegatorAddr: dstAddr,
+			ValidatorAddr: validator.Operator,
+			Shares:        sdk.ZeroDec(),
+		}
+	}
+	dst.Shares = dst.Shares.Add(shares)
+	dst.Height = ctx.BlockHeight()
+	k.SetDelegation(ctx, dst)
+	if found {
+		k.Hooks().OnDelegationSharesModified(ctx, dst.DelegatorAddr, dst.ValidatorAddr)
+	} else {
+		k.Hooks().OnDelegationCreated(ctx, dst.DelegatorAddr, dst.ValidatorAddr)
+	}
+
+	// revoke the validator if its operator tokenized its self-delegation
+	// below the minimum, as when unbonding
+	if bytes.Equal(srcAddr, validator.Operator) && !validator.Revoked &&
+		(src.Shares.IsZero() || k.SelfDelegationBelowMinimum(ctx, validator)) {
+		validator.Revoked = true
+		k.UpdateValidator(ctx, validator)
+	}
+	return nil
+}
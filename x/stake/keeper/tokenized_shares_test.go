@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake/types"
+)
+
+func TestTokenizeAndRedeemShares(t *testing.T) {
+	ctx, am, keeper := CreateTestInput(t, false, 1000)
+	params := keeper.GetParams(ctx)
+	sharesDenom := types.GetSharesDenom(addrVals[0])
+
+	// delegate to a new validator
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	validator = keeper.UpdateValidator(ctx, validator)
+	keeper.SetValidatorByPubKeyIndex(ctx, validator)
+	_, err := keeper.Delegate(ctx, addrDels[0], sdk.NewInt64Coin(params.BondDenom, 100), validator, true)
+	require.Nil(t, err)
+
+	// cannot tokenize more shares than delegated
+	_, err = keeper.TokenizeShares(ctx, addrDels[0], addrVals[0], sdk.NewDec(101))
+	require.NotNil(t, err)
+
+	// tokenize shares, minting as many coins
+	tokens, err := keeper.TokenizeShares(ctx, addrDels[0], addrVals[0], sdk.NewDec(40))
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt64Coin(sharesDenom, 40), tokens)
+	require.Equal(t, int64(40), keeper.coinKeeper.GetCoins(ctx, addrDels[0]).AmountOf(sharesDenom).Int64())
+	delegation, found := keeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(60), delegation.Shares)
+	delegation, found = keeper.GetDelegation(ctx, keeper.GetTokenizedSharesAddr(), addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(40), delegation.Shares)
+	require.Nil(t, TokenizedSharesInvariant(keeper)(ctx))
+	requireSupplyHeld(t, ctx, am, keeper)
+
+	// transfer the tokens, and slash the validator in half
+	_, err = keeper.coinKeeper.SendCoins(ctx, addrDels[0], addrDels[1], sdk.Coins{tokens})
+	require.Nil(t, err)
+	keeper.Slash(ctx, PKs[0], 0, 100, sdk.NewDecWithPrec(5, 1), sdk.InfractionDoubleSign)
+
+	// cannot redeem more tokens than held
+	err = keeper.RedeemTokens(ctx, addrDels[1], sdk.NewInt64Coin(sharesDenom, 41))
+	require.NotNil(t, err)
+
+	// redeem the tokens for the slashed delegation
+	err = keeper.RedeemTokens(ctx, addrDels[1], tokens)
+	require.Nil(t, err)
+	require.True(t, keeper.coinKeeper.GetCoins(ctx, addrDels[1]).AmountOf(sharesDenom).IsZero())
+	require.True(t, keeper.coinKeeper.GetSupply(ctx, sharesDenom).IsZero())
+	_, found = keeper.GetDelegation(ctx, keeper.GetTokenizedSharesAddr(), addrVals[0])
+	require.False(t, found)
+	delegation, found = keeper.GetDelegation(ctx, addrDels[1], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(40), delegation.Shares)
+	validator, _ = keeper.GetValidator(ctx, addrVals[0])
+	require.Equal(t, sdk.NewDec(20), delegation.Shares.Mul(validator.DelegatorShareExRate()))
+	require.Nil(t, TokenizedSharesInvariant(keeper)(ctx))
+	requireSupplyHeld(t, ctx, am, keeper)
+}
+
+func TestTokenizeRedelegatedShares(t *testing.T) {
+	ctx, _, keeper := CreateTestInput(t, false, 1000)
+	params := keeper.GetParams(ctx)
+
+	// delegate to a validator and redelegate to another one
+	for i := 0; i < 2; i++ {
+		validator := types.NewValidator(addrVals[i], PKs[i], types.Description{})
+		validator = keeper.UpdateValidator(ctx, validator)
+		keeper.SetValidatorByPubKeyIndex(ctx, validator)
+		_, err := keeper.Delegate(ctx, addrDels[i], sdk.NewInt64Coin(params.BondDenom, 100), validator, true)
+		require.Nil(t, err)
+	}
+	err := keeper.BeginRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1], sdk.NewDec(100))
+	require.Nil(t, err)
+
+	// the redelegated shares can't be tokenized until the redelegation
+	// completes, as they may still be slashed for the source validator
+	_, err = keeper.TokenizeShares(ctx, addrDels[0], addrVals[1], sdk.NewDec(10))
+	require.Equal(t, types.ErrTokenizeRedelegatedShares(keeper.Codespace()).Code(), err.Code())
+
+	ctx = ctx.WithBlockHeader(abci.Header{Time: ctx.BlockHeader().Time.Add(params.UnbondingTime)})
+	err = keeper.CompleteRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
+	require.Nil(t, err)
+
+	_, err = keeper.TokenizeShares(ctx, addrDels[0], addrVals[1], sdk.NewDec(10))
+	require.Nil(t, err)
+}
@@ -576,6 +576,8 @@ func (k Keeper) unbondValidator(ctx sdk.Context, validator types.Validator) type
 
 	// also remove from the Bonded types.Validators Store
 	store.Delete(GetValidatorsBondedIndexKey(validator.Operator))
+
+	k.Hooks().OnValidatorBeginUnbonding(ctx, validator.PubKey, validator.Operator)
 	return validator
 }
 
@@ -602,6 +604,7 @@ func (k Keeper) bondValidator(ctx sdk.Context, validator types.Validator) types.
 	bzABCI := k.cdc.MustMarshalBinary(validator.ABCIValidator())
 	store.Set(GetTendermintUpdatesKey(validator.Operator), bzABCI)
 
+	k.Hooks().OnValidatorBonded(ctx, validator.PubKey, validator.Operator)
 	return validator
 }
 
@@ -614,6 +617,9 @@ func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.AccAddress) {
 		return
 	}
 
+	// any tokens left with the validator remain in the pool
+	k.AddUndistributedTokens(ctx, validator.Tokens)
+
 	// delete the old validator record
 	store := ctx.KVStore(k.storeKey)
 	pool := k.GetPool(ctx)
@@ -623,13 +629,14 @@ func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.AccAddress) {
 
 	// delete from the current and power weighted validator groups if the validator
 	// is bonded - and add validator with zero power to the validator updates
-	if store.Get(GetValidatorsBondedIndexKey(validator.Operator)) == nil {
-		return
+	if store.Get(GetValidatorsBondedIndexKey(validator.Operator)) != nil {
+		store.Delete(GetValidatorsBondedIndexKey(validator.Operator))
+
+		bz := k.cdc.MustMarshalBinary(validator.ABCIValidatorZero())
+		store.Set(GetTendermintUpdatesKey(address), bz)
 	}
-	store.Delete(GetValidatorsBondedIndexKey(validator.Operator))
 
-	bz := k.cdc.MustMarshalBinary(validator.ABCIValidatorZero())
-	store.Set(GetTendermintUpdatesKey(address), bz)
+	k.Hooks().OnValidatorRemoved(ctx, validator.PubKey, validator.Operator)
 }
 
 //__________________________________________________________________________
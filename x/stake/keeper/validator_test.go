@@ -159,9 +159,11 @@ func TestSlashToZeroPowerRemoved(t *testing.T) {
 	keeper.SetValidatorByPubKeyIndex(ctx, validator)
 	validator = keeper.UpdateValidator(ctx, validator)
 	require.Equal(t, int64(100), validator.Tokens.RoundInt64(), "\nvalidator %v\npool %v", validator, pool)
+	err := keeper.coinKeeper.MintModuleCoins(ctx, types.ModuleName, sdk.Coins{sdk.NewInt64Coin(keeper.GetParams(ctx).BondDenom, 100)})
+	require.Nil(t, err)
 
 	// slash the validator by 100%
-	keeper.Slash(ctx, PKs[0], 0, 100, sdk.OneDec())
+	keeper.Slash(ctx, PKs[0], 0, 100, sdk.OneDec(), sdk.InfractionDoubleSign)
 	// validator should have been deleted
 	_, found := keeper.GetValidator(ctx, addrVals[0])
 	require.False(t, found)
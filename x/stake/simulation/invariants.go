@@ -15,10 +15,11 @@ import (
 )
 
 // AllInvariants runs all invariants of the stake module.
-// Currently: total supply, positive power
+// Currently: total supply, tokenized shares supply, positive power
 func AllInvariants(ck bank.Keeper, k stake.Keeper, am auth.AccountMapper) simulation.Invariant {
 	return func(t *testing.T, app *baseapp.BaseApp, log string) {
 		SupplyInvariants(ck, k, am)(t, app, log)
+		TokenizedSharesInvariant(k)(t, app, log)
 		PositivePowerInvariant(k)(t, app, log)
 		ValidatorSetInvariant(k)(t, app, log)
 	}
@@ -30,30 +31,26 @@ func SupplyInvariants(ck bank.Keeper, k stake.Keeper, am auth.AccountMapper) sim
 		ctx := app.NewContext(false, abci.Header{})
 		pool := k.GetPool(ctx)
 
-		loose := sdk.ZeroInt()
+		held := sdk.ZeroInt()
 		bonded := sdk.ZeroDec()
 		am.IterateAccounts(ctx, func(acc auth.Account) bool {
-			loose = loose.Add(acc.GetCoins().AmountOf("steak"))
-			return false
-		})
-		k.IterateUnbondingDelegations(ctx, func(_ int64, ubd stake.UnbondingDelegation) bool {
-			loose = loose.Add(ubd.Balance.Amount)
+			held = held.Add(acc.GetCoins().AmountOf("steak"))
 			return false
 		})
 		k.IterateValidators(ctx, func(_ int64, validator sdk.Validator) bool {
-			switch validator.GetStatus() {
-			case sdk.Bonded:
+			if validator.GetStatus() == sdk.Bonded {
 				bonded = bonded.Add(validator.GetPower())
-			case sdk.Unbonding:
-			case sdk.Unbonded:
-				loose = loose.Add(validator.GetTokens().RoundInt())
 			}
 			return false
 		})
 
-		// Loose tokens should equal coin supply plus unbonding delegations plus tokens on unbonded validators
-		require.True(t, pool.LooseTokens.RoundInt64() == loose.Int64(), "expected loose tokens to equal total steak held by accounts - pool.LooseTokens: %v, sum of account tokens: %v\nlog: %s",
-			pool.LooseTokens.RoundInt64(), loose.Int64(), log)
+		// The stake module account holds the bonded tokens next to the loose tokens of
+		// unbonding delegations and non-bonded validators, so the loose tokens are all
+		// the steak held by accounts, plus the fraction of the pool not minted as coins,
+		// minus the bonded tokens.
+		loose := sdk.NewDecFromInt(held).Add(k.GetSupplyRemainder(ctx)).Sub(pool.BondedTokens)
+		require.True(t, pool.LooseTokens.Equal(loose), "expected loose tokens to equal total steak held by accounts plus the supply remainder minus bonded tokens - pool.LooseTokens: %v, sum of account tokens plus remainder minus bonded tokens: %v\nlog: %s",
+			pool.LooseTokens, loose, log)
 
 		// Bonded tokens should equal sum of tokens with bonded validators
 		require.True(t, pool.BondedTokens.Equal(bonded), "expected bonded tokens to equal total steak held by bonded validators\nlog: %s", log)
@@ -62,6 +59,16 @@ func SupplyInvariants(ck bank.Keeper, k stake.Keeper, am auth.AccountMapper) sim
 	}
 }
 
+// TokenizedSharesInvariant checks that the supply of the tokenized shares of
+// each validator equals the shares of its tokenized shares delegation
+func TokenizedSharesInvariant(k stake.Keeper) simulation.Invariant {
+	return func(t *testing.T, app *baseapp.BaseApp, log string) {
+		ctx := app.NewContext(false, abci.Header{})
+		err := stake.TokenizedSharesInvariant(k)(ctx)
+		require.Nil(t, err, "%v\nlog: %s", err, log)
+	}
+}
+
 // PositivePowerInvariant checks that all stored validators have > 0 power
 func PositivePowerInvariant(k stake.Keeper) simulation.Invariant {
 	return func(t *testing.T, app *baseapp.BaseApp, log string) {
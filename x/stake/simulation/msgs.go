@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/mock"
 	"github.com/cosmos/cosmos-sdk/x/mock/simulation"
 	"github.com/cosmos/cosmos-sdk/x/stake"
@@ -34,12 +35,14 @@ func SimulateMsgCreateValidator(m auth.AccountMapper, k stake.Keeper) simulation
 		if amount.Equal(sdk.ZeroInt()) {
 			return "no-operation", nil
 		}
+		minSelfDelegation := simulation.RandomAmount(r, amount)
 		msg := stake.MsgCreateValidator{
-			Description:   description,
-			ValidatorAddr: address,
-			DelegatorAddr: address,
-			PubKey:        pubkey,
-			Delegation:    sdk.NewCoin(denom, amount),
+			Description:       description,
+			ValidatorAddr:     address,
+			DelegatorAddr:     address,
+			PubKey:            pubkey,
+			Delegation:        sdk.NewCoin(denom, amount),
+			MinSelfDelegation: minSelfDelegation,
 		}
 		require.Nil(t, msg.ValidateBasic(), "expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
 		ctx, write := ctx.CacheContext()
@@ -146,6 +149,70 @@ func SimulateMsgBeginUnbonding(m auth.AccountMapper, k stake.Keeper) simulation.
 	}
 }
 
+// SimulateMsgTokenizeShares
+func SimulateMsgTokenizeShares(m auth.AccountMapper, k stake.Keeper) simulation.TestAndRunTx {
+	return func(t *testing.T, r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, keys []crypto.PrivKey, log string, event func(string)) (action string, err sdk.Error) {
+		denom := k.GetParams(ctx).BondDenom
+		validatorKey := simulation.RandomKey(r, keys)
+		validatorAddress := sdk.AccAddress(validatorKey.PubKey().Address())
+		delegatorKey := simulation.RandomKey(r, keys)
+		delegatorAddress := sdk.AccAddress(delegatorKey.PubKey().Address())
+		amount := m.GetAccount(ctx, delegatorAddress).GetCoins().AmountOf(denom)
+		if amount.GT(sdk.ZeroInt()) {
+			amount = simulation.RandomAmount(r, amount)
+		}
+		if amount.Equal(sdk.ZeroInt()) {
+			return "no-operation", nil
+		}
+		msg := stake.MsgTokenizeShares{
+			DelegatorAddr: delegatorAddress,
+			ValidatorAddr: validatorAddress,
+			SharesAmount:  sdk.NewDecFromInt(amount),
+		}
+		require.Nil(t, msg.ValidateBasic(), "expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		ctx, write := ctx.CacheContext()
+		result := stake.NewHandler(k)(ctx, msg)
+		if result.IsOK() {
+			write()
+		}
+		event(fmt.Sprintf("stake/MsgTokenizeShares/%v", result.IsOK()))
+		action = fmt.Sprintf("TestMsgTokenizeShares: ok %v, msg %s", result.IsOK(), msg.GetSignBytes())
+		return action, nil
+	}
+}
+
+// SimulateMsgRedeemTokens
+func SimulateMsgRedeemTokens(m auth.AccountMapper, k stake.Keeper) simulation.TestAndRunTx {
+	return func(t *testing.T, r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, keys []crypto.PrivKey, log string, event func(string)) (action string, err sdk.Error) {
+		delegatorKey := simulation.RandomKey(r, keys)
+		delegatorAddress := sdk.AccAddress(delegatorKey.PubKey().Address())
+		var tokens sdk.Coins
+		for _, coin := range m.GetAccount(ctx, delegatorAddress).GetCoins() {
+			if _, err := stake.ParseSharesDenom(coin.Denom); err == nil && coin.IsPositive() {
+				tokens = append(tokens, coin)
+			}
+		}
+		if len(tokens) == 0 {
+			return "no-operation", nil
+		}
+		token := tokens[r.Intn(len(tokens))]
+		token.Amount = simulation.RandomAmount(r, token.Amount).AddRaw(1)
+		msg := stake.MsgRedeemTokens{
+			DelegatorAddr: delegatorAddress,
+			Amount:        token,
+		}
+		require.Nil(t, msg.ValidateBasic(), "expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		ctx, write := ctx.CacheContext()
+		result := stake.NewHandler(k)(ctx, msg)
+		if result.IsOK() {
+			write()
+		}
+		event(fmt.Sprintf("stake/MsgRedeemTokens/%v", result.IsOK()))
+		action = fmt.Sprintf("TestMsgRedeemTokens: ok %v, msg %s", result.IsOK(), msg.GetSignBytes())
+		return action, nil
+	}
+}
+
 // SimulateMsgCompleteUnbonding
 func SimulateMsgCompleteUnbonding(k stake.Keeper) simulation.TestAndRunTx {
 	return func(t *testing.T, r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, keys []crypto.PrivKey, log string, event func(string)) (action string, err sdk.Error) {
@@ -232,7 +299,7 @@ func SimulateMsgCompleteRedelegate(k stake.Keeper) simulation.TestAndRunTx {
 }
 
 // Setup
-func Setup(mapp *mock.App, k stake.Keeper) simulation.RandSetup {
+func Setup(mapp *mock.App, ck bank.Keeper, k stake.Keeper) simulation.RandSetup {
 	return func(r *rand.Rand, privKeys []crypto.PrivKey) {
 		ctx := mapp.NewContext(false, abci.Header{})
 		stake.InitGenesis(ctx, k, stake.DefaultGenesisState())
@@ -246,8 +313,11 @@ func Setup(mapp *mock.App, k stake.Keeper) simulation.RandSetup {
 			loose = loose.Add(balance)
 			return false
 		})
+		// the loose tokens are held by the accounts rather than the stake module
+		// account, so only record them in the total supply
 		pool := k.GetPool(ctx)
 		pool.LooseTokens = pool.LooseTokens.Add(sdk.NewDec(loose.Int64()))
 		k.SetPool(ctx, pool)
+		ck.IncreaseSupply(ctx, sdk.Coins{sdk.NewCoin(denom, loose)})
 	}
 }
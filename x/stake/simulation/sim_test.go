@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/mock"
 	"github.com/cosmos/cosmos-sdk/x/mock/simulation"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/stake"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
@@ -19,7 +20,10 @@ func TestStakeWithRandomMessages(t *testing.T) {
 
 	bank.RegisterWire(mapp.Cdc)
 	mapper := mapp.AccountMapper
-	coinKeeper := bank.NewKeeper(mapper)
+	bankKey := sdk.NewKVStoreKey("bank")
+	paramsKey := sdk.NewKVStoreKey("params")
+	paramsKeeper := params.NewKeeper(mapp.Cdc, paramsKey)
+	coinKeeper := bank.NewKeeper(mapp.Cdc, bankKey, mapper, paramsKeeper.Setter(), mapp.RegisterCodespace(bank.DefaultCodespace))
 	stakeKey := sdk.NewKVStoreKey("stake")
 	stakeKeeper := stake.NewKeeper(mapp.Cdc, stakeKey, coinKeeper, stake.DefaultCodespace)
 	mapp.Router().AddRoute("stake", stake.NewHandler(stakeKeeper))
@@ -30,7 +34,7 @@ func TestStakeWithRandomMessages(t *testing.T) {
 		}
 	})
 
-	err := mapp.CompleteSetup([]*sdk.KVStoreKey{stakeKey})
+	err := mapp.CompleteSetup([]*sdk.KVStoreKey{stakeKey, bankKey, paramsKey})
 	if err != nil {
 		panic(err)
 	}
@@ -50,8 +54,10 @@ func TestStakeWithRandomMessages(t *testing.T) {
 			SimulateMsgCompleteUnbonding(stakeKeeper),
 			SimulateMsgBeginRedelegate(mapper, stakeKeeper),
 			SimulateMsgCompleteRedelegate(stakeKeeper),
+			SimulateMsgTokenizeShares(mapper, stakeKeeper),
+			SimulateMsgRedeemTokens(mapper, stakeKeeper),
 		}, []simulation.RandSetup{
-			Setup(mapp, stakeKeeper),
+			Setup(mapp, coinKeeper, stakeKeeper),
 		}, []simulation.Invariant{
 			AllInvariants(coinKeeper, stakeKeeper, mapp.AccountMapper),
 		}, 10, 100, 100,
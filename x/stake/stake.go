@@ -15,70 +15,97 @@ type (
 	Delegation            = types.Delegation
 	UnbondingDelegation   = types.UnbondingDelegation
 	Redelegation          = types.Redelegation
+	SlashEvent            = types.SlashEvent
+	HistoricalInfo        = types.HistoricalInfo
 	Params                = types.Params
 	Pool                  = types.Pool
 	MsgCreateValidator    = types.MsgCreateValidator
 	MsgEditValidator      = types.MsgEditValidator
+	MsgRotateConsPubKey   = types.MsgRotateConsPubKey
 	MsgDelegate           = types.MsgDelegate
 	MsgBeginUnbonding     = types.MsgBeginUnbonding
 	MsgCompleteUnbonding  = types.MsgCompleteUnbonding
 	MsgBeginRedelegate    = types.MsgBeginRedelegate
 	MsgCompleteRedelegate = types.MsgCompleteRedelegate
+	MsgTokenizeShares     = types.MsgTokenizeShares
+	MsgRedeemTokens       = types.MsgRedeemTokens
 	GenesisState          = types.GenesisState
 )
 
 var (
 	NewKeeper = keeper.NewKeeper
 
-	GetValidatorKey              = keeper.GetValidatorKey
-	GetValidatorByPubKeyIndexKey = keeper.GetValidatorByPubKeyIndexKey
-	GetValidatorsBondedIndexKey  = keeper.GetValidatorsBondedIndexKey
-	GetValidatorsByPowerIndexKey = keeper.GetValidatorsByPowerIndexKey
-	GetTendermintUpdatesKey      = keeper.GetTendermintUpdatesKey
-	GetDelegationKey             = keeper.GetDelegationKey
-	GetDelegationsKey            = keeper.GetDelegationsKey
-	ParamKey                     = keeper.ParamKey
-	PoolKey                      = keeper.PoolKey
-	ValidatorsKey                = keeper.ValidatorsKey
-	ValidatorsByPubKeyIndexKey   = keeper.ValidatorsByPubKeyIndexKey
-	ValidatorsBondedIndexKey     = keeper.ValidatorsBondedIndexKey
-	ValidatorsByPowerIndexKey    = keeper.ValidatorsByPowerIndexKey
-	ValidatorCliffIndexKey       = keeper.ValidatorCliffIndexKey
-	ValidatorPowerCliffKey       = keeper.ValidatorPowerCliffKey
-	TendermintUpdatesKey         = keeper.TendermintUpdatesKey
-	DelegationKey                = keeper.DelegationKey
-	IntraTxCounterKey            = keeper.IntraTxCounterKey
-	GetUBDKey                    = keeper.GetUBDKey
-	GetUBDByValIndexKey          = keeper.GetUBDByValIndexKey
-	GetUBDsKey                   = keeper.GetUBDsKey
-	GetUBDsByValIndexKey         = keeper.GetUBDsByValIndexKey
-	GetREDKey                    = keeper.GetREDKey
-	GetREDByValSrcIndexKey       = keeper.GetREDByValSrcIndexKey
-	GetREDByValDstIndexKey       = keeper.GetREDByValDstIndexKey
-	GetREDsKey                   = keeper.GetREDsKey
-	GetREDsFromValSrcIndexKey    = keeper.GetREDsFromValSrcIndexKey
-	GetREDsToValDstIndexKey      = keeper.GetREDsToValDstIndexKey
-	GetREDsByDelToValDstIndexKey = keeper.GetREDsByDelToValDstIndexKey
+	BondedTokensInvariant    = keeper.BondedTokensInvariant
+	ModuleAccountInvariant   = keeper.ModuleAccountInvariant
+	TokenizedSharesInvariant = keeper.TokenizedSharesInvariant
+
+	GetValidatorKey                 = keeper.GetValidatorKey
+	GetValidatorByPubKeyIndexKey    = keeper.GetValidatorByPubKeyIndexKey
+	GetValidatorsBondedIndexKey     = keeper.GetValidatorsBondedIndexKey
+	GetValidatorsByPowerIndexKey    = keeper.GetValidatorsByPowerIndexKey
+	GetTendermintUpdatesKey         = keeper.GetTendermintUpdatesKey
+	GetDelegationKey                = keeper.GetDelegationKey
+	GetDelegationsKey               = keeper.GetDelegationsKey
+	GetDelegationByValIndexKey      = keeper.GetDelegationByValIndexKey
+	GetDelegationsByValIndexKey     = keeper.GetDelegationsByValIndexKey
+	GetDelegationKeyFromValIndexKey = keeper.GetDelegationKeyFromValIndexKey
+	ParamKey                        = keeper.ParamKey
+	PoolKey                         = keeper.PoolKey
+	ValidatorsKey                   = keeper.ValidatorsKey
+	ValidatorsByPubKeyIndexKey      = keeper.ValidatorsByPubKeyIndexKey
+	ValidatorsBondedIndexKey        = keeper.ValidatorsBondedIndexKey
+	ValidatorsByPowerIndexKey       = keeper.ValidatorsByPowerIndexKey
+	ValidatorCliffIndexKey          = keeper.ValidatorCliffIndexKey
+	ValidatorPowerCliffKey          = keeper.ValidatorPowerCliffKey
+	TendermintUpdatesKey            = keeper.TendermintUpdatesKey
+	DelegationKey                   = keeper.DelegationKey
+	IntraTxCounterKey               = keeper.IntraTxCounterKey
+	GetUBDKey                       = keeper.GetUBDKey
+	GetUBDByValIndexKey             = keeper.GetUBDByValIndexKey
+	GetUBDsKey                      = keeper.GetUBDsKey
+	GetUBDsByValIndexKey            = keeper.GetUBDsByValIndexKey
+	GetUBDKeyFromValIndexKey        = keeper.GetUBDKeyFromValIndexKey
+	GetREDKey                       = keeper.GetREDKey
+	GetREDByValSrcIndexKey          = keeper.GetREDByValSrcIndexKey
+	GetREDByValDstIndexKey          = keeper.GetREDByValDstIndexKey
+	GetREDsKey                      = keeper.GetREDsKey
+	GetREDsFromValSrcIndexKey       = keeper.GetREDsFromValSrcIndexKey
+	GetREDsToValDstIndexKey         = keeper.GetREDsToValDstIndexKey
+	GetREDsByDelToValDstIndexKey    = keeper.GetREDsByDelToValDstIndexKey
+	GetREDKeyFromValSrcIndexKey     = keeper.GetREDKeyFromValSrcIndexKey
+	GetREDKeyFromValDstIndexKey     = keeper.GetREDKeyFromValDstIndexKey
+	GetSlashEventsKey               = keeper.GetSlashEventsKey
+	GetHistoricalInfoKey            = keeper.GetHistoricalInfoKey
 
 	DefaultParams       = types.DefaultParams
 	InitialPool         = types.InitialPool
 	NewValidator        = types.NewValidator
 	NewDescription      = types.NewDescription
+	NewSlashEvent       = types.NewSlashEvent
+	NewHistoricalInfo   = types.NewHistoricalInfo
 	NewGenesisState     = types.NewGenesisState
 	DefaultGenesisState = types.DefaultGenesisState
 	RegisterWire        = types.RegisterWire
+	GetSharesDenom      = types.GetSharesDenom
+	ParseSharesDenom    = types.ParseSharesDenom
 
 	NewMsgCreateValidator           = types.NewMsgCreateValidator
 	NewMsgCreateValidatorOnBehalfOf = types.NewMsgCreateValidatorOnBehalfOf
 	NewMsgEditValidator             = types.NewMsgEditValidator
+	NewMsgRotateConsPubKey          = types.NewMsgRotateConsPubKey
 	NewMsgDelegate                  = types.NewMsgDelegate
 	NewMsgBeginUnbonding            = types.NewMsgBeginUnbonding
 	NewMsgCompleteUnbonding         = types.NewMsgCompleteUnbonding
 	NewMsgBeginRedelegate           = types.NewMsgBeginRedelegate
 	NewMsgCompleteRedelegate        = types.NewMsgCompleteRedelegate
+	NewMsgTokenizeShares            = types.NewMsgTokenizeShares
+	NewMsgRedeemTokens              = types.NewMsgRedeemTokens
 )
 
 const (
+	ModuleName          = types.ModuleName
+	TokenizedSharesName = types.TokenizedSharesName
+
 	DefaultCodespace      = types.DefaultCodespace
 	CodeInvalidValidator  = types.CodeInvalidValidator
 	CodeInvalidDelegation = types.CodeInvalidDelegation
@@ -112,6 +139,10 @@ var (
 	ErrBadSharesAmount           = types.ErrBadSharesAmount
 	ErrBadSharesPercent          = types.ErrBadSharesPercent
 
+	ErrMinSelfDelegationInvalid   = types.ErrMinSelfDelegationInvalid
+	ErrMinSelfDelegationDecreased = types.ErrMinSelfDelegationDecreased
+	ErrSelfDelegationBelowMinimum = types.ErrSelfDelegationBelowMinimum
+
 	ErrNotMature             = types.ErrNotMature
 	ErrNoUnbondingDelegation = types.ErrNoUnbondingDelegation
 	ErrNoRedelegation        = types.ErrNoRedelegation
@@ -130,6 +161,8 @@ var (
 	ActionCompleteUnbonding    = tags.ActionCompleteUnbonding
 	ActionBeginRedelegation    = tags.ActionBeginRedelegation
 	ActionCompleteRedelegation = tags.ActionCompleteRedelegation
+	ActionTokenizeShares       = tags.ActionTokenizeShares
+	ActionRedeemTokens         = tags.ActionRedeemTokens
 
 	TagAction       = tags.Action
 	TagSrcValidator = tags.SrcValidator
@@ -8,11 +8,14 @@ import (
 var (
 	ActionCreateValidator      = []byte("create-validator")
 	ActionEditValidator        = []byte("edit-validator")
+	ActionRotateConsPubKey     = []byte("rotate-cons-pubkey")
 	ActionDelegate             = []byte("delegate")
 	ActionBeginUnbonding       = []byte("begin-unbonding")
 	ActionCompleteUnbonding    = []byte("complete-unbonding")
 	ActionBeginRedelegation    = []byte("begin-redelegation")
 	ActionCompleteRedelegation = []byte("complete-redelegation")
+	ActionTokenizeShares       = []byte("tokenize-shares")
+	ActionRedeemTokens         = []byte("redeem-tokens")
 
 	Action       = sdk.TagAction
 	SrcValidator = sdk.TagSrcValidator
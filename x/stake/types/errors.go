@@ -81,6 +81,18 @@ func ErrBadDelegationAmount(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidDelegation, "amount must be > 0")
 }
 
+func ErrMinSelfDelegationInvalid(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "minimum self-delegation cannot be negative")
+}
+
+func ErrMinSelfDelegationDecreased(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "minimum self-delegation cannot be decreased")
+}
+
+func ErrSelfDelegationBelowMinimum(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, "self-delegation is below the minimum self-delegation")
+}
+
 func ErrNoDelegation(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidDelegation, "no delegation for this (address, validator) pair")
 }
@@ -144,6 +156,11 @@ func ErrTransitiveRedelegation(codespace sdk.CodespaceType) sdk.Error {
 		"redelegation to this validator already in progress, first redelegation to this validator must complete before next redelegation")
 }
 
+func ErrTokenizeRedelegatedShares(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation,
+		"redelegation to this validator already in progress, redelegation to this validator must complete before tokenizing shares")
+}
+
 func ErrBothShareMsgsGiven(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidInput, "both shares amount and shares percent provided")
 }
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// HistoricalInfo records the header of a block along with the bonded
+// validators at the end of the block
+type HistoricalInfo struct {
+	Header abci.Header `json:"header"`
+	ValSet []Validator `json:"valset"`
+}
+
+func NewHistoricalInfo(header abci.Header, valSet []Validator) HistoricalInfo {
+	return HistoricalInfo{
+		Header: header,
+		ValSet: valSet,
+	}
+}
+
+// HumanReadableString returns a human readable string of the historical info
+func (hi HistoricalInfo) HumanReadableString() (string, error) {
+	resp := fmt.Sprintf("Historical Info at height %d\n", hi.Header.Height)
+	resp += fmt.Sprintf("Chain ID: %s\n", hi.Header.ChainID)
+	resp += fmt.Sprintf("Time: %v\n", hi.Header.Time)
+	resp += fmt.Sprintf("Validators Hash: %X\n", hi.Header.ValidatorsHash)
+	resp += fmt.Sprintf("Validators:\n")
+	for _, validator := range hi.ValSet {
+		human, err := validator.HumanReadableString()
+		if err != nil {
+			return "", err
+		}
+		resp += "  " + strings.Replace(human, "\n", "\n  ", -1) + "\n"
+	}
+	return resp, nil
+}
@@ -10,43 +10,53 @@ import (
 // name to idetify transaction types
 const MsgType = "stake"
 
+// name of the module account holding the staked tokens
+const ModuleName = "stake"
+
 // Verify interface at compile time
 var _, _, _ sdk.Msg = &MsgCreateValidator{}, &MsgEditValidator{}, &MsgDelegate{}
 var _, _ sdk.Msg = &MsgBeginUnbonding{}, &MsgCompleteUnbonding{}
 var _, _ sdk.Msg = &MsgBeginRedelegate{}, &MsgCompleteRedelegate{}
+var _ sdk.Msg = &MsgRotateConsPubKey{}
+var _, _ sdk.Msg = &MsgTokenizeShares{}, &MsgRedeemTokens{}
 
 //______________________________________________________________________
 
 // MsgCreateValidator - struct for unbonding transactions
 type MsgCreateValidator struct {
 	Description
-	DelegatorAddr sdk.AccAddress `json:"delegator_address"`
-	ValidatorAddr sdk.AccAddress `json:"validator_address"`
-	PubKey        crypto.PubKey  `json:"pubkey"`
-	Delegation    sdk.Coin       `json:"delegation"`
+	DelegatorAddr     sdk.AccAddress `json:"delegator_address"`
+	ValidatorAddr     sdk.AccAddress `json:"validator_address"`
+	PubKey            crypto.PubKey  `json:"pubkey"`
+	Delegation        sdk.Coin       `json:"delegation"`
+	MinSelfDelegation sdk.Int        `json:"min_self_delegation"`
 }
 
 // Default way to create validator. Delegator address and validator address are the same
 func NewMsgCreateValidator(validatorAddr sdk.AccAddress, pubkey crypto.PubKey,
-	selfDelegation sdk.Coin, description Description) MsgCreateValidator {
+	selfDelegation sdk.Coin, minSelfDelegation sdk.Int, description Description) MsgCreateValidator {
 	return MsgCreateValidator{
-		Description:   description,
-		DelegatorAddr: validatorAddr,
-		ValidatorAddr: validatorAddr,
-		PubKey:        pubkey,
-		Delegation:    selfDelegation,
+		Description:       description,
+		DelegatorAddr:     validatorAddr,
+		ValidatorAddr:     validatorAddr,
+		PubKey:            pubkey,
+		Delegation:        selfDelegation,
+		MinSelfDelegation: minSelfDelegation,
 	}
 }
 
-// Creates validator msg by delegator address on behalf of validator address
+// Creates validator msg by delegator address on behalf of validator address.
+// The operator then holds no self-delegation, so the minimum self-delegation
+// must be zero.
 func NewMsgCreateValidatorOnBehalfOf(delegatorAddr, validatorAddr sdk.AccAddress, pubkey crypto.PubKey,
 	delegation sdk.Coin, description Description) MsgCreateValidator {
 	return MsgCreateValidator{
-		Description:   description,
-		DelegatorAddr: delegatorAddr,
-		ValidatorAddr: validatorAddr,
-		PubKey:        pubkey,
-		Delegation:    delegation,
+		Description:       description,
+		DelegatorAddr:     delegatorAddr,
+		ValidatorAddr:     validatorAddr,
+		PubKey:            pubkey,
+		Delegation:        delegation,
+		MinSelfDelegation: sdk.ZeroInt(),
 	}
 }
 
@@ -68,15 +78,17 @@ func (msg MsgCreateValidator) GetSigners() []sdk.AccAddress {
 func (msg MsgCreateValidator) GetSignBytes() []byte {
 	b, err := MsgCdc.MarshalJSON(struct {
 		Description
-		DelegatorAddr sdk.AccAddress `json:"delegator_address"`
-		ValidatorAddr sdk.AccAddress `json:"validator_address"`
-		PubKey        string         `json:"pubkey"`
-		Delegation    sdk.Coin       `json:"delegation"`
+		DelegatorAddr     sdk.AccAddress `json:"delegator_address"`
+		ValidatorAddr     sdk.AccAddress `json:"validator_address"`
+		PubKey            string         `json:"pubkey"`
+		Delegation        sdk.Coin       `json:"delegation"`
+		MinSelfDelegation sdk.Int        `json:"min_self_delegation"`
 	}{
-		Description:   msg.Description,
-		ValidatorAddr: msg.ValidatorAddr,
-		PubKey:        sdk.MustBech32ifyValPub(msg.PubKey),
-		Delegation:    msg.Delegation,
+		Description:       msg.Description,
+		ValidatorAddr:     msg.ValidatorAddr,
+		PubKey:            sdk.MustBech32ifyValPub(msg.PubKey),
+		Delegation:        msg.Delegation,
+		MinSelfDelegation: msg.MinSelfDelegation,
 	})
 	if err != nil {
 		panic(err)
@@ -95,6 +107,15 @@ func (msg MsgCreateValidator) ValidateBasic() sdk.Error {
 	if !(msg.Delegation.Amount.GT(sdk.ZeroInt())) {
 		return ErrBadDelegationAmount(DefaultCodespace)
 	}
+	if msg.MinSelfDelegation == (sdk.Int{}) || msg.MinSelfDelegation.LT(sdk.ZeroInt()) {
+		return ErrMinSelfDelegationInvalid(DefaultCodespace)
+	}
+	if !msg.MinSelfDelegation.IsZero() {
+		// the validator must start with enough self-delegation
+		if !reflect.DeepEqual(msg.DelegatorAddr, msg.ValidatorAddr) || msg.Delegation.Amount.LT(msg.MinSelfDelegation) {
+			return ErrSelfDelegationBelowMinimum(DefaultCodespace)
+		}
+	}
 	empty := Description{}
 	if msg.Description == empty {
 		return sdk.NewError(DefaultCodespace, CodeInvalidInput, "description must be included")
@@ -104,16 +125,19 @@ func (msg MsgCreateValidator) ValidateBasic() sdk.Error {
 
 //______________________________________________________________________
 
-// MsgEditValidator - struct for editing a validator
+// MsgEditValidator - struct for editing a validator. A nil minimum
+// self-delegation leaves it unchanged.
 type MsgEditValidator struct {
 	Description
-	ValidatorAddr sdk.AccAddress `json:"address"`
+	ValidatorAddr     sdk.AccAddress `json:"address"`
+	MinSelfDelegation *sdk.Int       `json:"min_self_delegation"`
 }
 
-func NewMsgEditValidator(validatorAddr sdk.AccAddress, description Description) MsgEditValidator {
+func NewMsgEditValidator(validatorAddr sdk.AccAddress, description Description, minSelfDelegation *sdk.Int) MsgEditValidator {
 	return MsgEditValidator{
-		Description:   description,
-		ValidatorAddr: validatorAddr,
+		Description:       description,
+		ValidatorAddr:     validatorAddr,
+		MinSelfDelegation: minSelfDelegation,
 	}
 }
 
@@ -127,10 +151,12 @@ func (msg MsgEditValidator) GetSigners() []sdk.AccAddress {
 func (msg MsgEditValidator) GetSignBytes() []byte {
 	b, err := MsgCdc.MarshalJSON(struct {
 		Description
-		ValidatorAddr sdk.AccAddress `json:"address"`
+		ValidatorAddr     sdk.AccAddress `json:"address"`
+		MinSelfDelegation *sdk.Int       `json:"min_self_delegation"`
 	}{
-		Description:   msg.Description,
-		ValidatorAddr: msg.ValidatorAddr,
+		Description:       msg.Description,
+		ValidatorAddr:     msg.ValidatorAddr,
+		MinSelfDelegation: msg.MinSelfDelegation,
 	})
 	if err != nil {
 		panic(err)
@@ -144,9 +170,59 @@ func (msg MsgEditValidator) ValidateBasic() sdk.Error {
 		return sdk.NewError(DefaultCodespace, CodeInvalidInput, "nil validator address")
 	}
 	empty := Description{}
-	if msg.Description == empty {
+	if msg.Description == empty && msg.MinSelfDelegation == nil {
 		return sdk.NewError(DefaultCodespace, CodeInvalidInput, "transaction must include some information to modify")
 	}
+	if msg.MinSelfDelegation != nil && msg.MinSelfDelegation.LT(sdk.ZeroInt()) {
+		return ErrMinSelfDelegationInvalid(DefaultCodespace)
+	}
+	return nil
+}
+
+//______________________________________________________________________
+
+// MsgRotateConsPubKey - struct for rotating the consensus pubkey of a validator
+type MsgRotateConsPubKey struct {
+	ValidatorAddr sdk.AccAddress `json:"address"`
+	NewPubKey     crypto.PubKey  `json:"new_pubkey"`
+}
+
+func NewMsgRotateConsPubKey(validatorAddr sdk.AccAddress, newPubKey crypto.PubKey) MsgRotateConsPubKey {
+	return MsgRotateConsPubKey{
+		ValidatorAddr: validatorAddr,
+		NewPubKey:     newPubKey,
+	}
+}
+
+//nolint
+func (msg MsgRotateConsPubKey) Type() string { return MsgType }
+func (msg MsgRotateConsPubKey) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.ValidatorAddr}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgRotateConsPubKey) GetSignBytes() []byte {
+	b, err := MsgCdc.MarshalJSON(struct {
+		ValidatorAddr sdk.AccAddress `json:"address"`
+		NewPubKey     string         `json:"new_pubkey"`
+	}{
+		ValidatorAddr: msg.ValidatorAddr,
+		NewPubKey:     sdk.MustBech32ifyValPub(msg.NewPubKey),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgRotateConsPubKey) ValidateBasic() sdk.Error {
+	if msg.ValidatorAddr == nil {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if msg.NewPubKey == nil {
+		return sdk.NewError(DefaultCodespace, CodeInvalidInput, "nil validator pubkey")
+	}
 	return nil
 }
 
@@ -395,3 +471,105 @@ func (msg MsgCompleteUnbonding) ValidateBasic() sdk.Error {
 	}
 	return nil
 }
+
+//______________________________________________________________________
+
+// MsgTokenizeShares - struct for tokenizing the shares of a delegation into
+// coins, which may be transferred and redeemed for the delegation
+type MsgTokenizeShares struct {
+	DelegatorAddr sdk.AccAddress `json:"delegator_addr"`
+	ValidatorAddr sdk.AccAddress `json:"validator_addr"`
+	SharesAmount  sdk.Dec        `json:"shares_amount"`
+}
+
+func NewMsgTokenizeShares(delegatorAddr, validatorAddr sdk.AccAddress, sharesAmount sdk.Dec) MsgTokenizeShares {
+	return MsgTokenizeShares{
+		DelegatorAddr: delegatorAddr,
+		ValidatorAddr: validatorAddr,
+		SharesAmount:  sharesAmount,
+	}
+}
+
+//nolint
+func (msg MsgTokenizeShares) Type() string { return MsgType }
+func (msg MsgTokenizeShares) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddr}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgTokenizeShares) GetSignBytes() []byte {
+	b, err := MsgCdc.MarshalJSON(struct {
+		DelegatorAddr sdk.AccAddress `json:"delegator_addr"`
+		ValidatorAddr sdk.AccAddress `json:"validator_addr"`
+		SharesAmount  string         `json:"shares_amount"`
+	}{
+		DelegatorAddr: msg.DelegatorAddr,
+		ValidatorAddr: msg.ValidatorAddr,
+		SharesAmount:  msg.SharesAmount.String(),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgTokenizeShares) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddr == nil {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	if msg.ValidatorAddr == nil {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if msg.SharesAmount.LTE(sdk.ZeroDec()) {
+		return ErrBadSharesAmount(DefaultCodespace)
+	}
+	// shares are tokenized whole, one token per share
+	if !msg.SharesAmount.Equal(sdk.NewDecFromInt(msg.SharesAmount.RoundInt())) {
+		return ErrBadSharesAmount(DefaultCodespace)
+	}
+	return nil
+}
+
+// MsgRedeemTokens - struct for redeeming tokenized shares for the delegation
+// of the shares
+type MsgRedeemTokens struct {
+	DelegatorAddr sdk.AccAddress `json:"delegator_addr"`
+	Amount        sdk.Coin       `json:"amount"`
+}
+
+func NewMsgRedeemTokens(delegatorAddr sdk.AccAddress, amount sdk.Coin) MsgRedeemTokens {
+	return MsgRedeemTokens{
+		DelegatorAddr: delegatorAddr,
+		Amount:        amount,
+	}
+}
+
+//nolint
+func (msg MsgRedeemTokens) Type() string { return MsgType }
+func (msg MsgRedeemTokens) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddr}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgRedeemTokens) GetSignBytes() []byte {
+	b, err := MsgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgRedeemTokens) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddr == nil {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	if !msg.Amount.IsPositive() {
+		return ErrBadSharesAmount(DefaultCodespace)
+	}
+	if _, err := ParseSharesDenom(msg.Amount.Denom); err != nil {
+		return ErrBadDenom(DefaultCodespace)
+	}
+	return nil
+}
@@ -22,21 +22,26 @@ func TestMsgCreateValidator(t *testing.T) {
 		validatorAddr                             sdk.AccAddress
 		pubkey                                    crypto.PubKey
 		bond                                      sdk.Coin
+		minSelfDelegation                         sdk.Int
 		expectPass                                bool
 	}{
-		{"basic good", "a", "b", "c", "d", addr1, pk1, coinPos, true},
-		{"partial description", "", "", "c", "", addr1, pk1, coinPos, true},
-		{"empty description", "", "", "", "", addr1, pk1, coinPos, false},
-		{"empty address", "a", "b", "c", "d", emptyAddr, pk1, coinPos, false},
-		{"empty pubkey", "a", "b", "c", "d", addr1, emptyPubkey, coinPos, true},
-		{"empty bond", "a", "b", "c", "d", addr1, pk1, coinZero, false},
-		{"negative bond", "a", "b", "c", "d", addr1, pk1, coinNeg, false},
-		{"negative bond", "a", "b", "c", "d", addr1, pk1, coinNeg, false},
+		{"basic good", "a", "b", "c", "d", addr1, pk1, coinPos, sdk.ZeroInt(), true},
+		{"partial description", "", "", "c", "", addr1, pk1, coinPos, sdk.ZeroInt(), true},
+		{"empty description", "", "", "", "", addr1, pk1, coinPos, sdk.ZeroInt(), false},
+		{"empty address", "a", "b", "c", "d", emptyAddr, pk1, coinPos, sdk.ZeroInt(), false},
+		{"empty pubkey", "a", "b", "c", "d", addr1, emptyPubkey, coinPos, sdk.ZeroInt(), true},
+		{"empty bond", "a", "b", "c", "d", addr1, pk1, coinZero, sdk.ZeroInt(), false},
+		{"negative bond", "a", "b", "c", "d", addr1, pk1, coinNeg, sdk.ZeroInt(), false},
+		{"negative bond", "a", "b", "c", "d", addr1, pk1, coinNeg, sdk.ZeroInt(), false},
+		{"bond equal to min self delegation", "a", "b", "c", "d", addr1, pk1, coinPos, coinPos.Amount, true},
+		{"bond below min self delegation", "a", "b", "c", "d", addr1, pk1, coinPos, coinPos.Amount.AddRaw(1), false},
+		{"negative min self delegation", "a", "b", "c", "d", addr1, pk1, coinPos, sdk.NewInt(-1), false},
+		{"nil min self delegation", "a", "b", "c", "d", addr1, pk1, coinPos, sdk.Int{}, false},
 	}
 
 	for _, tc := range tests {
 		description := NewDescription(tc.moniker, tc.identity, tc.website, tc.details)
-		msg := NewMsgCreateValidator(tc.validatorAddr, tc.pubkey, tc.bond, description)
+		msg := NewMsgCreateValidator(tc.validatorAddr, tc.pubkey, tc.bond, tc.minSelfDelegation, description)
 		if tc.expectPass {
 			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
 		} else {
@@ -50,17 +55,20 @@ func TestMsgEditValidator(t *testing.T) {
 	tests := []struct {
 		name, moniker, identity, website, details string
 		validatorAddr                             sdk.AccAddress
+		minSelfDelegation                         *sdk.Int
 		expectPass                                bool
 	}{
-		{"basic good", "a", "b", "c", "d", addr1, true},
-		{"partial description", "", "", "c", "", addr1, true},
-		{"empty description", "", "", "", "", addr1, false},
-		{"empty address", "a", "b", "c", "d", emptyAddr, false},
+		{"basic good", "a", "b", "c", "d", addr1, nil, true},
+		{"partial description", "", "", "c", "", addr1, nil, true},
+		{"empty description", "", "", "", "", addr1, nil, false},
+		{"empty address", "a", "b", "c", "d", emptyAddr, nil, false},
+		{"min self delegation only", "", "", "", "", addr1, &coinPos.Amount, true},
+		{"negative min self delegation", "a", "b", "c", "d", addr1, &coinNeg.Amount, false},
 	}
 
 	for _, tc := range tests {
 		description := NewDescription(tc.moniker, tc.identity, tc.website, tc.details)
-		msg := NewMsgEditValidator(tc.validatorAddr, description)
+		msg := NewMsgEditValidator(tc.validatorAddr, description, tc.minSelfDelegation)
 		if tc.expectPass {
 			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
 		} else {
@@ -69,6 +77,30 @@ func TestMsgEditValidator(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgRotateConsPubKey
+func TestMsgRotateConsPubKey(t *testing.T) {
+	tests := []struct {
+		name          string
+		validatorAddr sdk.AccAddress
+		newPubKey     crypto.PubKey
+		expectPass    bool
+	}{
+		{"basic good", addr1, pk1, true},
+		{"empty address", emptyAddr, pk1, false},
+		{"empty pubkey", addr1, emptyPubkey, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgRotateConsPubKey(tc.validatorAddr, tc.newPubKey)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+			require.Equal(t, []sdk.AccAddress{tc.validatorAddr}, msg.GetSigners())
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
 // test ValidateBasic and GetSigners for MsgCreateValidatorOnBehalfOf
 func TestMsgCreateValidatorOnBehalfOf(t *testing.T) {
 	tests := []struct {
@@ -100,7 +132,7 @@ func TestMsgCreateValidatorOnBehalfOf(t *testing.T) {
 		}
 	}
 
-	msg := NewMsgCreateValidator(addr1, pk1, coinPos, Description{})
+	msg := NewMsgCreateValidator(addr1, pk1, coinPos, sdk.ZeroInt(), Description{})
 	addrs := msg.GetSigners()
 	require.Equal(t, []sdk.AccAddress{addr1}, addrs, "Signers on default msg is wrong")
 
@@ -237,3 +269,53 @@ func TestMsgCompleteUnbonding(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgTokenizeShares
+func TestMsgTokenizeShares(t *testing.T) {
+	tests := []struct {
+		name                         string
+		delegatorAddr, validatorAddr sdk.AccAddress
+		sharesAmount                 sdk.Dec
+		expectPass                   bool
+	}{
+		{"regular", addr1, addr2, sdk.NewDec(1), true},
+		{"fractional shares", addr1, addr2, sdk.NewDecWithPrec(15, 1), false},
+		{"zero shares", addr1, addr2, sdk.ZeroDec(), false},
+		{"negative shares", addr1, addr2, sdk.NewDec(-1), false},
+		{"empty delegator", emptyAddr, addr2, sdk.NewDec(1), false},
+		{"empty validator", addr1, emptyAddr, sdk.NewDec(1), false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgTokenizeShares(tc.delegatorAddr, tc.validatorAddr, tc.sharesAmount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+// test ValidateBasic for MsgRedeemTokens
+func TestMsgRedeemTokens(t *testing.T) {
+	tests := []struct {
+		name          string
+		delegatorAddr sdk.AccAddress
+		amount        sdk.Coin
+		expectPass    bool
+	}{
+		{"regular", addr1, sdk.NewInt64Coin(GetSharesDenom(addr2), 1), true},
+		{"zero amount", addr1, sdk.NewInt64Coin(GetSharesDenom(addr2), 0), false},
+		{"not tokenized shares", addr1, coinPos, false},
+		{"empty delegator", emptyAddr, sdk.NewInt64Coin(GetSharesDenom(addr2), 1), false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgRedeemTokens(tc.delegatorAddr, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SlashEvent records a slash of a validator, along with the unbonding
+// delegations and redelegations from it
+type SlashEvent struct {
+	ValidatorAddr    sdk.AccAddress `json:"validator_addr"`
+	Height           int64          `json:"height"`            // height at which the validator was slashed
+	InfractionHeight int64          `json:"infraction_height"` // height at which the infraction occurred
+	Fraction         sdk.Dec        `json:"fraction"`          // slash fraction
+	Infraction       sdk.Infraction `json:"infraction"`        // infraction for which the validator was slashed
+	TokensBurned     sdk.Dec        `json:"tokens_burned"`     // tokens burned, from the validator, unbonding delegations and redelegations
+}
+
+func NewSlashEvent(validatorAddr sdk.AccAddress, height, infractionHeight int64,
+	fraction sdk.Dec, infraction sdk.Infraction, tokensBurned sdk.Dec) SlashEvent {
+
+	return SlashEvent{
+		ValidatorAddr:    validatorAddr,
+		Height:           height,
+		InfractionHeight: infractionHeight,
+		Fraction:         fraction,
+		Infraction:       infraction,
+		TokensBurned:     tokensBurned,
+	}
+}
+
+// HumanReadableString returns a human readable string of the slash event
+func (e SlashEvent) HumanReadableString() string {
+	return fmt.Sprintf("Validator %s slashed at height %d for %s at height %d, fraction: %v, tokens burned: %v",
+		e.ValidatorAddr, e.Height, e.Infraction, e.InfractionHeight, e.Fraction, e.TokensBurned)
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// name of the module account minting and burning the tokenized shares, whose
+// address holds the tokenized delegations
+const TokenizedSharesName = "stake_tokenized_shares"
+
+// prefix of the denominations of tokenized shares
+const sharesDenomPrefix = ModuleName + "/"
+
+// GetSharesDenom returns the denomination of the tokens representing the
+// delegator shares of a validator, one token per share
+func GetSharesDenom(validatorAddr sdk.AccAddress) string {
+	return sharesDenomPrefix + validatorAddr.String()
+}
+
+// ParseSharesDenom returns the address of the validator whose delegator
+// shares are represented by the denomination
+func ParseSharesDenom(denom string) (sdk.AccAddress, error) {
+	if !strings.HasPrefix(denom, sharesDenomPrefix) {
+		return nil, fmt.Errorf("%s is not a denomination of tokenized shares", denom)
+	}
+	return sdk.AccAddressFromBech32(strings.TrimPrefix(denom, sharesDenomPrefix))
+}
@@ -24,9 +24,10 @@ type Validator struct {
 	PubKey   crypto.PubKey  `json:"pub_key"`  // pubkey of validator
 	Revoked  bool           `json:"revoked"`  // has the validator been revoked from bonded status?
 
-	Status          sdk.BondStatus `json:"status"`           // validator status (bonded/unbonding/unbonded)
-	Tokens          sdk.Dec        `json:"tokens"`           // delegated tokens (incl. self-delegation)
-	DelegatorShares sdk.Dec        `json:"delegator_shares"` // total shares issued to a validator's delegators
+	Status            sdk.BondStatus `json:"status"`              // validator status (bonded/unbonding/unbonded)
+	Tokens            sdk.Dec        `json:"tokens"`              // delegated tokens (incl. self-delegation)
+	DelegatorShares   sdk.Dec        `json:"delegator_shares"`    // total shares issued to a validator's delegators
+	MinSelfDelegation sdk.Int        `json:"min_self_delegation"` // minimum tokens of the self-delegation, below which the validator is revoked
 
 	Description        Description `json:"description"`           // description terms for the validator
 	BondHeight         int64       `json:"bond_height"`           // earliest height as a bonded validator
@@ -51,6 +52,7 @@ func NewValidator(operator sdk.AccAddress, pubKey crypto.PubKey, description Des
 		Status:                sdk.Unbonded,
 		Tokens:                sdk.ZeroDec(),
 		DelegatorShares:       sdk.ZeroDec(),
+		MinSelfDelegation:     sdk.ZeroInt(),
 		Description:           description,
 		BondHeight:            int64(0),
 		BondIntraTxCounter:    int16(0),
@@ -70,6 +72,7 @@ type validatorValue struct {
 	Status                sdk.BondStatus
 	Tokens                sdk.Dec
 	DelegatorShares       sdk.Dec
+	MinSelfDelegation     sdk.Int
 	Description           Description
 	BondHeight            int64
 	BondIntraTxCounter    int16
@@ -89,6 +92,7 @@ func MustMarshalValidator(cdc *wire.Codec, validator Validator) []byte {
 		Status:                validator.Status,
 		Tokens:                validator.Tokens,
 		DelegatorShares:       validator.DelegatorShares,
+		MinSelfDelegation:     validator.MinSelfDelegation,
 		Description:           validator.Description,
 		BondHeight:            validator.BondHeight,
 		BondIntraTxCounter:    validator.BondIntraTxCounter,
@@ -131,6 +135,7 @@ func UnmarshalValidator(cdc *wire.Codec, operatorAddr, value []byte) (validator
 		Tokens:                storeValue.Tokens,
 		Status:                storeValue.Status,
 		DelegatorShares:       storeValue.DelegatorShares,
+		MinSelfDelegation:     storeValue.MinSelfDelegation,
 		Description:           storeValue.Description,
 		BondHeight:            storeValue.BondHeight,
 		BondIntraTxCounter:    storeValue.BondIntraTxCounter,
@@ -159,6 +164,7 @@ func (v Validator) HumanReadableString() (string, error) {
 	resp += fmt.Sprintf("Status: %s\n", sdk.BondStatusToString(v.Status))
 	resp += fmt.Sprintf("Tokens: %s\n", v.Tokens.String())
 	resp += fmt.Sprintf("Delegator Shares: %s\n", v.DelegatorShares.String())
+	resp += fmt.Sprintf("Min Self Delegation: %s\n", v.MinSelfDelegation.String())
 	resp += fmt.Sprintf("Description: %s\n", v.Description)
 	resp += fmt.Sprintf("Bond Height: %d\n", v.BondHeight)
 	resp += fmt.Sprintf("Proposer Reward Pool: %s\n", v.ProposerRewardPool.String())
@@ -179,9 +185,10 @@ type BechValidator struct {
 	PubKey   string         `json:"pub_key"`  // in bech32
 	Revoked  bool           `json:"revoked"`  // has the validator been revoked from bonded status?
 
-	Status          sdk.BondStatus `json:"status"`           // validator status (bonded/unbonding/unbonded)
-	Tokens          sdk.Dec        `json:"tokens"`           // delegated tokens (incl. self-delegation)
-	DelegatorShares sdk.Dec        `json:"delegator_shares"` // total shares issued to a validator's delegators
+	Status            sdk.BondStatus `json:"status"`              // validator status (bonded/unbonding/unbonded)
+	Tokens            sdk.Dec        `json:"tokens"`              // delegated tokens (incl. self-delegation)
+	DelegatorShares   sdk.Dec        `json:"delegator_shares"`    // total shares issued to a validator's delegators
+	MinSelfDelegation sdk.Int        `json:"min_self_delegation"` // minimum tokens of the self-delegation, below which the validator is revoked
 
 	Description        Description `json:"description"`           // description terms for the validator
 	BondHeight         int64       `json:"bond_height"`           // earliest height as a bonded validator
@@ -209,9 +216,10 @@ func (v Validator) Bech32Validator() (BechValidator, error) {
 		PubKey:   bechValPubkey,
 		Revoked:  v.Revoked,
 
-		Status:          v.Status,
-		Tokens:          v.Tokens,
-		DelegatorShares: v.DelegatorShares,
+		Status:            v.Status,
+		Tokens:            v.Tokens,
+		DelegatorShares:   v.DelegatorShares,
+		MinSelfDelegation: v.MinSelfDelegation,
 
 		Description:        v.Description,
 		BondHeight:         v.BondHeight,
@@ -237,6 +245,7 @@ func (v Validator) Equal(c2 Validator) bool {
 		v.Status.Equal(c2.Status) &&
 		v.Tokens.Equal(c2.Tokens) &&
 		v.DelegatorShares.Equal(c2.DelegatorShares) &&
+		v.MinSelfDelegation.Equal(c2.MinSelfDelegation) &&
 		v.Description == c2.Description &&
 		v.ProposerRewardPool.IsEqual(c2.ProposerRewardPool) &&
 		v.Commission.Equal(c2.Commission) &&
@@ -427,12 +436,13 @@ func (v Validator) BondedTokens() sdk.Dec {
 var _ sdk.Validator = Validator{}
 
 // nolint - for sdk.Validator
-func (v Validator) GetRevoked() bool            { return v.Revoked }
-func (v Validator) GetMoniker() string          { return v.Description.Moniker }
-func (v Validator) GetStatus() sdk.BondStatus   { return v.Status }
-func (v Validator) GetOperator() sdk.AccAddress { return v.Operator }
-func (v Validator) GetPubKey() crypto.PubKey    { return v.PubKey }
-func (v Validator) GetPower() sdk.Dec           { return v.BondedTokens() }
-func (v Validator) GetTokens() sdk.Dec          { return v.Tokens }
-func (v Validator) GetDelegatorShares() sdk.Dec { return v.DelegatorShares }
-func (v Validator) GetBondHeight() int64        { return v.BondHeight }
+func (v Validator) GetRevoked() bool              { return v.Revoked }
+func (v Validator) GetMoniker() string            { return v.Description.Moniker }
+func (v Validator) GetStatus() sdk.BondStatus     { return v.Status }
+func (v Validator) GetOperator() sdk.AccAddress   { return v.Operator }
+func (v Validator) GetPubKey() crypto.PubKey      { return v.PubKey }
+func (v Validator) GetPower() sdk.Dec             { return v.BondedTokens() }
+func (v Validator) GetTokens() sdk.Dec            { return v.Tokens }
+func (v Validator) GetDelegatorShares() sdk.Dec   { return v.DelegatorShares }
+func (v Validator) GetBondHeight() int64          { return v.BondHeight }
+func (v Validator) GetMinSelfDelegation() sdk.Int { return v.MinSelfDelegation }